@@ -23,6 +23,34 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for BoardExportMemberRole.
+const (
+	BoardExportMemberRoleMember    BoardExportMemberRole = "member"
+	BoardExportMemberRoleModerator BoardExportMemberRole = "moderator"
+	BoardExportMemberRoleOwner     BoardExportMemberRole = "owner"
+)
+
+// Defines values for BulkCardRequestOperation.
+const (
+	Archive     BulkCardRequestOperation = "archive"
+	AssignLabel BulkCardRequestOperation = "assignLabel"
+	Delete      BulkCardRequestOperation = "delete"
+	Move        BulkCardRequestOperation = "move"
+	Unarchive   BulkCardRequestOperation = "unarchive"
+)
+
+// Defines values for GetBoardsIdBoardExportParamsFormat.
+const (
+	Csv  GetBoardsIdBoardExportParamsFormat = "csv"
+	Json GetBoardsIdBoardExportParamsFormat = "json"
+)
+
+// Defines values for ImportReportSource.
+const (
+	Export ImportReportSource = "export"
+	Trello ImportReportSource = "trello"
+)
+
 // Board defines model for Board.
 type Board struct {
 	CreatedAt   *time.Time          `json:"createdAt,omitempty"`
@@ -39,6 +67,79 @@ type Board struct {
 	// Starred Whether the current user has starred this board
 	Starred   *bool      `json:"starred,omitempty"`
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Watching Whether the current user watches this board, only returned with board details
+	Watching *bool `json:"watching,omitempty"`
+
+	// WipLimitMode soft or hard. Soft WIP limits can be exceeded by passing ignoreWipLimit, hard ones can't.
+	WipLimitMode *string `json:"wipLimitMode,omitempty"`
+}
+
+// BoardExport defines model for BoardExport.
+type BoardExport struct {
+	Board        Board               `json:"board"`
+	Cards        []BoardExportCard   `json:"cards"`
+	CustomFields *[]CustomField      `json:"customFields,omitempty"`
+	ExportedAt   *time.Time          `json:"exportedAt,omitempty"`
+	Labels       []Label             `json:"labels"`
+	Lists        []List              `json:"lists"`
+	Members      []BoardExportMember `json:"members"`
+
+	// Version Export format version
+	Version int `json:"version"`
+}
+
+// BoardExportCard defines model for BoardExportCard.
+type BoardExportCard struct {
+	Archived  *bool      `json:"archived,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy ID of the member who created the card
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+
+	// CustomFields Custom field values of the card, only returned for a single card and in exports
+	CustomFields *[]CardCustomFieldValue `json:"customFields,omitempty"`
+	Description  *string                 `json:"description,omitempty"`
+	Id           *openapi_types.UUID     `json:"id,omitempty"`
+	IdLabels     *[]openapi_types.UUID   `json:"idLabels,omitempty"`
+	IdList       *openapi_types.UUID     `json:"idList,omitempty"`
+
+	// Position Position for ordering cards within list
+	Position  *float32   `json:"position,omitempty"`
+	Title     *string    `json:"title,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Watching Whether the current user watches this card, only returned for a single card
+	Watching *bool `json:"watching,omitempty"`
+}
+
+// BoardExportMember defines model for BoardExportMember.
+type BoardExportMember struct {
+	// AvatarUrl 256x256 PNG avatar, missing without an avatar. The 32, 64 and 128 pixel sizes are served
+	// next to it, with the size replacing 256 in the file name.
+	AvatarUrl *string              `json:"avatarUrl,omitempty"`
+	CreatedAt *time.Time           `json:"createdAt,omitempty"`
+	Email     *openapi_types.Email `json:"email,omitempty"`
+
+	// EmailVerifiedAt Time the current email was verified, missing while unverified
+	EmailVerifiedAt *time.Time             `json:"emailVerifiedAt,omitempty"`
+	FullName        *string                `json:"fullName,omitempty"`
+	Id              *openapi_types.UUID    `json:"id,omitempty"`
+	JoinedAt        *time.Time             `json:"joinedAt,omitempty"`
+	Role            *BoardExportMemberRole `json:"role,omitempty"`
+	UpdatedAt       *time.Time             `json:"updatedAt,omitempty"`
+	Username        *string                `json:"username,omitempty"`
+}
+
+// BoardExportMemberRole defines model for BoardExportMember.role.
+type BoardExportMemberRole string
+
+// BoardOwnershipTransfer defines model for BoardOwnershipTransfer.
+type BoardOwnershipTransfer struct {
+	IdBoard openapi_types.UUID `json:"idBoard"`
+
+	// IdMember Board member who becomes the owner
+	IdMember openapi_types.UUID `json:"idMember"`
 }
 
 // BoardSummary defines model for BoardSummary.
@@ -56,21 +157,76 @@ type BoardSummary struct {
 	UpdatedAt       *time.Time `json:"updatedAt,omitempty"`
 }
 
+// BulkCardRequest defines model for BulkCardRequest.
+type BulkCardRequest struct {
+	IdCards []openapi_types.UUID `json:"idCards"`
+
+	// IdLabel Label to assign (required for assignLabel)
+	IdLabel *openapi_types.UUID `json:"idLabel,omitempty"`
+
+	// IdList Target list (required for move)
+	IdList *openapi_types.UUID `json:"idList,omitempty"`
+
+	// IgnoreWipLimit Move or unarchive the cards even if a list exceeds its WIP limit, only allowed for soft limits
+	IgnoreWipLimit *bool `json:"ignoreWipLimit,omitempty"`
+
+	// Operation Operation applied to every card
+	Operation BulkCardRequestOperation `json:"operation"`
+}
+
+// BulkCardRequestOperation Operation applied to every card
+type BulkCardRequestOperation string
+
+// BulkCardResult defines model for BulkCardResult.
+type BulkCardResult struct {
+	// Error Reason the operation was not applied to this card
+	Error   *string            `json:"error,omitempty"`
+	IdCard  openapi_types.UUID `json:"idCard"`
+	Success bool               `json:"success"`
+}
+
 // Card defines model for Card.
 type Card struct {
 	Archived  *bool      `json:"archived,omitempty"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
 	// CreatedBy ID of the member who created the card
-	CreatedBy   *openapi_types.UUID `json:"createdBy,omitempty"`
-	Description *string             `json:"description,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	IdList      *openapi_types.UUID `json:"idList,omitempty"`
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+
+	// CustomFields Custom field values of the card, only returned for a single card and in exports
+	CustomFields *[]CardCustomFieldValue `json:"customFields,omitempty"`
+	Description  *string                 `json:"description,omitempty"`
+	Id           *openapi_types.UUID     `json:"id,omitempty"`
+	IdList       *openapi_types.UUID     `json:"idList,omitempty"`
 
 	// Position Position for ordering cards within list
 	Position  *float32   `json:"position,omitempty"`
 	Title     *string    `json:"title,omitempty"`
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Watching Whether the current user watches this card, only returned for a single card
+	Watching *bool `json:"watching,omitempty"`
+}
+
+// CardCustomFieldValue defines model for CardCustomFieldValue.
+type CardCustomFieldValue struct {
+	Checked       *bool              `json:"checked,omitempty"`
+	Date          *time.Time         `json:"date,omitempty"`
+	IdCustomField openapi_types.UUID `json:"idCustomField"`
+
+	// IdOption Selected option of a dropdown field
+	IdOption  *openapi_types.UUID `json:"idOption,omitempty"`
+	Number    *float64            `json:"number,omitempty"`
+	Text      *string             `json:"text,omitempty"`
+	UpdatedAt *time.Time          `json:"updatedAt,omitempty"`
+}
+
+// CreateAccessTokenRequest defines model for CreateAccessTokenRequest.
+type CreateAccessTokenRequest struct {
+	// ExpiresAt Expiry of the token, never expires when omitted
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
 }
 
 // CreateBoardRequest defines model for CreateBoardRequest.
@@ -89,11 +245,31 @@ type CreateBoardRequest struct {
 type CreateCardRequest struct {
 	Description *string `json:"description,omitempty"`
 
+	// IgnoreWipLimit Create the card even if the list is at its WIP limit, only allowed for soft limits
+	IgnoreWipLimit *bool `json:"ignoreWipLimit,omitempty"`
+
 	// Position Position for ordering (auto-generated if not provided)
 	Position *float32 `json:"position,omitempty"`
 	Title    string   `json:"title"`
 }
 
+// CreateCustomFieldRequest defines model for CreateCustomFieldRequest.
+type CreateCustomFieldRequest struct {
+	Name string `json:"name"`
+
+	// Options Choices of a dropdown field, 1 to 50 distinct values. Other types have none.
+	Options *[]string `json:"options,omitempty"`
+
+	// Type text, number, date, checkbox or dropdown
+	Type string `json:"type"`
+}
+
+// CreateLabelRequest defines model for CreateLabelRequest.
+type CreateLabelRequest struct {
+	Color string  `json:"color"`
+	Name  *string `json:"name,omitempty"`
+}
+
 // CreateListRequest defines model for CreateListRequest.
 type CreateListRequest struct {
 	Name string `json:"name"`
@@ -102,6 +278,77 @@ type CreateListRequest struct {
 	Position float32 `json:"position"`
 }
 
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// Events Events to deliver, empty or omitted means all events. Supported: card.created, card.updated,
+	// card.moved, card.archived, card.unarchived, card.deleted, cards.bulk, list.cards_moved,
+	// list.cards_archived
+	Events *[]string `json:"events,omitempty"`
+
+	// Secret Signing secret, generated when omitted
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// CustomField defines model for CustomField.
+type CustomField struct {
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	IdBoard   *openapi_types.UUID `json:"idBoard,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+
+	// Options Choices of a dropdown field in display order
+	Options *[]CustomFieldOption `json:"options,omitempty"`
+
+	// Position Position for ordering fields on the board
+	Position *float64 `json:"position,omitempty"`
+
+	// Type text, number, date, checkbox or dropdown
+	Type      *string    `json:"type,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// CustomFieldOption defines model for CustomFieldOption.
+type CustomFieldOption struct {
+	// Id Omitted for new options when updating a field
+	Id    *openapi_types.UUID `json:"id,omitempty"`
+	Value string              `json:"value"`
+}
+
+// DataExport defines model for DataExport.
+type DataExport struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+
+	// ExpiresAt The export and its archive are deleted afterwards
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Format json or zip
+	Format string             `json:"format"`
+	Id     openapi_types.UUID `json:"id"`
+
+	// SizeBytes Archive size, set when ready
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+
+	// Status pending, processing, ready or failed
+	Status string `json:"status"`
+}
+
+// DeleteAccountRequest defines model for DeleteAccountRequest.
+type DeleteAccountRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+
+	// TransferOwnership New owners for owned boards shared with other members
+	TransferOwnership *[]BoardOwnershipTransfer `json:"transferOwnership,omitempty"`
+}
+
+// DisableMFARequest defines model for DisableMFARequest.
+type DisableMFARequest struct {
+	// Code TOTP or recovery code
+	Code            string `json:"code"`
+	CurrentPassword string `json:"currentPassword"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Details Additional error details
@@ -114,12 +361,70 @@ type Error struct {
 	StatusCode int `json:"statusCode"`
 }
 
+// ForgotPasswordRequest defines model for ForgotPasswordRequest.
+type ForgotPasswordRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// ImportBoardRequest defines model for ImportBoardRequest.
+type ImportBoardRequest struct {
+	// Data BoardExport document or Trello board JSON export
+	Data map[string]interface{} `json:"data"`
+
+	// Name Board name (defaults to the name found in the imported data)
+	Name *string `json:"name,omitempty"`
+
+	// NameBoardUnique Unique identifier name for the new board
+	NameBoardUnique string `json:"name_board_unique"`
+
+	// Password Password required to join the new board
+	Password string `json:"password"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	CardsImported      int                   `json:"cardsImported"`
+	ChecklistsImported int                   `json:"checklistsImported"`
+	LabelsImported     int                   `json:"labelsImported"`
+	ListsImported      int                   `json:"listsImported"`
+	Skipped            []ImportSkippedItem   `json:"skipped"`
+	Source             ImportReportSource    `json:"source"`
+	UnmappedFields     []ImportUnmappedField `json:"unmappedFields"`
+}
+
+// ImportReportSource defines model for ImportReport.source.
+type ImportReportSource string
+
+// ImportSkippedItem defines model for ImportSkippedItem.
+type ImportSkippedItem struct {
+	// Id Identifier of the item in the imported data
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+	Type   string `json:"type"`
+}
+
+// ImportUnmappedField defines model for ImportUnmappedField.
+type ImportUnmappedField struct {
+	// Count Number of imported items that had a value in this field
+	Count int    `json:"count"`
+	Field string `json:"field"`
+}
+
 // JoinBoardRequest defines model for JoinBoardRequest.
 type JoinBoardRequest struct {
 	// Password Board password
 	Password string `json:"password"`
 }
 
+// Label defines model for Label.
+type Label struct {
+	Color     *string             `json:"color,omitempty"`
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	IdBoard   *openapi_types.UUID `json:"idBoard,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+}
+
 // List defines model for List.
 type List struct {
 	Archived  *bool               `json:"archived,omitempty"`
@@ -131,6 +436,9 @@ type List struct {
 	// Position Position for ordering lists
 	Position  *float32   `json:"position,omitempty"`
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// WipLimit Maximum number of active cards in the list, omitted when unlimited
+	WipLimit *int `json:"wipLimit,omitempty"`
 }
 
 // LoginRequest defines model for LoginRequest.
@@ -139,14 +447,112 @@ type LoginRequest struct {
 	Password string              `json:"password"`
 }
 
+// MFACodeRequest defines model for MFACodeRequest.
+type MFACodeRequest struct {
+	// Code Current code from the authenticator app
+	Code string `json:"code"`
+}
+
 // Member defines model for Member.
 type Member struct {
+	// AvatarUrl 256x256 PNG avatar, missing without an avatar. The 32, 64 and 128 pixel sizes are served
+	// next to it, with the size replacing 256 in the file name.
+	AvatarUrl *string              `json:"avatarUrl,omitempty"`
 	CreatedAt *time.Time           `json:"createdAt,omitempty"`
 	Email     *openapi_types.Email `json:"email,omitempty"`
-	FullName  *string              `json:"fullName,omitempty"`
-	Id        *openapi_types.UUID  `json:"id,omitempty"`
-	UpdatedAt *time.Time           `json:"updatedAt,omitempty"`
-	Username  *string              `json:"username,omitempty"`
+
+	// EmailVerifiedAt Time the current email was verified, missing while unverified
+	EmailVerifiedAt *time.Time          `json:"emailVerifiedAt,omitempty"`
+	FullName        *string             `json:"fullName,omitempty"`
+	Id              *openapi_types.UUID `json:"id,omitempty"`
+	UpdatedAt       *time.Time          `json:"updatedAt,omitempty"`
+	Username        *string             `json:"username,omitempty"`
+}
+
+// MemberPreferences defines model for MemberPreferences.
+type MemberPreferences struct {
+	// DefaultBoardSort Order of GET /boards, updated, name, created or joined
+	DefaultBoardSort *string `json:"defaultBoardSort,omitempty"`
+
+	// EmailDigest How often unread notifications are emailed, off, daily or weekly. Digests are sent at the configured
+	// hour in the member's time zone, weekly ones on Mondays, and only to verified email addresses.
+	EmailDigest *string `json:"emailDigest,omitempty"`
+
+	// Locale BCP 47 language tag
+	Locale        *string                  `json:"locale,omitempty"`
+	Notifications *NotificationPreferences `json:"notifications,omitempty"`
+
+	// Timezone IANA time zone name
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// MemberProfile Public profile of a member, visible to other members
+type MemberProfile struct {
+	// AvatarUrl 256x256 PNG avatar, missing without an avatar. The 32, 64 and 128 pixel sizes are served
+	// next to it, with the size replacing 256 in the file name.
+	AvatarUrl *string             `json:"avatarUrl,omitempty"`
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	FullName  *string             `json:"fullName,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Username  *string             `json:"username,omitempty"`
+}
+
+// MoveAllCardsRequest defines model for MoveAllCardsRequest.
+type MoveAllCardsRequest struct {
+	// IdList Target list in the same board
+	IdList openapi_types.UUID `json:"idList"`
+
+	// IgnoreWipLimit Move the cards even if the target list exceeds its WIP limit, only allowed for soft limits
+	IgnoreWipLimit *bool `json:"ignoreWipLimit,omitempty"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data Type specific details such as boardName, username, cardTitle, idList and idListBefore
+	Data *map[string]interface{} `json:"data,omitempty"`
+	Id   openapi_types.UUID      `json:"id"`
+
+	// IdActor Member who caused the notification
+	IdActor *openapi_types.UUID `json:"idActor,omitempty"`
+	IdBoard *openapi_types.UUID `json:"idBoard,omitempty"`
+
+	// IdCard Missing for board notifications and deleted cards
+	IdCard *openapi_types.UUID `json:"idCard,omitempty"`
+	Read   bool                `json:"read"`
+	ReadAt *time.Time          `json:"readAt,omitempty"`
+
+	// Type board.member_joined, board.member_left, board.member_removed, card.created, card.updated,
+	// card.moved, card.archived, card.unarchived, card.deleted or card.mentioned
+	Type string `json:"type"`
+}
+
+// NotificationPreferences Events that create notifications
+type NotificationPreferences struct {
+	// BoardMembers Members joining or leaving a board
+	BoardMembers *bool `json:"boardMembers,omitempty"`
+
+	// CardChanges Cards created, moved, updated or archived
+	CardChanges *bool `json:"cardChanges,omitempty"`
+
+	// Mentions Being mentioned with @username in a card
+	Mentions *bool `json:"mentions,omitempty"`
+}
+
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// ExpiresAt Absent when the token never expires
+	ExpiresAt  *time.Time          `json:"expiresAt,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	LastUsedAt *time.Time          `json:"lastUsedAt,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Scopes     *[]string           `json:"scopes,omitempty"`
+
+	// TokenPrefix First characters of the token, to tell tokens apart
+	TokenPrefix *string `json:"tokenPrefix,omitempty"`
 }
 
 // RegisterRequest defines model for RegisterRequest.
@@ -157,6 +563,28 @@ type RegisterRequest struct {
 	Username string              `json:"username"`
 }
 
+// ResendVerificationRequest defines model for ResendVerificationRequest.
+type ResendVerificationRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	Password string `json:"password"`
+
+	// Token Token from the reset link
+	Token string `json:"token"`
+}
+
+// SetCustomFieldValueRequest defines model for SetCustomFieldValueRequest.
+type SetCustomFieldValueRequest struct {
+	Checked  *bool               `json:"checked,omitempty"`
+	Date     *time.Time          `json:"date,omitempty"`
+	IdOption *openapi_types.UUID `json:"idOption,omitempty"`
+	Number   *float64            `json:"number,omitempty"`
+	Text     *string             `json:"text,omitempty"`
+}
+
 // TokenRefreshRequest defines model for TokenRefreshRequest.
 type TokenRefreshRequest struct {
 	// RefreshToken Valid refresh token
@@ -173,6 +601,9 @@ type UpdateBoardRequest struct {
 
 	// Password Update board password
 	Password *string `json:"password,omitempty"`
+
+	// WipLimitMode How list WIP limits are enforced, soft or hard
+	WipLimitMode *string `json:"wipLimitMode,omitempty"`
 }
 
 // UpdateCardRequest defines model for UpdateCardRequest.
@@ -184,11 +615,24 @@ type UpdateCardRequest struct {
 	// IdList Move card to different list
 	IdList *openapi_types.UUID `json:"idList,omitempty"`
 
+	// IgnoreWipLimit Move or unarchive the card even if the list is at its WIP limit, only allowed for soft limits
+	IgnoreWipLimit *bool `json:"ignoreWipLimit,omitempty"`
+
 	// Position New position for ordering
 	Position *float32 `json:"position,omitempty"`
 	Title    *string  `json:"title,omitempty"`
 }
 
+// UpdateCustomFieldRequest defines model for UpdateCustomFieldRequest.
+type UpdateCustomFieldRequest struct {
+	Name *string `json:"name,omitempty"`
+
+	// Options Replaces the choices of a dropdown field. Existing options are kept by their id, options without
+	// id are added and missing ones removed.
+	Options  *[]CustomFieldOption `json:"options,omitempty"`
+	Position *float64             `json:"position,omitempty"`
+}
+
 // UpdateListRequest defines model for UpdateListRequest.
 type UpdateListRequest struct {
 	// Archived Archive/unarchive the list
@@ -197,18 +641,94 @@ type UpdateListRequest struct {
 
 	// Position New position for ordering
 	Position *float32 `json:"position,omitempty"`
+
+	// WipLimit Maximum number of active cards in the list, 0 removes the limit
+	WipLimit *int `json:"wipLimit,omitempty"`
 }
 
 // UpdateMemberRequest defines model for UpdateMemberRequest.
 type UpdateMemberRequest struct {
-	Email    *openapi_types.Email `json:"email,omitempty"`
-	FullName *string              `json:"fullName,omitempty"`
+	// CurrentPassword Current password, required when changing email or password
+	CurrentPassword *string              `json:"currentPassword,omitempty"`
+	Email           *openapi_types.Email `json:"email,omitempty"`
+	FullName        *string              `json:"fullName,omitempty"`
 
 	// Password New password (optional)
 	Password *string `json:"password,omitempty"`
 	Username *string `json:"username,omitempty"`
 }
 
+// UpdateWebhookRequest defines model for UpdateWebhookRequest.
+type UpdateWebhookRequest struct {
+	Active *bool     `json:"active,omitempty"`
+	Events *[]string `json:"events,omitempty"`
+	Secret *string   `json:"secret,omitempty"`
+	Url    *string   `json:"url,omitempty"`
+}
+
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	// Token Token from the verification link
+	Token string `json:"token"`
+}
+
+// VerifyMFARequest defines model for VerifyMFARequest.
+type VerifyMFARequest struct {
+	// Code TOTP or recovery code
+	Code string `json:"code"`
+
+	// MfaToken Token returned by login
+	MfaToken string `json:"mfaToken"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active    *bool               `json:"active,omitempty"`
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+
+	// Events Subscribed events, empty means all events
+	Events    *[]string           `json:"events,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	IdBoard   *openapi_types.UUID `json:"idBoard,omitempty"`
+	UpdatedAt *time.Time          `json:"updatedAt,omitempty"`
+	Url       *string             `json:"url,omitempty"`
+}
+
+// WebhookDeliveryLog defines model for WebhookDeliveryLog.
+type WebhookDeliveryLog struct {
+	Attempt   *int       `json:"attempt,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// DeliveryStatus Current state of the delivery (pending, succeeded or dead)
+	DeliveryStatus *string             `json:"deliveryStatus,omitempty"`
+	DurationMs     *int                `json:"durationMs,omitempty"`
+	Error          *string             `json:"error,omitempty"`
+	Event          *string             `json:"event,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+	IdDelivery     *openapi_types.UUID `json:"idDelivery,omitempty"`
+	IdWebhook      *openapi_types.UUID `json:"idWebhook,omitempty"`
+
+	// StatusCode HTTP status returned by the receiver, absent when no response was received
+	StatusCode *int `json:"statusCode,omitempty"`
+}
+
+// AccessTokenCreatedResponse defines model for AccessTokenCreatedResponse.
+type AccessTokenCreatedResponse struct {
+	AccessToken *PersonalAccessToken `json:"accessToken,omitempty"`
+
+	// Token Token value, shown only once
+	Token *string `json:"token,omitempty"`
+}
+
+// AccessTokensListResponse defines model for AccessTokensListResponse.
+type AccessTokensListResponse struct {
+	Tokens *[]PersonalAccessToken `json:"tokens,omitempty"`
+}
+
+// BadGateway defines model for BadGateway.
+type BadGateway = Error
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
@@ -233,6 +753,12 @@ type BoardWithDetailsResponse struct {
 	// Starred Whether the current user has starred this board
 	Starred   *bool      `json:"starred,omitempty"`
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Watching Whether the current user watches this board, only returned with board details
+	Watching *bool `json:"watching,omitempty"`
+
+	// WipLimitMode soft or hard. Soft WIP limits can be exceeded by passing ignoreWipLimit, hard ones can't.
+	WipLimitMode *string `json:"wipLimitMode,omitempty"`
 }
 
 // BoardsListResponse defines model for BoardsListResponse.
@@ -243,17 +769,56 @@ type BoardsListResponse struct {
 	Total  *int            `json:"total,omitempty"`
 }
 
+// BulkCardResponse defines model for BulkCardResponse.
+type BulkCardResponse struct {
+	Results *[]BulkCardResult `json:"results,omitempty"`
+}
+
+// CardCustomFieldValueResponse defines model for CardCustomFieldValueResponse.
+type CardCustomFieldValueResponse = CardCustomFieldValue
+
 // CardResponse defines model for CardResponse.
 type CardResponse = Card
 
+// CustomFieldResponse defines model for CustomFieldResponse.
+type CustomFieldResponse = CustomField
+
+// CustomFieldsListResponse defines model for CustomFieldsListResponse.
+type CustomFieldsListResponse struct {
+	CustomFields *[]CustomField `json:"customFields,omitempty"`
+}
+
+// DataExportResponse defines model for DataExportResponse.
+type DataExportResponse = DataExport
+
 // Forbidden defines model for Forbidden.
 type Forbidden = Error
 
+// ImportBoardResponse defines model for ImportBoardResponse.
+type ImportBoardResponse struct {
+	Board  *Board        `json:"board,omitempty"`
+	Report *ImportReport `json:"report,omitempty"`
+}
+
 // JoinBoardResponse defines model for JoinBoardResponse.
 type JoinBoardResponse struct {
 	Board *Board `json:"board,omitempty"`
 }
 
+// LabelResponse defines model for LabelResponse.
+type LabelResponse = Label
+
+// LabelsListResponse defines model for LabelsListResponse.
+type LabelsListResponse struct {
+	Labels *[]Label `json:"labels,omitempty"`
+}
+
+// ListCardsAffectedResponse defines model for ListCardsAffectedResponse.
+type ListCardsAffectedResponse struct {
+	// Count Number of cards affected
+	Count *int `json:"count,omitempty"`
+}
+
 // ListResponse defines model for ListResponse.
 type ListResponse = List
 
@@ -269,6 +834,9 @@ type ListWithCardsResponse struct {
 	// Position Position for ordering lists
 	Position  *float32   `json:"position,omitempty"`
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// WipLimit Maximum number of active cards in the list, omitted when unlimited
+	WipLimit *int `json:"wipLimit,omitempty"`
 }
 
 // LoginResponse defines model for LoginResponse.
@@ -279,17 +847,65 @@ type LoginResponse struct {
 	// ExpiresIn Access token expiration time in seconds
 	ExpiresIn *int `json:"expiresIn,omitempty"`
 
+	// MfaRequired A second factor is required, complete the login at /auth/mfa/verify
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+	// MfaToken Short-lived challenge token for /auth/mfa/verify
+	MfaToken *string `json:"mfaToken,omitempty"`
+
+	// MfaTokenExpiresIn Challenge token expiration time in seconds
+	MfaTokenExpiresIn *int `json:"mfaTokenExpiresIn,omitempty"`
+
 	// RefreshToken JWT refresh token
 	RefreshToken *string `json:"refreshToken,omitempty"`
 	User         *Member `json:"user,omitempty"`
 }
 
+// MFAStatusResponse defines model for MFAStatusResponse.
+type MFAStatusResponse struct {
+	ConfirmedAt            *time.Time `json:"confirmedAt,omitempty"`
+	Enabled                *bool      `json:"enabled,omitempty"`
+	RecoveryCodesRemaining *int       `json:"recoveryCodesRemaining,omitempty"`
+}
+
+// MemberPreferencesResponse defines model for MemberPreferencesResponse.
+type MemberPreferencesResponse = MemberPreferences
+
+// MemberProfileResponse defines model for MemberProfileResponse.
+type MemberProfileResponse = MemberProfile
+
 // MemberResponse defines model for MemberResponse.
 type MemberResponse = Member
 
+// MembersSearchResponse defines model for MembersSearchResponse.
+type MembersSearchResponse struct {
+	Members *[]MemberProfile `json:"members,omitempty"`
+}
+
+// MessageResponse defines model for MessageResponse.
+type MessageResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
 // NotFound defines model for NotFound.
 type NotFound = Error
 
+// NotificationsListResponse defines model for NotificationsListResponse.
+type NotificationsListResponse struct {
+	Limit         *int            `json:"limit,omitempty"`
+	Notifications *[]Notification `json:"notifications,omitempty"`
+	Offset        *int            `json:"offset,omitempty"`
+	Total         *int            `json:"total,omitempty"`
+
+	// UnreadCount Unread notifications of the user, regardless of the filter
+	UnreadCount *int `json:"unreadCount,omitempty"`
+}
+
+// RecoveryCodesResponse defines model for RecoveryCodesResponse.
+type RecoveryCodesResponse struct {
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+}
+
 // RegisterResponse defines model for RegisterResponse.
 type RegisterResponse = Member
 
@@ -299,12 +915,24 @@ type StarBoardResponse struct {
 	Starred *bool   `json:"starred,omitempty"`
 }
 
+// TOTPEnrolmentResponse defines model for TOTPEnrolmentResponse.
+type TOTPEnrolmentResponse struct {
+	// OtpauthUri URI to render as a QR code
+	OtpauthUri *string `json:"otpauthUri,omitempty"`
+
+	// Secret Base32 secret for manual entry
+	Secret *string `json:"secret,omitempty"`
+}
+
 // TokenRefreshResponse defines model for TokenRefreshResponse.
 type TokenRefreshResponse struct {
 	AccessToken *string `json:"accessToken,omitempty"`
 	ExpiresIn   *int    `json:"expiresIn,omitempty"`
 }
 
+// TooManyAttempts defines model for TooManyAttempts.
+type TooManyAttempts = Error
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = Error
 
@@ -314,6 +942,59 @@ type UnstarBoardResponse struct {
 	Starred *bool   `json:"starred,omitempty"`
 }
 
+// UnwatchResponse defines model for UnwatchResponse.
+type UnwatchResponse struct {
+	Message  *string `json:"message,omitempty"`
+	Watching *bool   `json:"watching,omitempty"`
+}
+
+// WIPLimitExceeded defines model for WIPLimitExceeded.
+type WIPLimitExceeded = Error
+
+// WatchResponse defines model for WatchResponse.
+type WatchResponse struct {
+	Message  *string `json:"message,omitempty"`
+	Watching *bool   `json:"watching,omitempty"`
+}
+
+// WebhookCreatedResponse defines model for WebhookCreatedResponse.
+type WebhookCreatedResponse struct {
+	// Secret Signing secret, shown only once
+	Secret  *string  `json:"secret,omitempty"`
+	Webhook *Webhook `json:"webhook,omitempty"`
+}
+
+// WebhookDeliveryLogResponse defines model for WebhookDeliveryLogResponse.
+type WebhookDeliveryLogResponse = WebhookDeliveryLog
+
+// WebhookDeliveryLogsResponse defines model for WebhookDeliveryLogsResponse.
+type WebhookDeliveryLogsResponse struct {
+	Deliveries *[]WebhookDeliveryLog `json:"deliveries,omitempty"`
+	Limit      *int                  `json:"limit,omitempty"`
+	Offset     *int                  `json:"offset,omitempty"`
+	Total      *int                  `json:"total,omitempty"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse = Webhook
+
+// WebhooksListResponse defines model for WebhooksListResponse.
+type WebhooksListResponse struct {
+	Webhooks *[]Webhook `json:"webhooks,omitempty"`
+}
+
+// GetAuthOidcProviderCallbackParams defines parameters for GetAuthOidcProviderCallback.
+type GetAuthOidcProviderCallbackParams struct {
+	// Code Authorization code issued by the provider
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// State State returned by the provider unchanged
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Error Error code when the provider login failed or was cancelled
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
 // GetBoardsParams defines parameters for GetBoards.
 type GetBoardsParams struct {
 	// Starred Filter by starred boards only
@@ -322,6 +1003,20 @@ type GetBoardsParams struct {
 	Offset  *int  `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetBoardsIdBoardExportParams defines parameters for GetBoardsIdBoardExport.
+type GetBoardsIdBoardExportParams struct {
+	Format *GetBoardsIdBoardExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBoardsIdBoardExportParamsFormat defines parameters for GetBoardsIdBoardExport.
+type GetBoardsIdBoardExportParamsFormat string
+
+// GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams defines parameters for GetBoardsIdBoardWebhooksIdWebhookDeliveries.
+type GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // PostCardsParams defines parameters for PostCards.
 type PostCardsParams struct {
 	IdList openapi_types.UUID `form:"idList" json:"idList"`
@@ -332,39 +1027,120 @@ type PostListsParams struct {
 	IdBoard openapi_types.UUID `form:"idBoard" json:"idBoard"`
 }
 
+// GetMembersParams defines parameters for GetMembers.
+type GetMembersParams struct {
+	// Query Username or full name prefix, or an exact email address
+	Query string `form:"query" json:"query"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetMembersMeExportParams defines parameters for GetMembersMeExport.
+type GetMembersMeExportParams struct {
+	// Format json or zip
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetMembersMeNotificationsParams defines parameters for GetMembersMeNotifications.
+type GetMembersMeNotificationsParams struct {
+	// Unread Filter by unread notifications only
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int  `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
 type PostAuthLoginJSONRequestBody = LoginRequest
 
+// PostAuthMfaVerifyJSONRequestBody defines body for PostAuthMfaVerify for application/json ContentType.
+type PostAuthMfaVerifyJSONRequestBody = VerifyMFARequest
+
+// PostAuthPasswordForgotJSONRequestBody defines body for PostAuthPasswordForgot for application/json ContentType.
+type PostAuthPasswordForgotJSONRequestBody = ForgotPasswordRequest
+
+// PostAuthPasswordResetJSONRequestBody defines body for PostAuthPasswordReset for application/json ContentType.
+type PostAuthPasswordResetJSONRequestBody = ResetPasswordRequest
+
 // PostAuthRefreshJSONRequestBody defines body for PostAuthRefresh for application/json ContentType.
 type PostAuthRefreshJSONRequestBody = TokenRefreshRequest
 
 // PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
 type PostAuthRegisterJSONRequestBody = RegisterRequest
 
+// PostAuthVerifyEmailJSONRequestBody defines body for PostAuthVerifyEmail for application/json ContentType.
+type PostAuthVerifyEmailJSONRequestBody = VerifyEmailRequest
+
+// PostAuthVerifyEmailResendJSONRequestBody defines body for PostAuthVerifyEmailResend for application/json ContentType.
+type PostAuthVerifyEmailResendJSONRequestBody = ResendVerificationRequest
+
 // PostBoardsJSONRequestBody defines body for PostBoards for application/json ContentType.
 type PostBoardsJSONRequestBody = CreateBoardRequest
 
+// PostBoardsImportJSONRequestBody defines body for PostBoardsImport for application/json ContentType.
+type PostBoardsImportJSONRequestBody = ImportBoardRequest
+
 // PutBoardsIdBoardJSONRequestBody defines body for PutBoardsIdBoard for application/json ContentType.
 type PutBoardsIdBoardJSONRequestBody = UpdateBoardRequest
 
-// PostCardsJSONRequestBody defines body for PostCards for application/json ContentType.
+// PostBoardsIdBoardCustomFieldsJSONRequestBody defines body for PostBoardsIdBoardCustomFields for application/json ContentType.
+type PostBoardsIdBoardCustomFieldsJSONRequestBody = CreateCustomFieldRequest
+
+// PutBoardsIdBoardCustomFieldsIdFieldJSONRequestBody defines body for PutBoardsIdBoardCustomFieldsIdField for application/json ContentType.
+type PutBoardsIdBoardCustomFieldsIdFieldJSONRequestBody = UpdateCustomFieldRequest
+
+// PostBoardsIdBoardLabelsJSONRequestBody defines body for PostBoardsIdBoardLabels for application/json ContentType.
+type PostBoardsIdBoardLabelsJSONRequestBody = CreateLabelRequest
+
+// PostBoardsIdBoardWebhooksJSONRequestBody defines body for PostBoardsIdBoardWebhooks for application/json ContentType.
+type PostBoardsIdBoardWebhooksJSONRequestBody = CreateWebhookRequest
+
+// PutBoardsIdBoardWebhooksIdWebhookJSONRequestBody defines body for PutBoardsIdBoardWebhooksIdWebhook for application/json ContentType.
+type PutBoardsIdBoardWebhooksIdWebhookJSONRequestBody = UpdateWebhookRequest
+
+// PostCardsJSONRequestBody defines body for PostCards for application/json ContentType.
 type PostCardsJSONRequestBody = CreateCardRequest
 
+// PostCardsBulkJSONRequestBody defines body for PostCardsBulk for application/json ContentType.
+type PostCardsBulkJSONRequestBody = BulkCardRequest
+
 // PutCardsIdCardJSONRequestBody defines body for PutCardsIdCard for application/json ContentType.
 type PutCardsIdCardJSONRequestBody = UpdateCardRequest
 
+// PutCardsIdCardCustomFieldsIdFieldJSONRequestBody defines body for PutCardsIdCardCustomFieldsIdField for application/json ContentType.
+type PutCardsIdCardCustomFieldsIdFieldJSONRequestBody = SetCustomFieldValueRequest
+
 // PostListsJSONRequestBody defines body for PostLists for application/json ContentType.
 type PostListsJSONRequestBody = CreateListRequest
 
 // PutListsIdListJSONRequestBody defines body for PutListsIdList for application/json ContentType.
 type PutListsIdListJSONRequestBody = UpdateListRequest
 
+// PostListsIdListMoveAllCardsJSONRequestBody defines body for PostListsIdListMoveAllCards for application/json ContentType.
+type PostListsIdListMoveAllCardsJSONRequestBody = MoveAllCardsRequest
+
 // PostMembersBoardsNameBoardUniqueJoinJSONRequestBody defines body for PostMembersBoardsNameBoardUniqueJoin for application/json ContentType.
 type PostMembersBoardsNameBoardUniqueJoinJSONRequestBody = JoinBoardRequest
 
+// DeleteMembersMeJSONRequestBody defines body for DeleteMembersMe for application/json ContentType.
+type DeleteMembersMeJSONRequestBody = DeleteAccountRequest
+
 // PutMembersMeJSONRequestBody defines body for PutMembersMe for application/json ContentType.
 type PutMembersMeJSONRequestBody = UpdateMemberRequest
 
+// PostMembersMeMfaRecoveryCodesJSONRequestBody defines body for PostMembersMeMfaRecoveryCodes for application/json ContentType.
+type PostMembersMeMfaRecoveryCodesJSONRequestBody = MFACodeRequest
+
+// PostMembersMeMfaTotpConfirmJSONRequestBody defines body for PostMembersMeMfaTotpConfirm for application/json ContentType.
+type PostMembersMeMfaTotpConfirmJSONRequestBody = MFACodeRequest
+
+// PostMembersMeMfaTotpDisableJSONRequestBody defines body for PostMembersMeMfaTotpDisable for application/json ContentType.
+type PostMembersMeMfaTotpDisableJSONRequestBody = DisableMFARequest
+
+// PatchMembersMePreferencesJSONRequestBody defines body for PatchMembersMePreferences for application/json ContentType.
+type PatchMembersMePreferencesJSONRequestBody = MemberPreferences
+
+// PostMembersMeTokensJSONRequestBody defines body for PostMembersMeTokens for application/json ContentType.
+type PostMembersMeTokensJSONRequestBody = CreateAccessTokenRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -446,6 +1222,27 @@ type ClientInterface interface {
 
 	PostAuthLogin(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostAuthMfaVerifyWithBody request with any body
+	PostAuthMfaVerifyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthMfaVerify(ctx context.Context, body PostAuthMfaVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthOidcProviderCallback request
+	GetAuthOidcProviderCallback(ctx context.Context, provider string, params *GetAuthOidcProviderCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthOidcProviderStart request
+	GetAuthOidcProviderStart(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthPasswordForgotWithBody request with any body
+	PostAuthPasswordForgotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthPasswordForgot(ctx context.Context, body PostAuthPasswordForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthPasswordResetWithBody request with any body
+	PostAuthPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthPasswordReset(ctx context.Context, body PostAuthPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAuthRefreshWithBody request with any body
 	PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -456,6 +1253,16 @@ type ClientInterface interface {
 
 	PostAuthRegister(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostAuthVerifyEmailWithBody request with any body
+	PostAuthVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthVerifyEmail(ctx context.Context, body PostAuthVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthVerifyEmailResendWithBody request with any body
+	PostAuthVerifyEmailResendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthVerifyEmailResend(ctx context.Context, body PostAuthVerifyEmailResendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetBoards request
 	GetBoards(ctx context.Context, params *GetBoardsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -464,6 +1271,11 @@ type ClientInterface interface {
 
 	PostBoards(ctx context.Context, body PostBoardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostBoardsImportWithBody request with any body
+	PostBoardsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBoardsImport(ctx context.Context, body PostBoardsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteBoardsIdBoard request
 	DeleteBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -475,14 +1287,74 @@ type ClientInterface interface {
 
 	PutBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetBoardsIdBoardCustomFields request
+	GetBoardsIdBoardCustomFields(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBoardsIdBoardCustomFieldsWithBody request with any body
+	PostBoardsIdBoardCustomFieldsWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBoardsIdBoardCustomFields(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardCustomFieldsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBoardsIdBoardCustomFieldsIdField request
+	DeleteBoardsIdBoardCustomFieldsIdField(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBoardsIdBoardCustomFieldsIdFieldWithBody request with any body
+	PutBoardsIdBoardCustomFieldsIdFieldWithBody(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutBoardsIdBoardCustomFieldsIdField(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, body PutBoardsIdBoardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBoardsIdBoardExport request
+	GetBoardsIdBoardExport(ctx context.Context, idBoard openapi_types.UUID, params *GetBoardsIdBoardExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBoardsIdBoardLabels request
+	GetBoardsIdBoardLabels(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBoardsIdBoardLabelsWithBody request with any body
+	PostBoardsIdBoardLabelsWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBoardsIdBoardLabels(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteBoardsIdBoardMembersIdMember request
 	DeleteBoardsIdBoardMembersIdMember(ctx context.Context, idBoard openapi_types.UUID, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteBoardsIdBoardWatch request
+	DeleteBoardsIdBoardWatch(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBoardsIdBoardWatch request
+	PostBoardsIdBoardWatch(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBoardsIdBoardWebhooks request
+	GetBoardsIdBoardWebhooks(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBoardsIdBoardWebhooksWithBody request with any body
+	PostBoardsIdBoardWebhooksWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBoardsIdBoardWebhooks(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBoardsIdBoardWebhooksIdWebhook request
+	DeleteBoardsIdBoardWebhooksIdWebhook(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBoardsIdBoardWebhooksIdWebhookWithBody request with any body
+	PutBoardsIdBoardWebhooksIdWebhookWithBody(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutBoardsIdBoardWebhooksIdWebhook(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, body PutBoardsIdBoardWebhooksIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBoardsIdBoardWebhooksIdWebhookDeliveries request
+	GetBoardsIdBoardWebhooksIdWebhookDeliveries(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params *GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBoardsIdBoardWebhooksIdWebhookTest request
+	PostBoardsIdBoardWebhooksIdWebhookTest(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostCardsWithBody request with any body
 	PostCardsWithBody(ctx context.Context, params *PostCardsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostCards(ctx context.Context, params *PostCardsParams, body PostCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostCardsBulkWithBody request with any body
+	PostCardsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCardsBulk(ctx context.Context, body PostCardsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteCardsIdCard request
 	DeleteCardsIdCard(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -494,6 +1366,17 @@ type ClientInterface interface {
 
 	PutCardsIdCard(ctx context.Context, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PutCardsIdCardCustomFieldsIdFieldWithBody request with any body
+	PutCardsIdCardCustomFieldsIdFieldWithBody(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutCardsIdCardCustomFieldsIdField(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, body PutCardsIdCardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCardsIdCardWatch request
+	DeleteCardsIdCardWatch(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCardsIdCardWatch request
+	PostCardsIdCardWatch(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostListsWithBody request with any body
 	PostListsWithBody(ctx context.Context, params *PostListsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -510,6 +1393,23 @@ type ClientInterface interface {
 
 	PutListsIdList(ctx context.Context, idList openapi_types.UUID, body PutListsIdListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostListsIdListArchiveAllCards request
+	PostListsIdListArchiveAllCards(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostListsIdListMoveAllCardsWithBody request with any body
+	PostListsIdListMoveAllCardsWithBody(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostListsIdListMoveAllCards(ctx context.Context, idList openapi_types.UUID, body PostListsIdListMoveAllCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteListsIdListWatch request
+	DeleteListsIdListWatch(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostListsIdListWatch request
+	PostListsIdListWatch(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembers request
+	GetMembers(ctx context.Context, params *GetMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteMembersBoardsIdBoardStar request
 	DeleteMembersBoardsIdBoardStar(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -521,6 +1421,11 @@ type ClientInterface interface {
 
 	PostMembersBoardsNameBoardUniqueJoin(ctx context.Context, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteMembersMeWithBody request with any body
+	DeleteMembersMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteMembersMe(ctx context.Context, body DeleteMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetMembersMe request
 	GetMembersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -528,6 +1433,70 @@ type ClientInterface interface {
 	PutMembersMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutMembersMe(ctx context.Context, body PutMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutMembersMeAvatarWithBody request with any body
+	PutMembersMeAvatarWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersMeExport request
+	GetMembersMeExport(ctx context.Context, params *GetMembersMeExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersMeExportIdExport request
+	GetMembersMeExportIdExport(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersMeExportIdExportDownload request
+	GetMembersMeExportIdExportDownload(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersMeMfa request
+	GetMembersMeMfa(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMembersMeMfaRecoveryCodesWithBody request with any body
+	PostMembersMeMfaRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMembersMeMfaRecoveryCodes(ctx context.Context, body PostMembersMeMfaRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMembersMeMfaTotp request
+	PostMembersMeMfaTotp(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMembersMeMfaTotpConfirmWithBody request with any body
+	PostMembersMeMfaTotpConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMembersMeMfaTotpConfirm(ctx context.Context, body PostMembersMeMfaTotpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMembersMeMfaTotpDisableWithBody request with any body
+	PostMembersMeMfaTotpDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMembersMeMfaTotpDisable(ctx context.Context, body PostMembersMeMfaTotpDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersMeNotifications request
+	GetMembersMeNotifications(ctx context.Context, params *GetMembersMeNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMembersMeNotificationsReadAll request
+	PostMembersMeNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMembersMeNotificationsIdNotificationRead request
+	PostMembersMeNotificationsIdNotificationRead(ctx context.Context, idNotification openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersMePreferences request
+	GetMembersMePreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchMembersMePreferencesWithBody request with any body
+	PatchMembersMePreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchMembersMePreferences(ctx context.Context, body PatchMembersMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersMeTokens request
+	GetMembersMeTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMembersMeTokensWithBody request with any body
+	PostMembersMeTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMembersMeTokens(ctx context.Context, body PostMembersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMembersMeTokensIdToken request
+	DeleteMembersMeTokensIdToken(ctx context.Context, idToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembersIdMember request
+	GetMembersIdMember(ctx context.Context, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetAlive(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -566,8 +1535,8 @@ func (c *Client) PostAuthLogin(ctx context.Context, body PostAuthLoginJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAuthRefreshRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAuthMfaVerifyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthMfaVerifyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -578,8 +1547,8 @@ func (c *Client) PostAuthRefreshWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAuthRefreshRequest(c.Server, body)
+func (c *Client) PostAuthMfaVerify(ctx context.Context, body PostAuthMfaVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthMfaVerifyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -590,8 +1559,8 @@ func (c *Client) PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAuthRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAuthRegisterRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAuthOidcProviderCallback(ctx context.Context, provider string, params *GetAuthOidcProviderCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthOidcProviderCallbackRequest(c.Server, provider, params)
 	if err != nil {
 		return nil, err
 	}
@@ -602,8 +1571,8 @@ func (c *Client) PostAuthRegisterWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAuthRegister(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAuthRegisterRequest(c.Server, body)
+func (c *Client) GetAuthOidcProviderStart(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthOidcProviderStartRequest(c.Server, provider)
 	if err != nil {
 		return nil, err
 	}
@@ -614,8 +1583,8 @@ func (c *Client) PostAuthRegister(ctx context.Context, body PostAuthRegisterJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBoards(ctx context.Context, params *GetBoardsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBoardsRequest(c.Server, params)
+func (c *Client) PostAuthPasswordForgotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthPasswordForgotRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -626,8 +1595,8 @@ func (c *Client) GetBoards(ctx context.Context, params *GetBoardsParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostBoardsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostBoardsRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAuthPasswordForgot(ctx context.Context, body PostAuthPasswordForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthPasswordForgotRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -638,8 +1607,8 @@ func (c *Client) PostBoardsWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostBoards(ctx context.Context, body PostBoardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostBoardsRequest(c.Server, body)
+func (c *Client) PostAuthPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthPasswordResetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -650,8 +1619,8 @@ func (c *Client) PostBoards(ctx context.Context, body PostBoardsJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteBoardsIdBoardRequest(c.Server, idBoard)
+func (c *Client) PostAuthPasswordReset(ctx context.Context, body PostAuthPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthPasswordResetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -662,8 +1631,8 @@ func (c *Client) DeleteBoardsIdBoard(ctx context.Context, idBoard openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBoardsIdBoardRequest(c.Server, idBoard)
+func (c *Client) PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -674,8 +1643,8 @@ func (c *Client) GetBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutBoardsIdBoardWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutBoardsIdBoardRequestWithBody(c.Server, idBoard, contentType, body)
+func (c *Client) PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -686,8 +1655,8 @@ func (c *Client) PutBoardsIdBoardWithBody(ctx context.Context, idBoard openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutBoardsIdBoardRequest(c.Server, idBoard, body)
+func (c *Client) PostAuthRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRegisterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -698,8 +1667,8 @@ func (c *Client) PutBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteBoardsIdBoardMembersIdMember(ctx context.Context, idBoard openapi_types.UUID, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteBoardsIdBoardMembersIdMemberRequest(c.Server, idBoard, idMember)
+func (c *Client) PostAuthRegister(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRegisterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -710,8 +1679,8 @@ func (c *Client) DeleteBoardsIdBoardMembersIdMember(ctx context.Context, idBoard
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostCardsWithBody(ctx context.Context, params *PostCardsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostCardsRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) PostAuthVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthVerifyEmailRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -722,8 +1691,8 @@ func (c *Client) PostCardsWithBody(ctx context.Context, params *PostCardsParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostCards(ctx context.Context, params *PostCardsParams, body PostCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostCardsRequest(c.Server, params, body)
+func (c *Client) PostAuthVerifyEmail(ctx context.Context, body PostAuthVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthVerifyEmailRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -734,8 +1703,8 @@ func (c *Client) PostCards(ctx context.Context, params *PostCardsParams, body Po
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCardsIdCard(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCardsIdCardRequest(c.Server, idCard)
+func (c *Client) PostAuthVerifyEmailResendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthVerifyEmailResendRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -746,8 +1715,8 @@ func (c *Client) DeleteCardsIdCard(ctx context.Context, idCard openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCardsIdCard(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCardsIdCardRequest(c.Server, idCard)
+func (c *Client) PostAuthVerifyEmailResend(ctx context.Context, body PostAuthVerifyEmailResendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthVerifyEmailResendRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -758,8 +1727,8 @@ func (c *Client) GetCardsIdCard(ctx context.Context, idCard openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutCardsIdCardWithBody(ctx context.Context, idCard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutCardsIdCardRequestWithBody(c.Server, idCard, contentType, body)
+func (c *Client) GetBoards(ctx context.Context, params *GetBoardsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -770,8 +1739,8 @@ func (c *Client) PutCardsIdCardWithBody(ctx context.Context, idCard openapi_type
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutCardsIdCard(ctx context.Context, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutCardsIdCardRequest(c.Server, idCard, body)
+func (c *Client) PostBoardsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -782,8 +1751,8 @@ func (c *Client) PutCardsIdCard(ctx context.Context, idCard openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostListsWithBody(ctx context.Context, params *PostListsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostListsRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) PostBoards(ctx context.Context, body PostBoardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -794,8 +1763,8 @@ func (c *Client) PostListsWithBody(ctx context.Context, params *PostListsParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLists(ctx context.Context, params *PostListsParams, body PostListsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostListsRequest(c.Server, params, body)
+func (c *Client) PostBoardsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsImportRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -806,8 +1775,8 @@ func (c *Client) PostLists(ctx context.Context, params *PostListsParams, body Po
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteListsIdList(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteListsIdListRequest(c.Server, idList)
+func (c *Client) PostBoardsImport(ctx context.Context, body PostBoardsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsImportRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -818,8 +1787,8 @@ func (c *Client) DeleteListsIdList(ctx context.Context, idList openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetListsIdList(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetListsIdListRequest(c.Server, idList)
+func (c *Client) DeleteBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBoardsIdBoardRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
@@ -830,8 +1799,8 @@ func (c *Client) GetListsIdList(ctx context.Context, idList openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutListsIdListWithBody(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutListsIdListRequestWithBody(c.Server, idList, contentType, body)
+func (c *Client) GetBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardsIdBoardRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
@@ -842,8 +1811,8 @@ func (c *Client) PutListsIdListWithBody(ctx context.Context, idList openapi_type
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutListsIdList(ctx context.Context, idList openapi_types.UUID, body PutListsIdListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutListsIdListRequest(c.Server, idList, body)
+func (c *Client) PutBoardsIdBoardWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBoardsIdBoardRequestWithBody(c.Server, idBoard, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -854,8 +1823,8 @@ func (c *Client) PutListsIdList(ctx context.Context, idList openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMembersBoardsIdBoardStar(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMembersBoardsIdBoardStarRequest(c.Server, idBoard)
+func (c *Client) PutBoardsIdBoard(ctx context.Context, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBoardsIdBoardRequest(c.Server, idBoard, body)
 	if err != nil {
 		return nil, err
 	}
@@ -866,8 +1835,8 @@ func (c *Client) DeleteMembersBoardsIdBoardStar(ctx context.Context, idBoard ope
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostMembersBoardsIdBoardStar(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostMembersBoardsIdBoardStarRequest(c.Server, idBoard)
+func (c *Client) GetBoardsIdBoardCustomFields(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardsIdBoardCustomFieldsRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
@@ -878,8 +1847,8 @@ func (c *Client) PostMembersBoardsIdBoardStar(ctx context.Context, idBoard opena
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostMembersBoardsNameBoardUniqueJoinWithBody(ctx context.Context, nameBoardUnique string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody(c.Server, nameBoardUnique, contentType, body)
+func (c *Client) PostBoardsIdBoardCustomFieldsWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardCustomFieldsRequestWithBody(c.Server, idBoard, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -890,8 +1859,8 @@ func (c *Client) PostMembersBoardsNameBoardUniqueJoinWithBody(ctx context.Contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostMembersBoardsNameBoardUniqueJoin(ctx context.Context, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostMembersBoardsNameBoardUniqueJoinRequest(c.Server, nameBoardUnique, body)
+func (c *Client) PostBoardsIdBoardCustomFields(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardCustomFieldsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardCustomFieldsRequest(c.Server, idBoard, body)
 	if err != nil {
 		return nil, err
 	}
@@ -902,8 +1871,8 @@ func (c *Client) PostMembersBoardsNameBoardUniqueJoin(ctx context.Context, nameB
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMembersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMembersMeRequest(c.Server)
+func (c *Client) DeleteBoardsIdBoardCustomFieldsIdField(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBoardsIdBoardCustomFieldsIdFieldRequest(c.Server, idBoard, idField)
 	if err != nil {
 		return nil, err
 	}
@@ -914,8 +1883,8 @@ func (c *Client) GetMembersMe(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutMembersMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutMembersMeRequestWithBody(c.Server, contentType, body)
+func (c *Client) PutBoardsIdBoardCustomFieldsIdFieldWithBody(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBoardsIdBoardCustomFieldsIdFieldRequestWithBody(c.Server, idBoard, idField, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -926,8 +1895,8 @@ func (c *Client) PutMembersMeWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutMembersMe(ctx context.Context, body PutMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutMembersMeRequest(c.Server, body)
+func (c *Client) PutBoardsIdBoardCustomFieldsIdField(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, body PutBoardsIdBoardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBoardsIdBoardCustomFieldsIdFieldRequest(c.Server, idBoard, idField, body)
 	if err != nil {
 		return nil, err
 	}
@@ -938,893 +1907,860 @@ func (c *Client) PutMembersMe(ctx context.Context, body PutMembersMeJSONRequestB
 	return c.Client.Do(req)
 }
 
-// NewGetAliveRequest generates requests for GetAlive
-func NewGetAliveRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetBoardsIdBoardExport(ctx context.Context, idBoard openapi_types.UUID, params *GetBoardsIdBoardExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardsIdBoardExportRequest(c.Server, idBoard, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/alive")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetBoardsIdBoardLabels(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardsIdBoardLabelsRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostAuthLoginRequest calls the generic PostAuthLogin builder with application/json body
-func NewPostAuthLoginRequest(server string, body PostAuthLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostBoardsIdBoardLabelsWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardLabelsRequestWithBody(c.Server, idBoard, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthLoginRequestWithBody generates requests for PostAuthLogin with any type of body
-func NewPostAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostBoardsIdBoardLabels(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardLabelsRequest(c.Server, idBoard, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteBoardsIdBoardMembersIdMember(ctx context.Context, idBoard openapi_types.UUID, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBoardsIdBoardMembersIdMemberRequest(c.Server, idBoard, idMember)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRefreshRequest calls the generic PostAuthRefresh builder with application/json body
-func NewPostAuthRefreshRequest(server string, body PostAuthRefreshJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteBoardsIdBoardWatch(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBoardsIdBoardWatchRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthRefreshRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRefreshRequestWithBody generates requests for PostAuthRefresh with any type of body
-func NewPostAuthRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostBoardsIdBoardWatch(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardWatchRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetBoardsIdBoardWebhooks(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardsIdBoardWebhooksRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRegisterRequest calls the generic PostAuthRegister builder with application/json body
-func NewPostAuthRegisterRequest(server string, body PostAuthRegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostBoardsIdBoardWebhooksWithBody(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardWebhooksRequestWithBody(c.Server, idBoard, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthRegisterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthRegisterRequestWithBody generates requests for PostAuthRegister with any type of body
-func NewPostAuthRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostBoardsIdBoardWebhooks(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardWebhooksRequest(c.Server, idBoard, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteBoardsIdBoardWebhooksIdWebhook(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBoardsIdBoardWebhooksIdWebhookRequest(c.Server, idBoard, idWebhook)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetBoardsRequest generates requests for GetBoards
-func NewGetBoardsRequest(server string, params *GetBoardsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutBoardsIdBoardWebhooksIdWebhookWithBody(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBoardsIdBoardWebhooksIdWebhookRequestWithBody(c.Server, idBoard, idWebhook, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/boards")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutBoardsIdBoardWebhooksIdWebhook(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, body PutBoardsIdBoardWebhooksIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBoardsIdBoardWebhooksIdWebhookRequest(c.Server, idBoard, idWebhook, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Starred != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "starred", runtime.ParamLocationQuery, *params.Starred); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetBoardsIdBoardWebhooksIdWebhookDeliveries(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params *GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardsIdBoardWebhooksIdWebhookDeliveriesRequest(c.Server, idBoard, idWebhook, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostBoardsRequest calls the generic PostBoards builder with application/json body
-func NewPostBoardsRequest(server string, body PostBoardsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostBoardsIdBoardWebhooksIdWebhookTest(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBoardsIdBoardWebhooksIdWebhookTestRequest(c.Server, idBoard, idWebhook)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostBoardsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostBoardsRequestWithBody generates requests for PostBoards with any type of body
-func NewPostBoardsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostCardsWithBody(ctx context.Context, params *PostCardsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCardsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/boards")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostCards(ctx context.Context, params *PostCardsParams, body PostCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCardsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteBoardsIdBoardRequest generates requests for DeleteBoardsIdBoard
-func NewDeleteBoardsIdBoardRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+func (c *Client) PostCardsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCardsBulkRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostCardsBulk(ctx context.Context, body PostCardsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCardsBulkRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/boards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteCardsIdCard(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCardsIdCardRequest(c.Server, idCard)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetCardsIdCard(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCardsIdCardRequest(c.Server, idCard)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetBoardsIdBoardRequest generates requests for GetBoardsIdBoard
-func NewGetBoardsIdBoardRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+func (c *Client) PutCardsIdCardWithBody(ctx context.Context, idCard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCardsIdCardRequestWithBody(c.Server, idCard, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutCardsIdCard(ctx context.Context, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCardsIdCardRequest(c.Server, idCard, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/boards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutCardsIdCardCustomFieldsIdFieldWithBody(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCardsIdCardCustomFieldsIdFieldRequestWithBody(c.Server, idCard, idField, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PutCardsIdCardCustomFieldsIdField(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, body PutCardsIdCardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCardsIdCardCustomFieldsIdFieldRequest(c.Server, idCard, idField, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutBoardsIdBoardRequest calls the generic PutBoardsIdBoard builder with application/json body
-func NewPutBoardsIdBoardRequest(server string, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteCardsIdCardWatch(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCardsIdCardWatchRequest(c.Server, idCard)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutBoardsIdBoardRequestWithBody(server, idBoard, "application/json", bodyReader)
-}
-
-// NewPutBoardsIdBoardRequestWithBody generates requests for PutBoardsIdBoard with any type of body
-func NewPutBoardsIdBoardRequestWithBody(server string, idBoard openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostCardsIdCardWatch(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCardsIdCardWatchRequest(c.Server, idCard)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/boards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostListsWithBody(ctx context.Context, params *PostListsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostListsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteBoardsIdBoardMembersIdMemberRequest generates requests for DeleteBoardsIdBoardMembersIdMember
-func NewDeleteBoardsIdBoardMembersIdMemberRequest(server string, idBoard openapi_types.UUID, idMember openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+func (c *Client) PostLists(ctx context.Context, params *PostListsParams, body PostListsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostListsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idMember", runtime.ParamLocationPath, idMember)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteListsIdList(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteListsIdListRequest(c.Server, idList)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/boards/%s/members/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetListsIdList(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetListsIdListRequest(c.Server, idList)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostCardsRequest calls the generic PostCards builder with application/json body
-func NewPostCardsRequest(server string, params *PostCardsParams, body PostCardsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutListsIdListWithBody(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutListsIdListRequestWithBody(c.Server, idList, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostCardsRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostCardsRequestWithBody generates requests for PostCards with any type of body
-func NewPostCardsRequestWithBody(server string, params *PostCardsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutListsIdList(ctx context.Context, idList openapi_types.UUID, body PutListsIdListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutListsIdListRequest(c.Server, idList, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cards")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostListsIdListArchiveAllCards(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostListsIdListArchiveAllCardsRequest(c.Server, idList)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "idList", runtime.ParamLocationQuery, params.IdList); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostListsIdListMoveAllCardsWithBody(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostListsIdListMoveAllCardsRequestWithBody(c.Server, idList, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteCardsIdCardRequest generates requests for DeleteCardsIdCard
-func NewDeleteCardsIdCardRequest(server string, idCard openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
+func (c *Client) PostListsIdListMoveAllCards(ctx context.Context, idList openapi_types.UUID, body PostListsIdListMoveAllCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostListsIdListMoveAllCardsRequest(c.Server, idList, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteListsIdListWatch(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteListsIdListWatchRequest(c.Server, idList)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostListsIdListWatch(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostListsIdListWatchRequest(c.Server, idList)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCardsIdCardRequest generates requests for GetCardsIdCard
-func NewGetCardsIdCardRequest(server string, idCard openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
+func (c *Client) GetMembers(ctx context.Context, params *GetMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteMembersBoardsIdBoardStar(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMembersBoardsIdBoardStarRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostMembersBoardsIdBoardStar(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersBoardsIdBoardStarRequest(c.Server, idBoard)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutCardsIdCardRequest calls the generic PutCardsIdCard builder with application/json body
-func NewPutCardsIdCardRequest(server string, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostMembersBoardsNameBoardUniqueJoinWithBody(ctx context.Context, nameBoardUnique string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody(c.Server, nameBoardUnique, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutCardsIdCardRequestWithBody(server, idCard, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutCardsIdCardRequestWithBody generates requests for PutCardsIdCard with any type of body
-func NewPutCardsIdCardRequestWithBody(server string, idCard openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
+func (c *Client) PostMembersBoardsNameBoardUniqueJoin(ctx context.Context, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersBoardsNameBoardUniqueJoinRequest(c.Server, nameBoardUnique, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteMembersMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMembersMeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteMembersMe(ctx context.Context, body DeleteMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMembersMeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewPostListsRequest calls the generic PostLists builder with application/json body
-func NewPostListsRequest(server string, params *PostListsParams, body PostListsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetMembersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostListsRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostListsRequestWithBody generates requests for PostLists with any type of body
-func NewPostListsRequestWithBody(server string, params *PostListsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutMembersMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMembersMeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/lists")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutMembersMe(ctx context.Context, body PutMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMembersMeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "idBoard", runtime.ParamLocationQuery, params.IdBoard); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteListsIdListRequest generates requests for DeleteListsIdList
-func NewDeleteListsIdListRequest(server string, idList openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+func (c *Client) PutMembersMeAvatarWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMembersMeAvatarRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMembersMeExport(ctx context.Context, params *GetMembersMeExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMeExportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/lists/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetMembersMeExportIdExport(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMeExportIdExportRequest(c.Server, idExport)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetListsIdListRequest generates requests for GetListsIdList
-func NewGetListsIdListRequest(server string, idList openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+func (c *Client) GetMembersMeExportIdExportDownload(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMeExportIdExportDownloadRequest(c.Server, idExport)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMembersMeMfa(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMeMfaRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/lists/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostMembersMeMfaRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeMfaRecoveryCodesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutListsIdListRequest calls the generic PutListsIdList builder with application/json body
-func NewPutListsIdListRequest(server string, idList openapi_types.UUID, body PutListsIdListJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostMembersMeMfaRecoveryCodes(ctx context.Context, body PostMembersMeMfaRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeMfaRecoveryCodesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutListsIdListRequestWithBody(server, idList, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutListsIdListRequestWithBody generates requests for PutListsIdList with any type of body
-func NewPutListsIdListRequestWithBody(server string, idList openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+func (c *Client) PostMembersMeMfaTotp(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeMfaTotpRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostMembersMeMfaTotpConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeMfaTotpConfirmRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/lists/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostMembersMeMfaTotpConfirm(ctx context.Context, body PostMembersMeMfaTotpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeMfaTotpConfirmRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteMembersBoardsIdBoardStarRequest generates requests for DeleteMembersBoardsIdBoardStar
-func NewDeleteMembersBoardsIdBoardStarRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+func (c *Client) PostMembersMeMfaTotpDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeMfaTotpDisableRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostMembersMeMfaTotpDisable(ctx context.Context, body PostMembersMeMfaTotpDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeMfaTotpDisableRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/members/boards/%s/star", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetMembersMeNotifications(ctx context.Context, params *GetMembersMeNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMeNotificationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostMembersBoardsIdBoardStarRequest generates requests for PostMembersBoardsIdBoardStar
-func NewPostMembersBoardsIdBoardStarRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+func (c *Client) PostMembersMeNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeNotificationsReadAllRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostMembersMeNotificationsIdNotificationRead(ctx context.Context, idNotification openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeNotificationsIdNotificationReadRequest(c.Server, idNotification)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/members/boards/%s/star", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetMembersMePreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMePreferencesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostMembersBoardsNameBoardUniqueJoinRequest calls the generic PostMembersBoardsNameBoardUniqueJoin builder with application/json body
-func NewPostMembersBoardsNameBoardUniqueJoinRequest(server string, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PatchMembersMePreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchMembersMePreferencesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody(server, nameBoardUnique, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody generates requests for PostMembersBoardsNameBoardUniqueJoin with any type of body
-func NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody(server string, nameBoardUnique string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "nameBoardUnique", runtime.ParamLocationPath, nameBoardUnique)
+func (c *Client) PatchMembersMePreferences(ctx context.Context, body PatchMembersMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchMembersMePreferencesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMembersMeTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersMeTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/members/boards/%s/join", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostMembersMeTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeTokensRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostMembersMeTokens(ctx context.Context, body PostMembersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMembersMeTokensRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) DeleteMembersMeTokensIdToken(ctx context.Context, idToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMembersMeTokensIdTokenRequest(c.Server, idToken)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetMembersIdMember(ctx context.Context, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembersIdMemberRequest(c.Server, idMember)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetMembersMeRequest generates requests for GetMembersMe
-func NewGetMembersMeRequest(server string) (*http.Request, error) {
+// NewGetAliveRequest generates requests for GetAlive
+func NewGetAliveRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1832,7 +2768,7 @@ func NewGetMembersMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/members/me")
+	operationPath := fmt.Sprintf("/alive")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1850,19 +2786,19 @@ func NewGetMembersMeRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPutMembersMeRequest calls the generic PutMembersMe builder with application/json body
-func NewPutMembersMeRequest(server string, body PutMembersMeJSONRequestBody) (*http.Request, error) {
+// NewPostAuthLoginRequest calls the generic PostAuthLogin builder with application/json body
+func NewPostAuthLoginRequest(server string, body PostAuthLoginJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutMembersMeRequestWithBody(server, "application/json", bodyReader)
+	return NewPostAuthLoginRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutMembersMeRequestWithBody generates requests for PutMembersMe with any type of body
-func NewPutMembersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthLoginRequestWithBody generates requests for PostAuthLogin with any type of body
+func NewPostAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1870,7 +2806,7 @@ func NewPutMembersMeRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/members/me")
+	operationPath := fmt.Sprintf("/auth/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1880,7 +2816,7 @@ func NewPutMembersMeRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1890,2225 +2826,11402 @@ func NewPutMembersMeRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPostAuthMfaVerifyRequest calls the generic PostAuthMfaVerify builder with application/json body
+func NewPostAuthMfaVerifyRequest(server string, body PostAuthMfaVerifyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthMfaVerifyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewPostAuthMfaVerifyRequestWithBody generates requests for PostAuthMfaVerify with any type of body
+func NewPostAuthMfaVerifyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/auth/mfa/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAliveWithResponse request
-	GetAliveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAliveResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostAuthLoginWithBodyWithResponse request with any body
-	PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PostAuthRefreshWithBodyWithResponse request with any body
-	PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+	return req, nil
+}
 
-	PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+// NewGetAuthOidcProviderCallbackRequest generates requests for GetAuthOidcProviderCallback
+func NewGetAuthOidcProviderCallbackRequest(server string, provider string, params *GetAuthOidcProviderCallbackParams) (*http.Request, error) {
+	var err error
 
-	// PostAuthRegisterWithBodyWithResponse request with any body
-	PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+	var pathParam0 string
 
-	PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetBoardsWithResponse request
-	GetBoardsWithResponse(ctx context.Context, params *GetBoardsParams, reqEditors ...RequestEditorFn) (*GetBoardsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostBoardsWithBodyWithResponse request with any body
-	PostBoardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error)
+	operationPath := fmt.Sprintf("/auth/oidc/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PostBoardsWithResponse(ctx context.Context, body PostBoardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteBoardsIdBoardWithResponse request
-	DeleteBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetBoardsIdBoardWithResponse request
-	GetBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardResponse, error)
+		if params.Code != nil {
 
-	// PutBoardsIdBoardWithBodyWithResponse request with any body
-	PutBoardsIdBoardWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	PutBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error)
+		}
 
-	// DeleteBoardsIdBoardMembersIdMemberWithResponse request
-	DeleteBoardsIdBoardMembersIdMemberWithResponse(ctx context.Context, idBoard openapi_types.UUID, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardMembersIdMemberResponse, error)
+		if params.State != nil {
 
-	// PostCardsWithBodyWithResponse request with any body
-	PostCardsWithBodyWithResponse(ctx context.Context, params *PostCardsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCardsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	PostCardsWithResponse(ctx context.Context, params *PostCardsParams, body PostCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCardsResponse, error)
+		}
 
-	// DeleteCardsIdCardWithResponse request
-	DeleteCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCardsIdCardResponse, error)
+		if params.Error != nil {
 
-	// GetCardsIdCardWithResponse request
-	GetCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetCardsIdCardResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// PutCardsIdCardWithBodyWithResponse request with any body
-	PutCardsIdCardWithBodyWithResponse(ctx context.Context, idCard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error)
+		}
 
-	PutCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// PostListsWithBodyWithResponse request with any body
-	PostListsWithBodyWithResponse(ctx context.Context, params *PostListsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostListsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostListsWithResponse(ctx context.Context, params *PostListsParams, body PostListsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostListsResponse, error)
+	return req, nil
+}
 
-	// DeleteListsIdListWithResponse request
-	DeleteListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteListsIdListResponse, error)
+// NewGetAuthOidcProviderStartRequest generates requests for GetAuthOidcProviderStart
+func NewGetAuthOidcProviderStartRequest(server string, provider string) (*http.Request, error) {
+	var err error
 
-	// GetListsIdListWithResponse request
-	GetListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetListsIdListResponse, error)
+	var pathParam0 string
 
-	// PutListsIdListWithBodyWithResponse request with any body
-	PutListsIdListWithBodyWithResponse(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
 
-	PutListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, body PutListsIdListJSONRequestBody, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMembersBoardsIdBoardStarWithResponse request
-	DeleteMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMembersBoardsIdBoardStarResponse, error)
+	operationPath := fmt.Sprintf("/auth/oidc/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostMembersBoardsIdBoardStarWithResponse request
-	PostMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostMembersBoardsIdBoardStarResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse request with any body
-	PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse(ctx context.Context, nameBoardUnique string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostMembersBoardsNameBoardUniqueJoinWithResponse(ctx context.Context, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error)
+	return req, nil
+}
 
-	// GetMembersMeWithResponse request
-	GetMembersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeResponse, error)
-
-	// PutMembersMeWithBodyWithResponse request with any body
-	PutMembersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error)
-
-	PutMembersMeWithResponse(ctx context.Context, body PutMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error)
+// NewPostAuthPasswordForgotRequest calls the generic PostAuthPasswordForgot builder with application/json body
+func NewPostAuthPasswordForgotRequest(server string, body PostAuthPasswordForgotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthPasswordForgotRequestWithBody(server, "application/json", bodyReader)
 }
 
-type GetAliveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewPostAuthPasswordForgotRequestWithBody generates requests for PostAuthPasswordForgot with any type of body
+func NewPostAuthPasswordForgotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetAliveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAliveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/auth/password/forgot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostAuthLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostAuthLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAuthPasswordResetRequest calls the generic PostAuthPasswordReset builder with application/json body
+func NewPostAuthPasswordResetRequest(server string, body PostAuthPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthPasswordResetRequestWithBody(server, "application/json", bodyReader)
 }
 
-type PostAuthRefreshResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TokenRefreshResponse
-	JSON401      *Unauthorized
-}
+// NewPostAuthPasswordResetRequestWithBody generates requests for PostAuthPasswordReset with any type of body
+func NewPostAuthPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostAuthRefreshResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthRefreshResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/auth/password/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostAuthRegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *RegisterResponse
-	JSON400      *BadRequest
-	JSON409      *Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostAuthRegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthRegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAuthRefreshRequest calls the generic PostAuthRefresh builder with application/json body
+func NewPostAuthRefreshRequest(server string, body PostAuthRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthRefreshRequestWithBody(server, "application/json", bodyReader)
 }
 
-type GetBoardsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BoardsListResponse
-	JSON401      *Unauthorized
-}
+// NewPostAuthRefreshRequestWithBody generates requests for PostAuthRefresh with any type of body
+func NewPostAuthRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetBoardsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBoardsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostBoardsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *BoardResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostBoardsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostBoardsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAuthRegisterRequest calls the generic PostAuthRegister builder with application/json body
+func NewPostAuthRegisterRequest(server string, body PostAuthRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthRegisterRequestWithBody(server, "application/json", bodyReader)
 }
 
-type DeleteBoardsIdBoardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
+// NewPostAuthRegisterRequestWithBody generates requests for PostAuthRegister with any type of body
+func NewPostAuthRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteBoardsIdBoardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBoardsIdBoardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/auth/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetBoardsIdBoardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BoardWithDetailsResponse
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetBoardsIdBoardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBoardsIdBoardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAuthVerifyEmailRequest calls the generic PostAuthVerifyEmail builder with application/json body
+func NewPostAuthVerifyEmailRequest(server string, body PostAuthVerifyEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthVerifyEmailRequestWithBody(server, "application/json", bodyReader)
 }
 
-type PutBoardsIdBoardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BoardResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
+// NewPostAuthVerifyEmailRequestWithBody generates requests for PostAuthVerifyEmail with any type of body
+func NewPostAuthVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PutBoardsIdBoardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutBoardsIdBoardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/auth/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type DeleteBoardsIdBoardMembersIdMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteBoardsIdBoardMembersIdMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBoardsIdBoardMembersIdMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostCardsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CardResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *Error
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PostCardsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostCardsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAuthVerifyEmailResendRequest calls the generic PostAuthVerifyEmailResend builder with application/json body
+func NewPostAuthVerifyEmailResendRequest(server string, body PostAuthVerifyEmailResendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthVerifyEmailResendRequestWithBody(server, "application/json", bodyReader)
 }
 
-type DeleteCardsIdCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
+// NewPostAuthVerifyEmailResendRequestWithBody generates requests for PostAuthVerifyEmailResend with any type of body
+func NewPostAuthVerifyEmailResendRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteCardsIdCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCardsIdCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/auth/verify-email/resend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type GetCardsIdCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CardResponse
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
 
-// Status returns HTTPResponse.Status
-func (r GetCardsIdCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCardsIdCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutCardsIdCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CardResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
-
-// Status returns HTTPResponse.Status
-func (r PutCardsIdCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutCardsIdCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type PostListsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ListResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *Error
-}
+// NewGetBoardsRequest generates requests for GetBoards
+func NewGetBoardsRequest(server string, params *GetBoardsParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostListsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostListsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/boards")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type DeleteListsIdListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteListsIdListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteListsIdListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type GetListsIdListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListWithCardsResponse
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
+		if params.Starred != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetListsIdListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "starred", runtime.ParamLocationQuery, *params.Starred); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetListsIdListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		}
 
-type PutListsIdListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-}
+		if params.Limit != nil {
 
-// Status returns HTTPResponse.Status
-func (r PutListsIdListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutListsIdListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		}
 
-type DeleteMembersBoardsIdBoardStarResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UnstarBoardResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+		if params.Offset != nil {
 
-// Status returns HTTPResponse.Status
-func (r DeleteMembersBoardsIdBoardStarResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMembersBoardsIdBoardStarResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostMembersBoardsIdBoardStarResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StarBoardResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostMembersBoardsIdBoardStarResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostBoardsRequest calls the generic PostBoards builder with application/json body
+func NewPostBoardsRequest(server string, body PostBoardsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBoardsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostMembersBoardsIdBoardStarResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostBoardsRequestWithBody generates requests for PostBoards with any type of body
+func NewPostBoardsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostMembersBoardsNameBoardUniqueJoinResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JoinBoardResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Error
-	JSON404      *NotFound
-	JSON409      *Error
-}
+	operationPath := fmt.Sprintf("/boards")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostMembersBoardsNameBoardUniqueJoinResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostMembersBoardsNameBoardUniqueJoinResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMembersMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MemberResponse
-	JSON401      *Unauthorized
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetMembersMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMembersMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostBoardsImportRequest calls the generic PostBoardsImport builder with application/json body
+func NewPostBoardsImportRequest(server string, body PostBoardsImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBoardsImportRequestWithBody(server, "application/json", bodyReader)
 }
 
-type PutMembersMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MemberResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON409      *Error
-}
+// NewPostBoardsImportRequestWithBody generates requests for PostBoardsImport with any type of body
+func NewPostBoardsImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PutMembersMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutMembersMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/boards/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// GetAliveWithResponse request returning *GetAliveResponse
-func (c *ClientWithResponses) GetAliveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAliveResponse, error) {
-	rsp, err := c.GetAlive(ctx, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAliveResponse(rsp)
-}
 
-// PostAuthLoginWithBodyWithResponse request with arbitrary body returning *PostAuthLoginResponse
-func (c *ClientWithResponses) PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
-	rsp, err := c.PostAuthLoginWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthLoginResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
-	rsp, err := c.PostAuthLogin(ctx, body, reqEditors...)
+// NewDeleteBoardsIdBoardRequest generates requests for DeleteBoardsIdBoard
+func NewDeleteBoardsIdBoardRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthLoginResponse(rsp)
-}
 
-// PostAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostAuthRefreshResponse
-func (c *ClientWithResponses) PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
-	rsp, err := c.PostAuthRefreshWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthRefreshResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
-	rsp, err := c.PostAuthRefresh(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/boards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParsePostAuthRefreshResponse(rsp)
-}
 
-// PostAuthRegisterWithBodyWithResponse request with arbitrary body returning *PostAuthRegisterResponse
-func (c *ClientWithResponses) PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
-	rsp, err := c.PostAuthRegisterWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthRegisterResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
-	rsp, err := c.PostAuthRegister(ctx, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAuthRegisterResponse(rsp)
+
+	return req, nil
 }
 
-// GetBoardsWithResponse request returning *GetBoardsResponse
-func (c *ClientWithResponses) GetBoardsWithResponse(ctx context.Context, params *GetBoardsParams, reqEditors ...RequestEditorFn) (*GetBoardsResponse, error) {
-	rsp, err := c.GetBoards(ctx, params, reqEditors...)
+// NewGetBoardsIdBoardRequest generates requests for GetBoardsIdBoard
+func NewGetBoardsIdBoardRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBoardsResponse(rsp)
-}
 
-// PostBoardsWithBodyWithResponse request with arbitrary body returning *PostBoardsResponse
-func (c *ClientWithResponses) PostBoardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error) {
-	rsp, err := c.PostBoardsWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostBoardsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostBoardsWithResponse(ctx context.Context, body PostBoardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error) {
-	rsp, err := c.PostBoards(ctx, body, reqEditors...)
+	operationPath := fmt.Sprintf("/boards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostBoardsResponse(rsp)
-}
 
-// DeleteBoardsIdBoardWithResponse request returning *DeleteBoardsIdBoardResponse
-func (c *ClientWithResponses) DeleteBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardResponse, error) {
-	rsp, err := c.DeleteBoardsIdBoard(ctx, idBoard, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBoardsIdBoardResponse(rsp)
+
+	return req, nil
 }
 
-// GetBoardsIdBoardWithResponse request returning *GetBoardsIdBoardResponse
-func (c *ClientWithResponses) GetBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardResponse, error) {
-	rsp, err := c.GetBoardsIdBoard(ctx, idBoard, reqEditors...)
+// NewPutBoardsIdBoardRequest calls the generic PutBoardsIdBoard builder with application/json body
+func NewPutBoardsIdBoardRequest(server string, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBoardsIdBoardResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutBoardsIdBoardRequestWithBody(server, idBoard, "application/json", bodyReader)
 }
 
-// PutBoardsIdBoardWithBodyWithResponse request with arbitrary body returning *PutBoardsIdBoardResponse
-func (c *ClientWithResponses) PutBoardsIdBoardWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error) {
-	rsp, err := c.PutBoardsIdBoardWithBody(ctx, idBoard, contentType, body, reqEditors...)
+// NewPutBoardsIdBoardRequestWithBody generates requests for PutBoardsIdBoard with any type of body
+func NewPutBoardsIdBoardRequestWithBody(server string, idBoard openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutBoardsIdBoardResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error) {
-	rsp, err := c.PutBoardsIdBoard(ctx, idBoard, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutBoardsIdBoardResponse(rsp)
-}
 
-// DeleteBoardsIdBoardMembersIdMemberWithResponse request returning *DeleteBoardsIdBoardMembersIdMemberResponse
-func (c *ClientWithResponses) DeleteBoardsIdBoardMembersIdMemberWithResponse(ctx context.Context, idBoard openapi_types.UUID, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardMembersIdMemberResponse, error) {
-	rsp, err := c.DeleteBoardsIdBoardMembersIdMember(ctx, idBoard, idMember, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/boards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseDeleteBoardsIdBoardMembersIdMemberResponse(rsp)
-}
 
-// PostCardsWithBodyWithResponse request with arbitrary body returning *PostCardsResponse
-func (c *ClientWithResponses) PostCardsWithBodyWithResponse(ctx context.Context, params *PostCardsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCardsResponse, error) {
-	rsp, err := c.PostCardsWithBody(ctx, params, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostCardsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostCardsWithResponse(ctx context.Context, params *PostCardsParams, body PostCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCardsResponse, error) {
-	rsp, err := c.PostCards(ctx, params, body, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostCardsResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// DeleteCardsIdCardWithResponse request returning *DeleteCardsIdCardResponse
-func (c *ClientWithResponses) DeleteCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCardsIdCardResponse, error) {
-	rsp, err := c.DeleteCardsIdCard(ctx, idCard, reqEditors...)
+// NewGetBoardsIdBoardCustomFieldsRequest generates requests for GetBoardsIdBoardCustomFields
+func NewGetBoardsIdBoardCustomFieldsRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteCardsIdCardResponse(rsp)
-}
 
-// GetCardsIdCardWithResponse request returning *GetCardsIdCardResponse
-func (c *ClientWithResponses) GetCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetCardsIdCardResponse, error) {
-	rsp, err := c.GetCardsIdCard(ctx, idCard, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCardsIdCardResponse(rsp)
-}
 
-// PutCardsIdCardWithBodyWithResponse request with arbitrary body returning *PutCardsIdCardResponse
-func (c *ClientWithResponses) PutCardsIdCardWithBodyWithResponse(ctx context.Context, idCard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error) {
-	rsp, err := c.PutCardsIdCardWithBody(ctx, idCard, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/boards/%s/custom-fields", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutCardsIdCardResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error) {
-	rsp, err := c.PutCardsIdCard(ctx, idCard, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutCardsIdCardResponse(rsp)
+
+	return req, nil
 }
 
-// PostListsWithBodyWithResponse request with arbitrary body returning *PostListsResponse
-func (c *ClientWithResponses) PostListsWithBodyWithResponse(ctx context.Context, params *PostListsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostListsResponse, error) {
-	rsp, err := c.PostListsWithBody(ctx, params, contentType, body, reqEditors...)
+// NewPostBoardsIdBoardCustomFieldsRequest calls the generic PostBoardsIdBoardCustomFields builder with application/json body
+func NewPostBoardsIdBoardCustomFieldsRequest(server string, idBoard openapi_types.UUID, body PostBoardsIdBoardCustomFieldsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostListsResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBoardsIdBoardCustomFieldsRequestWithBody(server, idBoard, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) PostListsWithResponse(ctx context.Context, params *PostListsParams, body PostListsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostListsResponse, error) {
-	rsp, err := c.PostLists(ctx, params, body, reqEditors...)
+// NewPostBoardsIdBoardCustomFieldsRequestWithBody generates requests for PostBoardsIdBoardCustomFields with any type of body
+func NewPostBoardsIdBoardCustomFieldsRequestWithBody(server string, idBoard openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostListsResponse(rsp)
-}
 
-// DeleteListsIdListWithResponse request returning *DeleteListsIdListResponse
-func (c *ClientWithResponses) DeleteListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteListsIdListResponse, error) {
-	rsp, err := c.DeleteListsIdList(ctx, idList, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteListsIdListResponse(rsp)
-}
 
-// GetListsIdListWithResponse request returning *GetListsIdListResponse
-func (c *ClientWithResponses) GetListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetListsIdListResponse, error) {
-	rsp, err := c.GetListsIdList(ctx, idList, reqEditors...)
+	operationPath := fmt.Sprintf("/boards/%s/custom-fields", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetListsIdListResponse(rsp)
-}
 
-// PutListsIdListWithBodyWithResponse request with arbitrary body returning *PutListsIdListResponse
-func (c *ClientWithResponses) PutListsIdListWithBodyWithResponse(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error) {
-	rsp, err := c.PutListsIdListWithBody(ctx, idList, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutListsIdListResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PutListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, body PutListsIdListJSONRequestBody, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error) {
-	rsp, err := c.PutListsIdList(ctx, idList, body, reqEditors...)
+// NewDeleteBoardsIdBoardCustomFieldsIdFieldRequest generates requests for DeleteBoardsIdBoardCustomFieldsIdField
+func NewDeleteBoardsIdBoardCustomFieldsIdFieldRequest(server string, idBoard openapi_types.UUID, idField openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutListsIdListResponse(rsp)
-}
 
-// DeleteMembersBoardsIdBoardStarWithResponse request returning *DeleteMembersBoardsIdBoardStarResponse
-func (c *ClientWithResponses) DeleteMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMembersBoardsIdBoardStarResponse, error) {
-	rsp, err := c.DeleteMembersBoardsIdBoardStar(ctx, idBoard, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idField", runtime.ParamLocationPath, idField)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMembersBoardsIdBoardStarResponse(rsp)
-}
 
-// PostMembersBoardsIdBoardStarWithResponse request returning *PostMembersBoardsIdBoardStarResponse
-func (c *ClientWithResponses) PostMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostMembersBoardsIdBoardStarResponse, error) {
-	rsp, err := c.PostMembersBoardsIdBoardStar(ctx, idBoard, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostMembersBoardsIdBoardStarResponse(rsp)
-}
 
-// PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse request with arbitrary body returning *PostMembersBoardsNameBoardUniqueJoinResponse
-func (c *ClientWithResponses) PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse(ctx context.Context, nameBoardUnique string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error) {
-	rsp, err := c.PostMembersBoardsNameBoardUniqueJoinWithBody(ctx, nameBoardUnique, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/boards/%s/custom-fields/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostMembersBoardsNameBoardUniqueJoinResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostMembersBoardsNameBoardUniqueJoinWithResponse(ctx context.Context, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error) {
-	rsp, err := c.PostMembersBoardsNameBoardUniqueJoin(ctx, nameBoardUnique, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostMembersBoardsNameBoardUniqueJoinResponse(rsp)
+
+	return req, nil
 }
 
-// GetMembersMeWithResponse request returning *GetMembersMeResponse
-func (c *ClientWithResponses) GetMembersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeResponse, error) {
-	rsp, err := c.GetMembersMe(ctx, reqEditors...)
+// NewPutBoardsIdBoardCustomFieldsIdFieldRequest calls the generic PutBoardsIdBoardCustomFieldsIdField builder with application/json body
+func NewPutBoardsIdBoardCustomFieldsIdFieldRequest(server string, idBoard openapi_types.UUID, idField openapi_types.UUID, body PutBoardsIdBoardCustomFieldsIdFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMembersMeResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutBoardsIdBoardCustomFieldsIdFieldRequestWithBody(server, idBoard, idField, "application/json", bodyReader)
 }
 
-// PutMembersMeWithBodyWithResponse request with arbitrary body returning *PutMembersMeResponse
-func (c *ClientWithResponses) PutMembersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error) {
-	rsp, err := c.PutMembersMeWithBody(ctx, contentType, body, reqEditors...)
+// NewPutBoardsIdBoardCustomFieldsIdFieldRequestWithBody generates requests for PutBoardsIdBoardCustomFieldsIdField with any type of body
+func NewPutBoardsIdBoardCustomFieldsIdFieldRequestWithBody(server string, idBoard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutMembersMeResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutMembersMeWithResponse(ctx context.Context, body PutMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error) {
-	rsp, err := c.PutMembersMe(ctx, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idField", runtime.ParamLocationPath, idField)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutMembersMeResponse(rsp)
-}
 
-// ParseGetAliveResponse parses an HTTP response from a GetAliveWithResponse call
-func ParseGetAliveResponse(rsp *http.Response) (*GetAliveResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAliveResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/boards/%s/custom-fields/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParsePostAuthLoginResponse parses an HTTP response from a PostAuthLoginWithResponse call
-func ParsePostAuthLoginResponse(rsp *http.Response) (*PostAuthLoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAuthLoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// NewGetBoardsIdBoardExportRequest generates requests for GetBoardsIdBoardExport
+func NewGetBoardsIdBoardExportRequest(server string, idBoard openapi_types.UUID, params *GetBoardsIdBoardExportParams) (*http.Request, error) {
+	var err error
 
-	}
+	var pathParam0 string
 
-	return response, nil
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
+	}
 
-// ParsePostAuthRefreshResponse parses an HTTP response from a PostAuthRefreshWithResponse call
-func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAuthRefreshResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/boards/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TokenRefreshResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON401 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParsePostAuthRegisterResponse parses an HTTP response from a PostAuthRegisterWithResponse call
-func ParsePostAuthRegisterResponse(rsp *http.Response) (*PostAuthRegisterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetBoardsIdBoardLabelsRequest generates requests for GetBoardsIdBoardLabels
+func NewGetBoardsIdBoardLabelsRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAuthRegisterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest RegisterResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	operationPath := fmt.Sprintf("/boards/%s/labels", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetBoardsResponse parses an HTTP response from a GetBoardsWithResponse call
-func ParseGetBoardsResponse(rsp *http.Response) (*GetBoardsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostBoardsIdBoardLabelsRequest calls the generic PostBoardsIdBoardLabels builder with application/json body
+func NewPostBoardsIdBoardLabelsRequest(server string, idBoard openapi_types.UUID, body PostBoardsIdBoardLabelsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBoardsIdBoardLabelsRequestWithBody(server, idBoard, "application/json", bodyReader)
+}
+
+// NewPostBoardsIdBoardLabelsRequestWithBody generates requests for PostBoardsIdBoardLabels with any type of body
+func NewPostBoardsIdBoardLabelsRequestWithBody(server string, idBoard openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBoardsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BoardsListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/boards/%s/labels", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParsePostBoardsResponse parses an HTTP response from a PostBoardsWithResponse call
-func ParsePostBoardsResponse(rsp *http.Response) (*PostBoardsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteBoardsIdBoardMembersIdMemberRequest generates requests for DeleteBoardsIdBoardMembersIdMember
+func NewDeleteBoardsIdBoardMembersIdMemberRequest(server string, idBoard openapi_types.UUID, idMember openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostBoardsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idMember", runtime.ParamLocationPath, idMember)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest BoardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	operationPath := fmt.Sprintf("/boards/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseDeleteBoardsIdBoardResponse parses an HTTP response from a DeleteBoardsIdBoardWithResponse call
-func ParseDeleteBoardsIdBoardResponse(rsp *http.Response) (*DeleteBoardsIdBoardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteBoardsIdBoardWatchRequest generates requests for DeleteBoardsIdBoardWatch
+func NewDeleteBoardsIdBoardWatchRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBoardsIdBoardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	operationPath := fmt.Sprintf("/boards/%s/watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetBoardsIdBoardResponse parses an HTTP response from a GetBoardsIdBoardWithResponse call
-func ParseGetBoardsIdBoardResponse(rsp *http.Response) (*GetBoardsIdBoardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostBoardsIdBoardWatchRequest generates requests for PostBoardsIdBoardWatch
+func NewPostBoardsIdBoardWatchRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBoardsIdBoardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BoardWithDetailsResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
+	operationPath := fmt.Sprintf("/boards/%s/watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParsePutBoardsIdBoardResponse parses an HTTP response from a PutBoardsIdBoardWithResponse call
-func ParsePutBoardsIdBoardResponse(rsp *http.Response) (*PutBoardsIdBoardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutBoardsIdBoardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BoardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// NewGetBoardsIdBoardWebhooksRequest generates requests for GetBoardsIdBoardWebhooks
+func NewGetBoardsIdBoardWebhooksRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var pathParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
+	operationPath := fmt.Sprintf("/boards/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// ParseDeleteBoardsIdBoardMembersIdMemberResponse parses an HTTP response from a DeleteBoardsIdBoardMembersIdMemberWithResponse call
-func ParseDeleteBoardsIdBoardMembersIdMemberResponse(rsp *http.Response) (*DeleteBoardsIdBoardMembersIdMemberResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBoardsIdBoardMembersIdMemberResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	return req, nil
+}
+
+// NewPostBoardsIdBoardWebhooksRequest calls the generic PostBoardsIdBoardWebhooks builder with application/json body
+func NewPostBoardsIdBoardWebhooksRequest(server string, idBoard openapi_types.UUID, body PostBoardsIdBoardWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBoardsIdBoardWebhooksRequestWithBody(server, idBoard, "application/json", bodyReader)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// NewPostBoardsIdBoardWebhooksRequestWithBody generates requests for PostBoardsIdBoardWebhooks with any type of body
+func NewPostBoardsIdBoardWebhooksRequestWithBody(server string, idBoard openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	var pathParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/boards/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePostCardsResponse parses an HTTP response from a PostCardsWithResponse call
-func ParsePostCardsResponse(rsp *http.Response) (*PostCardsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostCardsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// NewDeleteBoardsIdBoardWebhooksIdWebhookRequest generates requests for DeleteBoardsIdBoardWebhooksIdWebhook
+func NewDeleteBoardsIdBoardWebhooksIdWebhookRequest(server string, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseDeleteCardsIdCardResponse parses an HTTP response from a DeleteCardsIdCardWithResponse call
-func ParseDeleteCardsIdCardResponse(rsp *http.Response) (*DeleteCardsIdCardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idWebhook", runtime.ParamLocationPath, idWebhook)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCardsIdCardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	operationPath := fmt.Sprintf("/boards/%s/webhooks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetCardsIdCardResponse parses an HTTP response from a GetCardsIdCardWithResponse call
-func ParseGetCardsIdCardResponse(rsp *http.Response) (*GetCardsIdCardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPutBoardsIdBoardWebhooksIdWebhookRequest calls the generic PutBoardsIdBoardWebhooksIdWebhook builder with application/json body
+func NewPutBoardsIdBoardWebhooksIdWebhookRequest(server string, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, body PutBoardsIdBoardWebhooksIdWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutBoardsIdBoardWebhooksIdWebhookRequestWithBody(server, idBoard, idWebhook, "application/json", bodyReader)
+}
 
-	response := &GetCardsIdCardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewPutBoardsIdBoardWebhooksIdWebhookRequestWithBody generates requests for PutBoardsIdBoardWebhooksIdWebhook with any type of body
+func NewPutBoardsIdBoardWebhooksIdWebhookRequestWithBody(server string, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var pathParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	var pathParam1 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idWebhook", runtime.ParamLocationPath, idWebhook)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/boards/%s/webhooks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePutCardsIdCardResponse parses an HTTP response from a PutCardsIdCardWithResponse call
-func ParsePutCardsIdCardResponse(rsp *http.Response) (*PutCardsIdCardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutCardsIdCardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// NewGetBoardsIdBoardWebhooksIdWebhookDeliveriesRequest generates requests for GetBoardsIdBoardWebhooksIdWebhookDeliveries
+func NewGetBoardsIdBoardWebhooksIdWebhookDeliveriesRequest(server string, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params *GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParsePostListsResponse parses an HTTP response from a PostListsWithResponse call
-func ParsePostListsResponse(rsp *http.Response) (*PostListsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idWebhook", runtime.ParamLocationPath, idWebhook)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostListsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	operationPath := fmt.Sprintf("/boards/%s/webhooks/%s/deliveries", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON404 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ParseDeleteListsIdListResponse parses an HTTP response from a DeleteListsIdListWithResponse call
-func ParseDeleteListsIdListResponse(rsp *http.Response) (*DeleteListsIdListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostBoardsIdBoardWebhooksIdWebhookTestRequest generates requests for PostBoardsIdBoardWebhooksIdWebhookTest
+func NewPostBoardsIdBoardWebhooksIdWebhookTestRequest(server string, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteListsIdListResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idWebhook", runtime.ParamLocationPath, idWebhook)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	operationPath := fmt.Sprintf("/boards/%s/webhooks/%s/test", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetListsIdListResponse parses an HTTP response from a GetListsIdListWithResponse call
-func ParseGetListsIdListResponse(rsp *http.Response) (*GetListsIdListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostCardsRequest calls the generic PostCards builder with application/json body
+func NewPostCardsRequest(server string, params *PostCardsParams, body PostCardsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCardsRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-	response := &GetListsIdListResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// NewPostCardsRequestWithBody generates requests for PostCards with any type of body
+func NewPostCardsRequestWithBody(server string, params *PostCardsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListWithCardsResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/cards")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "idList", runtime.ParamLocationQuery, params.IdList); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON404 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParsePutListsIdListResponse parses an HTTP response from a PutListsIdListWithResponse call
-func ParsePutListsIdListResponse(rsp *http.Response) (*PutListsIdListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostCardsBulkRequest calls the generic PostCardsBulk builder with application/json body
+func NewPostCardsBulkRequest(server string, body PostCardsBulkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCardsBulkRequestWithBody(server, "application/json", bodyReader)
+}
 
-	response := &PutListsIdListResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// NewPostCardsBulkRequestWithBody generates requests for PostCardsBulk with any type of body
+func NewPostCardsBulkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/cards/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return req, nil
+}
 
-	}
+// NewDeleteCardsIdCardRequest generates requests for DeleteCardsIdCard
+func NewDeleteCardsIdCardRequest(server string, idCard openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	return response, nil
-}
+	var pathParam0 string
 
-// ParseDeleteMembersBoardsIdBoardStarResponse parses an HTTP response from a DeleteMembersBoardsIdBoardStarWithResponse call
-func ParseDeleteMembersBoardsIdBoardStarResponse(rsp *http.Response) (*DeleteMembersBoardsIdBoardStarResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteMembersBoardsIdBoardStarResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UnstarBoardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	operationPath := fmt.Sprintf("/cards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePostMembersBoardsIdBoardStarResponse parses an HTTP response from a PostMembersBoardsIdBoardStarWithResponse call
-func ParsePostMembersBoardsIdBoardStarResponse(rsp *http.Response) (*PostMembersBoardsIdBoardStarResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetCardsIdCardRequest generates requests for GetCardsIdCard
+func NewGetCardsIdCardRequest(server string, idCard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostMembersBoardsIdBoardStarResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StarBoardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	operationPath := fmt.Sprintf("/cards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePostMembersBoardsNameBoardUniqueJoinResponse parses an HTTP response from a PostMembersBoardsNameBoardUniqueJoinWithResponse call
-func ParsePostMembersBoardsNameBoardUniqueJoinResponse(rsp *http.Response) (*PostMembersBoardsNameBoardUniqueJoinResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPutCardsIdCardRequest calls the generic PutCardsIdCard builder with application/json body
+func NewPutCardsIdCardRequest(server string, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutCardsIdCardRequestWithBody(server, idCard, "application/json", bodyReader)
+}
 
-	response := &PostMembersBoardsNameBoardUniqueJoinResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// NewPutCardsIdCardRequestWithBody generates requests for PutCardsIdCard with any type of body
+func NewPutCardsIdCardRequestWithBody(server string, idCard openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JoinBoardResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var pathParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	operationPath := fmt.Sprintf("/cards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParseGetMembersMeResponse parses an HTTP response from a GetMembersMeWithResponse call
-func ParseGetMembersMeResponse(rsp *http.Response) (*GetMembersMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPutCardsIdCardCustomFieldsIdFieldRequest calls the generic PutCardsIdCardCustomFieldsIdField builder with application/json body
+func NewPutCardsIdCardCustomFieldsIdFieldRequest(server string, idCard openapi_types.UUID, idField openapi_types.UUID, body PutCardsIdCardCustomFieldsIdFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutCardsIdCardCustomFieldsIdFieldRequestWithBody(server, idCard, idField, "application/json", bodyReader)
+}
 
-	response := &GetMembersMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// NewPutCardsIdCardCustomFieldsIdFieldRequestWithBody generates requests for PutCardsIdCardCustomFieldsIdField with any type of body
+func NewPutCardsIdCardCustomFieldsIdFieldRequestWithBody(server string, idCard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MemberResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var pathParam1 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "idField", runtime.ParamLocationPath, idField)
+	if err != nil {
+		return nil, err
+	}
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/cards/%s/custom-fields/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePutMembersMeResponse parses an HTTP response from a PutMembersMeWithResponse call
-func ParsePutMembersMeResponse(rsp *http.Response) (*PutMembersMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutMembersMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MemberResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// NewDeleteCardsIdCardWatchRequest generates requests for DeleteCardsIdCardWatch
+func NewDeleteCardsIdCardWatchRequest(server string, idCard openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// health check
-	// (GET /alive)
-	GetAlive(w http.ResponseWriter, r *http.Request)
-	// Login user
-	// (POST /auth/login)
-	PostAuthLogin(w http.ResponseWriter, r *http.Request)
-	// Refresh access token
-	// (POST /auth/refresh)
-	PostAuthRefresh(w http.ResponseWriter, r *http.Request)
-	// Register a new user
-	// (POST /auth/register)
-	PostAuthRegister(w http.ResponseWriter, r *http.Request)
-	// Get all boards of current member
-	// (GET /boards)
-	GetBoards(w http.ResponseWriter, r *http.Request, params GetBoardsParams)
-	// Create a new board
-	// (POST /boards)
-	PostBoards(w http.ResponseWriter, r *http.Request)
-	// Delete board
-	// (DELETE /boards/{idBoard})
-	DeleteBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
-	// Get board with lists
-	// (GET /boards/{idBoard})
-	GetBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
-	// Update board
-	// (PUT /boards/{idBoard})
-	PutBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
-	// Remove member from board (or leave from board if not an owner)
-	// (DELETE /boards/{idBoard}/members/{idMember})
-	DeleteBoardsIdBoardMembersIdMember(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idMember openapi_types.UUID)
-	// Create a new card
-	// (POST /cards)
-	PostCards(w http.ResponseWriter, r *http.Request, params PostCardsParams)
-	// Delete card
-	// (DELETE /cards/{idCard})
-	DeleteCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
-	// Get card with full info
-	// (GET /cards/{idCard})
-	GetCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
-	// Update card
-	// (PUT /cards/{idCard})
-	PutCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
-	// Create a new list
-	// (POST /lists)
-	PostLists(w http.ResponseWriter, r *http.Request, params PostListsParams)
-	// Delete list
-	// (DELETE /lists/{idList})
-	DeleteListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
-	// Get list with cards
-	// (GET /lists/{idList})
-	GetListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
-	// Update list
-	// (PUT /lists/{idList})
-	PutListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
-	// Unstar a board
-	// (DELETE /members/boards/{idBoard}/star)
-	DeleteMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
-	// Star a board
-	// (POST /members/boards/{idBoard}/star)
-	PostMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
-	// Join a board
-	// (POST /members/boards/{nameBoardUnique}/join)
-	PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r *http.Request, nameBoardUnique string)
-	// Get current user info
-	// (GET /members/me)
-	GetMembersMe(w http.ResponseWriter, r *http.Request)
-	// Update current user info
-	// (PUT /members/me)
-	PutMembersMe(w http.ResponseWriter, r *http.Request)
-}
+	operationPath := fmt.Sprintf("/cards/%s/watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-type Unimplemented struct{}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-// health check
-// (GET /alive)
-func (_ Unimplemented) GetAlive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	return req, nil
 }
 
-// Login user
-// (POST /auth/login)
-func (_ Unimplemented) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// NewPostCardsIdCardWatchRequest generates requests for PostCardsIdCardWatch
+func NewPostCardsIdCardWatchRequest(server string, idCard openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-// Refresh access token
-// (POST /auth/refresh)
-func (_ Unimplemented) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var pathParam0 string
 
-// Register a new user
-// (POST /auth/register)
-func (_ Unimplemented) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idCard", runtime.ParamLocationPath, idCard)
+	if err != nil {
+		return nil, err
+	}
 
-// Get all boards of current member
-// (GET /boards)
-func (_ Unimplemented) GetBoards(w http.ResponseWriter, r *http.Request, params GetBoardsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Create a new board
-// (POST /boards)
-func (_ Unimplemented) PostBoards(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	operationPath := fmt.Sprintf("/cards/%s/watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Delete board
-// (DELETE /boards/{idBoard})
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostListsRequest calls the generic PostLists builder with application/json body
+func NewPostListsRequest(server string, params *PostListsParams, body PostListsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostListsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostListsRequestWithBody generates requests for PostLists with any type of body
+func NewPostListsRequestWithBody(server string, params *PostListsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "idBoard", runtime.ParamLocationQuery, params.IdBoard); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteListsIdListRequest generates requests for DeleteListsIdList
+func NewDeleteListsIdListRequest(server string, idList openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetListsIdListRequest generates requests for GetListsIdList
+func NewGetListsIdListRequest(server string, idList openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutListsIdListRequest calls the generic PutListsIdList builder with application/json body
+func NewPutListsIdListRequest(server string, idList openapi_types.UUID, body PutListsIdListJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutListsIdListRequestWithBody(server, idList, "application/json", bodyReader)
+}
+
+// NewPutListsIdListRequestWithBody generates requests for PutListsIdList with any type of body
+func NewPutListsIdListRequestWithBody(server string, idList openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostListsIdListArchiveAllCardsRequest generates requests for PostListsIdListArchiveAllCards
+func NewPostListsIdListArchiveAllCardsRequest(server string, idList openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists/%s/archive-all-cards", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostListsIdListMoveAllCardsRequest calls the generic PostListsIdListMoveAllCards builder with application/json body
+func NewPostListsIdListMoveAllCardsRequest(server string, idList openapi_types.UUID, body PostListsIdListMoveAllCardsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostListsIdListMoveAllCardsRequestWithBody(server, idList, "application/json", bodyReader)
+}
+
+// NewPostListsIdListMoveAllCardsRequestWithBody generates requests for PostListsIdListMoveAllCards with any type of body
+func NewPostListsIdListMoveAllCardsRequestWithBody(server string, idList openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists/%s/move-all-cards", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteListsIdListWatchRequest generates requests for DeleteListsIdListWatch
+func NewDeleteListsIdListWatchRequest(server string, idList openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists/%s/watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostListsIdListWatchRequest generates requests for PostListsIdListWatch
+func NewPostListsIdListWatchRequest(server string, idList openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idList", runtime.ParamLocationPath, idList)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lists/%s/watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMembersRequest generates requests for GetMembers
+func NewGetMembersRequest(server string, params *GetMembersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, params.Query); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteMembersBoardsIdBoardStarRequest generates requests for DeleteMembersBoardsIdBoardStar
+func NewDeleteMembersBoardsIdBoardStarRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/boards/%s/star", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMembersBoardsIdBoardStarRequest generates requests for PostMembersBoardsIdBoardStar
+func NewPostMembersBoardsIdBoardStarRequest(server string, idBoard openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idBoard", runtime.ParamLocationPath, idBoard)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/boards/%s/star", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMembersBoardsNameBoardUniqueJoinRequest calls the generic PostMembersBoardsNameBoardUniqueJoin builder with application/json body
+func NewPostMembersBoardsNameBoardUniqueJoinRequest(server string, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody(server, nameBoardUnique, "application/json", bodyReader)
+}
+
+// NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody generates requests for PostMembersBoardsNameBoardUniqueJoin with any type of body
+func NewPostMembersBoardsNameBoardUniqueJoinRequestWithBody(server string, nameBoardUnique string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "nameBoardUnique", runtime.ParamLocationPath, nameBoardUnique)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/boards/%s/join", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMembersMeRequest calls the generic DeleteMembersMe builder with application/json body
+func NewDeleteMembersMeRequest(server string, body DeleteMembersMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteMembersMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteMembersMeRequestWithBody generates requests for DeleteMembersMe with any type of body
+func NewDeleteMembersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMembersMeRequest generates requests for GetMembersMe
+func NewGetMembersMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutMembersMeRequest calls the generic PutMembersMe builder with application/json body
+func NewPutMembersMeRequest(server string, body PutMembersMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutMembersMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutMembersMeRequestWithBody generates requests for PutMembersMe with any type of body
+func NewPutMembersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutMembersMeAvatarRequestWithBody generates requests for PutMembersMeAvatar with any type of body
+func NewPutMembersMeAvatarRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/avatar")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMembersMeExportRequest generates requests for GetMembersMeExport
+func NewGetMembersMeExportRequest(server string, params *GetMembersMeExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMembersMeExportIdExportRequest generates requests for GetMembersMeExportIdExport
+func NewGetMembersMeExportIdExportRequest(server string, idExport openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idExport", runtime.ParamLocationPath, idExport)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/export/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMembersMeExportIdExportDownloadRequest generates requests for GetMembersMeExportIdExportDownload
+func NewGetMembersMeExportIdExportDownloadRequest(server string, idExport openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idExport", runtime.ParamLocationPath, idExport)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/export/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMembersMeMfaRequest generates requests for GetMembersMeMfa
+func NewGetMembersMeMfaRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMembersMeMfaRecoveryCodesRequest calls the generic PostMembersMeMfaRecoveryCodes builder with application/json body
+func NewPostMembersMeMfaRecoveryCodesRequest(server string, body PostMembersMeMfaRecoveryCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMembersMeMfaRecoveryCodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMembersMeMfaRecoveryCodesRequestWithBody generates requests for PostMembersMeMfaRecoveryCodes with any type of body
+func NewPostMembersMeMfaRecoveryCodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/mfa/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostMembersMeMfaTotpRequest generates requests for PostMembersMeMfaTotp
+func NewPostMembersMeMfaTotpRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/mfa/totp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMembersMeMfaTotpConfirmRequest calls the generic PostMembersMeMfaTotpConfirm builder with application/json body
+func NewPostMembersMeMfaTotpConfirmRequest(server string, body PostMembersMeMfaTotpConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMembersMeMfaTotpConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMembersMeMfaTotpConfirmRequestWithBody generates requests for PostMembersMeMfaTotpConfirm with any type of body
+func NewPostMembersMeMfaTotpConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/mfa/totp/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostMembersMeMfaTotpDisableRequest calls the generic PostMembersMeMfaTotpDisable builder with application/json body
+func NewPostMembersMeMfaTotpDisableRequest(server string, body PostMembersMeMfaTotpDisableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMembersMeMfaTotpDisableRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMembersMeMfaTotpDisableRequestWithBody generates requests for PostMembersMeMfaTotpDisable with any type of body
+func NewPostMembersMeMfaTotpDisableRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/mfa/totp/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMembersMeNotificationsRequest generates requests for GetMembersMeNotifications
+func NewGetMembersMeNotificationsRequest(server string, params *GetMembersMeNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Unread != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMembersMeNotificationsReadAllRequest generates requests for PostMembersMeNotificationsReadAll
+func NewPostMembersMeNotificationsReadAllRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/notifications/read-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMembersMeNotificationsIdNotificationReadRequest generates requests for PostMembersMeNotificationsIdNotificationRead
+func NewPostMembersMeNotificationsIdNotificationReadRequest(server string, idNotification openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idNotification", runtime.ParamLocationPath, idNotification)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMembersMePreferencesRequest generates requests for GetMembersMePreferences
+func NewGetMembersMePreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchMembersMePreferencesRequest calls the generic PatchMembersMePreferences builder with application/json body
+func NewPatchMembersMePreferencesRequest(server string, body PatchMembersMePreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchMembersMePreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPatchMembersMePreferencesRequestWithBody generates requests for PatchMembersMePreferences with any type of body
+func NewPatchMembersMePreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMembersMeTokensRequest generates requests for GetMembersMeTokens
+func NewGetMembersMeTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMembersMeTokensRequest calls the generic PostMembersMeTokens builder with application/json body
+func NewPostMembersMeTokensRequest(server string, body PostMembersMeTokensJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMembersMeTokensRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMembersMeTokensRequestWithBody generates requests for PostMembersMeTokens with any type of body
+func NewPostMembersMeTokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMembersMeTokensIdTokenRequest generates requests for DeleteMembersMeTokensIdToken
+func NewDeleteMembersMeTokensIdTokenRequest(server string, idToken openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idToken", runtime.ParamLocationPath, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/me/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMembersIdMemberRequest generates requests for GetMembersIdMember
+func NewGetMembersIdMemberRequest(server string, idMember openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "idMember", runtime.ParamLocationPath, idMember)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/members/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAliveWithResponse request
+	GetAliveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAliveResponse, error)
+
+	// PostAuthLoginWithBodyWithResponse request with any body
+	PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+
+	PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error)
+
+	// PostAuthMfaVerifyWithBodyWithResponse request with any body
+	PostAuthMfaVerifyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthMfaVerifyResponse, error)
+
+	PostAuthMfaVerifyWithResponse(ctx context.Context, body PostAuthMfaVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthMfaVerifyResponse, error)
+
+	// GetAuthOidcProviderCallbackWithResponse request
+	GetAuthOidcProviderCallbackWithResponse(ctx context.Context, provider string, params *GetAuthOidcProviderCallbackParams, reqEditors ...RequestEditorFn) (*GetAuthOidcProviderCallbackResponse, error)
+
+	// GetAuthOidcProviderStartWithResponse request
+	GetAuthOidcProviderStartWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*GetAuthOidcProviderStartResponse, error)
+
+	// PostAuthPasswordForgotWithBodyWithResponse request with any body
+	PostAuthPasswordForgotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthPasswordForgotResponse, error)
+
+	PostAuthPasswordForgotWithResponse(ctx context.Context, body PostAuthPasswordForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthPasswordForgotResponse, error)
+
+	// PostAuthPasswordResetWithBodyWithResponse request with any body
+	PostAuthPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthPasswordResetResponse, error)
+
+	PostAuthPasswordResetWithResponse(ctx context.Context, body PostAuthPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthPasswordResetResponse, error)
+
+	// PostAuthRefreshWithBodyWithResponse request with any body
+	PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	// PostAuthRegisterWithBodyWithResponse request with any body
+	PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+
+	PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error)
+
+	// PostAuthVerifyEmailWithBodyWithResponse request with any body
+	PostAuthVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResponse, error)
+
+	PostAuthVerifyEmailWithResponse(ctx context.Context, body PostAuthVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResponse, error)
+
+	// PostAuthVerifyEmailResendWithBodyWithResponse request with any body
+	PostAuthVerifyEmailResendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResendResponse, error)
+
+	PostAuthVerifyEmailResendWithResponse(ctx context.Context, body PostAuthVerifyEmailResendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResendResponse, error)
+
+	// GetBoardsWithResponse request
+	GetBoardsWithResponse(ctx context.Context, params *GetBoardsParams, reqEditors ...RequestEditorFn) (*GetBoardsResponse, error)
+
+	// PostBoardsWithBodyWithResponse request with any body
+	PostBoardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error)
+
+	PostBoardsWithResponse(ctx context.Context, body PostBoardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error)
+
+	// PostBoardsImportWithBodyWithResponse request with any body
+	PostBoardsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsImportResponse, error)
+
+	PostBoardsImportWithResponse(ctx context.Context, body PostBoardsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsImportResponse, error)
+
+	// DeleteBoardsIdBoardWithResponse request
+	DeleteBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardResponse, error)
+
+	// GetBoardsIdBoardWithResponse request
+	GetBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardResponse, error)
+
+	// PutBoardsIdBoardWithBodyWithResponse request with any body
+	PutBoardsIdBoardWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error)
+
+	PutBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error)
+
+	// GetBoardsIdBoardCustomFieldsWithResponse request
+	GetBoardsIdBoardCustomFieldsWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardCustomFieldsResponse, error)
+
+	// PostBoardsIdBoardCustomFieldsWithBodyWithResponse request with any body
+	PostBoardsIdBoardCustomFieldsWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardCustomFieldsResponse, error)
+
+	PostBoardsIdBoardCustomFieldsWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardCustomFieldsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardCustomFieldsResponse, error)
+
+	// DeleteBoardsIdBoardCustomFieldsIdFieldWithResponse request
+	DeleteBoardsIdBoardCustomFieldsIdFieldWithResponse(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardCustomFieldsIdFieldResponse, error)
+
+	// PutBoardsIdBoardCustomFieldsIdFieldWithBodyWithResponse request with any body
+	PutBoardsIdBoardCustomFieldsIdFieldWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardCustomFieldsIdFieldResponse, error)
+
+	PutBoardsIdBoardCustomFieldsIdFieldWithResponse(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, body PutBoardsIdBoardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardCustomFieldsIdFieldResponse, error)
+
+	// GetBoardsIdBoardExportWithResponse request
+	GetBoardsIdBoardExportWithResponse(ctx context.Context, idBoard openapi_types.UUID, params *GetBoardsIdBoardExportParams, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardExportResponse, error)
+
+	// GetBoardsIdBoardLabelsWithResponse request
+	GetBoardsIdBoardLabelsWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardLabelsResponse, error)
+
+	// PostBoardsIdBoardLabelsWithBodyWithResponse request with any body
+	PostBoardsIdBoardLabelsWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardLabelsResponse, error)
+
+	PostBoardsIdBoardLabelsWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardLabelsResponse, error)
+
+	// DeleteBoardsIdBoardMembersIdMemberWithResponse request
+	DeleteBoardsIdBoardMembersIdMemberWithResponse(ctx context.Context, idBoard openapi_types.UUID, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardMembersIdMemberResponse, error)
+
+	// DeleteBoardsIdBoardWatchWithResponse request
+	DeleteBoardsIdBoardWatchWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardWatchResponse, error)
+
+	// PostBoardsIdBoardWatchWithResponse request
+	PostBoardsIdBoardWatchWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWatchResponse, error)
+
+	// GetBoardsIdBoardWebhooksWithResponse request
+	GetBoardsIdBoardWebhooksWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardWebhooksResponse, error)
+
+	// PostBoardsIdBoardWebhooksWithBodyWithResponse request with any body
+	PostBoardsIdBoardWebhooksWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWebhooksResponse, error)
+
+	PostBoardsIdBoardWebhooksWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWebhooksResponse, error)
+
+	// DeleteBoardsIdBoardWebhooksIdWebhookWithResponse request
+	DeleteBoardsIdBoardWebhooksIdWebhookWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardWebhooksIdWebhookResponse, error)
+
+	// PutBoardsIdBoardWebhooksIdWebhookWithBodyWithResponse request with any body
+	PutBoardsIdBoardWebhooksIdWebhookWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardWebhooksIdWebhookResponse, error)
+
+	PutBoardsIdBoardWebhooksIdWebhookWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, body PutBoardsIdBoardWebhooksIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardWebhooksIdWebhookResponse, error)
+
+	// GetBoardsIdBoardWebhooksIdWebhookDeliveriesWithResponse request
+	GetBoardsIdBoardWebhooksIdWebhookDeliveriesWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params *GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse, error)
+
+	// PostBoardsIdBoardWebhooksIdWebhookTestWithResponse request
+	PostBoardsIdBoardWebhooksIdWebhookTestWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWebhooksIdWebhookTestResponse, error)
+
+	// PostCardsWithBodyWithResponse request with any body
+	PostCardsWithBodyWithResponse(ctx context.Context, params *PostCardsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCardsResponse, error)
+
+	PostCardsWithResponse(ctx context.Context, params *PostCardsParams, body PostCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCardsResponse, error)
+
+	// PostCardsBulkWithBodyWithResponse request with any body
+	PostCardsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCardsBulkResponse, error)
+
+	PostCardsBulkWithResponse(ctx context.Context, body PostCardsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCardsBulkResponse, error)
+
+	// DeleteCardsIdCardWithResponse request
+	DeleteCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCardsIdCardResponse, error)
+
+	// GetCardsIdCardWithResponse request
+	GetCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetCardsIdCardResponse, error)
+
+	// PutCardsIdCardWithBodyWithResponse request with any body
+	PutCardsIdCardWithBodyWithResponse(ctx context.Context, idCard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error)
+
+	PutCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error)
+
+	// PutCardsIdCardCustomFieldsIdFieldWithBodyWithResponse request with any body
+	PutCardsIdCardCustomFieldsIdFieldWithBodyWithResponse(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCardsIdCardCustomFieldsIdFieldResponse, error)
+
+	PutCardsIdCardCustomFieldsIdFieldWithResponse(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, body PutCardsIdCardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCardsIdCardCustomFieldsIdFieldResponse, error)
+
+	// DeleteCardsIdCardWatchWithResponse request
+	DeleteCardsIdCardWatchWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCardsIdCardWatchResponse, error)
+
+	// PostCardsIdCardWatchWithResponse request
+	PostCardsIdCardWatchWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostCardsIdCardWatchResponse, error)
+
+	// PostListsWithBodyWithResponse request with any body
+	PostListsWithBodyWithResponse(ctx context.Context, params *PostListsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostListsResponse, error)
+
+	PostListsWithResponse(ctx context.Context, params *PostListsParams, body PostListsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostListsResponse, error)
+
+	// DeleteListsIdListWithResponse request
+	DeleteListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteListsIdListResponse, error)
+
+	// GetListsIdListWithResponse request
+	GetListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetListsIdListResponse, error)
+
+	// PutListsIdListWithBodyWithResponse request with any body
+	PutListsIdListWithBodyWithResponse(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error)
+
+	PutListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, body PutListsIdListJSONRequestBody, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error)
+
+	// PostListsIdListArchiveAllCardsWithResponse request
+	PostListsIdListArchiveAllCardsWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostListsIdListArchiveAllCardsResponse, error)
+
+	// PostListsIdListMoveAllCardsWithBodyWithResponse request with any body
+	PostListsIdListMoveAllCardsWithBodyWithResponse(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostListsIdListMoveAllCardsResponse, error)
+
+	PostListsIdListMoveAllCardsWithResponse(ctx context.Context, idList openapi_types.UUID, body PostListsIdListMoveAllCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostListsIdListMoveAllCardsResponse, error)
+
+	// DeleteListsIdListWatchWithResponse request
+	DeleteListsIdListWatchWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteListsIdListWatchResponse, error)
+
+	// PostListsIdListWatchWithResponse request
+	PostListsIdListWatchWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostListsIdListWatchResponse, error)
+
+	// GetMembersWithResponse request
+	GetMembersWithResponse(ctx context.Context, params *GetMembersParams, reqEditors ...RequestEditorFn) (*GetMembersResponse, error)
+
+	// DeleteMembersBoardsIdBoardStarWithResponse request
+	DeleteMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMembersBoardsIdBoardStarResponse, error)
+
+	// PostMembersBoardsIdBoardStarWithResponse request
+	PostMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostMembersBoardsIdBoardStarResponse, error)
+
+	// PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse request with any body
+	PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse(ctx context.Context, nameBoardUnique string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error)
+
+	PostMembersBoardsNameBoardUniqueJoinWithResponse(ctx context.Context, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error)
+
+	// DeleteMembersMeWithBodyWithResponse request with any body
+	DeleteMembersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMembersMeResponse, error)
+
+	DeleteMembersMeWithResponse(ctx context.Context, body DeleteMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMembersMeResponse, error)
+
+	// GetMembersMeWithResponse request
+	GetMembersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeResponse, error)
+
+	// PutMembersMeWithBodyWithResponse request with any body
+	PutMembersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error)
+
+	PutMembersMeWithResponse(ctx context.Context, body PutMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error)
+
+	// PutMembersMeAvatarWithBodyWithResponse request with any body
+	PutMembersMeAvatarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMembersMeAvatarResponse, error)
+
+	// GetMembersMeExportWithResponse request
+	GetMembersMeExportWithResponse(ctx context.Context, params *GetMembersMeExportParams, reqEditors ...RequestEditorFn) (*GetMembersMeExportResponse, error)
+
+	// GetMembersMeExportIdExportWithResponse request
+	GetMembersMeExportIdExportWithResponse(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMembersMeExportIdExportResponse, error)
+
+	// GetMembersMeExportIdExportDownloadWithResponse request
+	GetMembersMeExportIdExportDownloadWithResponse(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMembersMeExportIdExportDownloadResponse, error)
+
+	// GetMembersMeMfaWithResponse request
+	GetMembersMeMfaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeMfaResponse, error)
+
+	// PostMembersMeMfaRecoveryCodesWithBodyWithResponse request with any body
+	PostMembersMeMfaRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeMfaRecoveryCodesResponse, error)
+
+	PostMembersMeMfaRecoveryCodesWithResponse(ctx context.Context, body PostMembersMeMfaRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeMfaRecoveryCodesResponse, error)
+
+	// PostMembersMeMfaTotpWithResponse request
+	PostMembersMeMfaTotpWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpResponse, error)
+
+	// PostMembersMeMfaTotpConfirmWithBodyWithResponse request with any body
+	PostMembersMeMfaTotpConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpConfirmResponse, error)
+
+	PostMembersMeMfaTotpConfirmWithResponse(ctx context.Context, body PostMembersMeMfaTotpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpConfirmResponse, error)
+
+	// PostMembersMeMfaTotpDisableWithBodyWithResponse request with any body
+	PostMembersMeMfaTotpDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpDisableResponse, error)
+
+	PostMembersMeMfaTotpDisableWithResponse(ctx context.Context, body PostMembersMeMfaTotpDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpDisableResponse, error)
+
+	// GetMembersMeNotificationsWithResponse request
+	GetMembersMeNotificationsWithResponse(ctx context.Context, params *GetMembersMeNotificationsParams, reqEditors ...RequestEditorFn) (*GetMembersMeNotificationsResponse, error)
+
+	// PostMembersMeNotificationsReadAllWithResponse request
+	PostMembersMeNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostMembersMeNotificationsReadAllResponse, error)
+
+	// PostMembersMeNotificationsIdNotificationReadWithResponse request
+	PostMembersMeNotificationsIdNotificationReadWithResponse(ctx context.Context, idNotification openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostMembersMeNotificationsIdNotificationReadResponse, error)
+
+	// GetMembersMePreferencesWithResponse request
+	GetMembersMePreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMePreferencesResponse, error)
+
+	// PatchMembersMePreferencesWithBodyWithResponse request with any body
+	PatchMembersMePreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMembersMePreferencesResponse, error)
+
+	PatchMembersMePreferencesWithResponse(ctx context.Context, body PatchMembersMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMembersMePreferencesResponse, error)
+
+	// GetMembersMeTokensWithResponse request
+	GetMembersMeTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeTokensResponse, error)
+
+	// PostMembersMeTokensWithBodyWithResponse request with any body
+	PostMembersMeTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeTokensResponse, error)
+
+	PostMembersMeTokensWithResponse(ctx context.Context, body PostMembersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeTokensResponse, error)
+
+	// DeleteMembersMeTokensIdTokenWithResponse request
+	DeleteMembersMeTokensIdTokenWithResponse(ctx context.Context, idToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMembersMeTokensIdTokenResponse, error)
+
+	// GetMembersIdMemberWithResponse request
+	GetMembersIdMemberWithResponse(ctx context.Context, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMembersIdMemberResponse, error)
+}
+
+type GetAliveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAliveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAliveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Error
+	JSON429      *TooManyAttempts
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthMfaVerifyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *TooManyAttempts
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthMfaVerifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthMfaVerifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthOidcProviderCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthOidcProviderCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthOidcProviderCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthOidcProviderStartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON502      *BadGateway
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthOidcProviderStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthOidcProviderStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthPasswordForgotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *MessageResponse
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthPasswordForgotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthPasswordForgotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MessageResponse
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenRefreshResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RegisterResponse
+	JSON400      *BadRequest
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthVerifyEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MessageResponse
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthVerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthVerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthVerifyEmailResendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *MessageResponse
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthVerifyEmailResendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthVerifyEmailResendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BoardsListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBoardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BoardResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBoardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBoardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBoardsImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ImportBoardResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBoardsImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBoardsImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBoardsIdBoardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBoardsIdBoardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBoardsIdBoardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardsIdBoardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BoardWithDetailsResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardsIdBoardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardsIdBoardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBoardsIdBoardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BoardResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBoardsIdBoardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBoardsIdBoardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardsIdBoardCustomFieldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomFieldsListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardsIdBoardCustomFieldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardsIdBoardCustomFieldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBoardsIdBoardCustomFieldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CustomFieldResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBoardsIdBoardCustomFieldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBoardsIdBoardCustomFieldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBoardsIdBoardCustomFieldsIdFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBoardsIdBoardCustomFieldsIdFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBoardsIdBoardCustomFieldsIdFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBoardsIdBoardCustomFieldsIdFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomFieldResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBoardsIdBoardCustomFieldsIdFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBoardsIdBoardCustomFieldsIdFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardsIdBoardExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BoardExport
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardsIdBoardExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardsIdBoardExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardsIdBoardLabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LabelsListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardsIdBoardLabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardsIdBoardLabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBoardsIdBoardLabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *LabelResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBoardsIdBoardLabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBoardsIdBoardLabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBoardsIdBoardMembersIdMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBoardsIdBoardMembersIdMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBoardsIdBoardMembersIdMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBoardsIdBoardWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UnwatchResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBoardsIdBoardWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBoardsIdBoardWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBoardsIdBoardWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WatchResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBoardsIdBoardWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBoardsIdBoardWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardsIdBoardWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhooksListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardsIdBoardWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardsIdBoardWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBoardsIdBoardWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookCreatedResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBoardsIdBoardWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBoardsIdBoardWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBoardsIdBoardWebhooksIdWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBoardsIdBoardWebhooksIdWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBoardsIdBoardWebhooksIdWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBoardsIdBoardWebhooksIdWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBoardsIdBoardWebhooksIdWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBoardsIdBoardWebhooksIdWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryLogsResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBoardsIdBoardWebhooksIdWebhookTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryLogResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBoardsIdBoardWebhooksIdWebhookTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBoardsIdBoardWebhooksIdWebhookTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CardResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+	JSON409      *WIPLimitExceeded
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCardsBulkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkCardResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *Error
+	JSON409      *WIPLimitExceeded
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCardsBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCardsBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCardsIdCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCardsIdCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCardsIdCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardsIdCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CardResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardsIdCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardsIdCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutCardsIdCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CardResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *WIPLimitExceeded
+}
+
+// Status returns HTTPResponse.Status
+func (r PutCardsIdCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutCardsIdCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutCardsIdCardCustomFieldsIdFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CardCustomFieldValueResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PutCardsIdCardCustomFieldsIdFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutCardsIdCardCustomFieldsIdFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCardsIdCardWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UnwatchResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCardsIdCardWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCardsIdCardWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCardsIdCardWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WatchResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCardsIdCardWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCardsIdCardWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostListsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostListsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostListsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteListsIdListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteListsIdListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteListsIdListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetListsIdListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListWithCardsResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetListsIdListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetListsIdListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutListsIdListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PutListsIdListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutListsIdListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostListsIdListArchiveAllCardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListCardsAffectedResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostListsIdListArchiveAllCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostListsIdListArchiveAllCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostListsIdListMoveAllCardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListCardsAffectedResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *WIPLimitExceeded
+}
+
+// Status returns HTTPResponse.Status
+func (r PostListsIdListMoveAllCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostListsIdListMoveAllCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteListsIdListWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UnwatchResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteListsIdListWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteListsIdListWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostListsIdListWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WatchResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostListsIdListWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostListsIdListWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MembersSearchResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMembersBoardsIdBoardStarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UnstarBoardResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMembersBoardsIdBoardStarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMembersBoardsIdBoardStarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersBoardsIdBoardStarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StarBoardResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersBoardsIdBoardStarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersBoardsIdBoardStarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersBoardsNameBoardUniqueJoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JoinBoardResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Error
+	JSON404      *NotFound
+	JSON409      *Error
+	JSON429      *TooManyAttempts
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersBoardsNameBoardUniqueJoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersBoardsNameBoardUniqueJoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMembersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMembersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMembersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutMembersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutMembersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutMembersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutMembersMeAvatarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON413      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutMembersMeAvatarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutMembersMeAvatarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMeExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON202      *DataExportResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMeExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMeExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMeExportIdExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataExportResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMeExportIdExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMeExportIdExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMeExportIdExportDownloadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMeExportIdExportDownloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMeExportIdExportDownloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMeMfaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MFAStatusResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMeMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMeMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersMeMfaRecoveryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodesResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersMeMfaRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersMeMfaRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersMeMfaTotpResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TOTPEnrolmentResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersMeMfaTotpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersMeMfaTotpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersMeMfaTotpConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodesResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersMeMfaTotpConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersMeMfaTotpConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersMeMfaTotpDisableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersMeMfaTotpDisableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersMeMfaTotpDisableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMeNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationsListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMeNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMeNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersMeNotificationsReadAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Marked Number of notifications that were unread
+		Marked *int `json:"marked,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersMeNotificationsReadAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersMeNotificationsReadAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersMeNotificationsIdNotificationReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersMeNotificationsIdNotificationReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersMeNotificationsIdNotificationReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMePreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberPreferencesResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMePreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMePreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchMembersMePreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberPreferencesResponse
+	JSON400      *Error
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchMembersMePreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchMembersMePreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersMeTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessTokensListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersMeTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersMeTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMembersMeTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AccessTokenCreatedResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMembersMeTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMembersMeTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMembersMeTokensIdTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMembersMeTokensIdTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMembersMeTokensIdTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembersIdMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemberProfileResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembersIdMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembersIdMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAliveWithResponse request returning *GetAliveResponse
+func (c *ClientWithResponses) GetAliveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAliveResponse, error) {
+	rsp, err := c.GetAlive(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAliveResponse(rsp)
+}
+
+// PostAuthLoginWithBodyWithResponse request with arbitrary body returning *PostAuthLoginResponse
+func (c *ClientWithResponses) PostAuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
+	rsp, err := c.PostAuthLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthLoginWithResponse(ctx context.Context, body PostAuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLoginResponse, error) {
+	rsp, err := c.PostAuthLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLoginResponse(rsp)
+}
+
+// PostAuthMfaVerifyWithBodyWithResponse request with arbitrary body returning *PostAuthMfaVerifyResponse
+func (c *ClientWithResponses) PostAuthMfaVerifyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthMfaVerifyResponse, error) {
+	rsp, err := c.PostAuthMfaVerifyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthMfaVerifyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthMfaVerifyWithResponse(ctx context.Context, body PostAuthMfaVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthMfaVerifyResponse, error) {
+	rsp, err := c.PostAuthMfaVerify(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthMfaVerifyResponse(rsp)
+}
+
+// GetAuthOidcProviderCallbackWithResponse request returning *GetAuthOidcProviderCallbackResponse
+func (c *ClientWithResponses) GetAuthOidcProviderCallbackWithResponse(ctx context.Context, provider string, params *GetAuthOidcProviderCallbackParams, reqEditors ...RequestEditorFn) (*GetAuthOidcProviderCallbackResponse, error) {
+	rsp, err := c.GetAuthOidcProviderCallback(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthOidcProviderCallbackResponse(rsp)
+}
+
+// GetAuthOidcProviderStartWithResponse request returning *GetAuthOidcProviderStartResponse
+func (c *ClientWithResponses) GetAuthOidcProviderStartWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*GetAuthOidcProviderStartResponse, error) {
+	rsp, err := c.GetAuthOidcProviderStart(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthOidcProviderStartResponse(rsp)
+}
+
+// PostAuthPasswordForgotWithBodyWithResponse request with arbitrary body returning *PostAuthPasswordForgotResponse
+func (c *ClientWithResponses) PostAuthPasswordForgotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthPasswordForgotResponse, error) {
+	rsp, err := c.PostAuthPasswordForgotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthPasswordForgotResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthPasswordForgotWithResponse(ctx context.Context, body PostAuthPasswordForgotJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthPasswordForgotResponse, error) {
+	rsp, err := c.PostAuthPasswordForgot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthPasswordForgotResponse(rsp)
+}
+
+// PostAuthPasswordResetWithBodyWithResponse request with arbitrary body returning *PostAuthPasswordResetResponse
+func (c *ClientWithResponses) PostAuthPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthPasswordResetResponse, error) {
+	rsp, err := c.PostAuthPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthPasswordResetWithResponse(ctx context.Context, body PostAuthPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthPasswordResetResponse, error) {
+	rsp, err := c.PostAuthPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthPasswordResetResponse(rsp)
+}
+
+// PostAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostAuthRefreshResponse
+func (c *ClientWithResponses) PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshResponse(rsp)
+}
+
+// PostAuthRegisterWithBodyWithResponse request with arbitrary body returning *PostAuthRegisterResponse
+func (c *ClientWithResponses) PostAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
+	rsp, err := c.PostAuthRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthRegisterWithResponse(ctx context.Context, body PostAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRegisterResponse, error) {
+	rsp, err := c.PostAuthRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRegisterResponse(rsp)
+}
+
+// PostAuthVerifyEmailWithBodyWithResponse request with arbitrary body returning *PostAuthVerifyEmailResponse
+func (c *ClientWithResponses) PostAuthVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResponse, error) {
+	rsp, err := c.PostAuthVerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthVerifyEmailWithResponse(ctx context.Context, body PostAuthVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResponse, error) {
+	rsp, err := c.PostAuthVerifyEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthVerifyEmailResponse(rsp)
+}
+
+// PostAuthVerifyEmailResendWithBodyWithResponse request with arbitrary body returning *PostAuthVerifyEmailResendResponse
+func (c *ClientWithResponses) PostAuthVerifyEmailResendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResendResponse, error) {
+	rsp, err := c.PostAuthVerifyEmailResendWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthVerifyEmailResendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthVerifyEmailResendWithResponse(ctx context.Context, body PostAuthVerifyEmailResendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthVerifyEmailResendResponse, error) {
+	rsp, err := c.PostAuthVerifyEmailResend(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthVerifyEmailResendResponse(rsp)
+}
+
+// GetBoardsWithResponse request returning *GetBoardsResponse
+func (c *ClientWithResponses) GetBoardsWithResponse(ctx context.Context, params *GetBoardsParams, reqEditors ...RequestEditorFn) (*GetBoardsResponse, error) {
+	rsp, err := c.GetBoards(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardsResponse(rsp)
+}
+
+// PostBoardsWithBodyWithResponse request with arbitrary body returning *PostBoardsResponse
+func (c *ClientWithResponses) PostBoardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error) {
+	rsp, err := c.PostBoardsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBoardsWithResponse(ctx context.Context, body PostBoardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsResponse, error) {
+	rsp, err := c.PostBoards(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsResponse(rsp)
+}
+
+// PostBoardsImportWithBodyWithResponse request with arbitrary body returning *PostBoardsImportResponse
+func (c *ClientWithResponses) PostBoardsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsImportResponse, error) {
+	rsp, err := c.PostBoardsImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBoardsImportWithResponse(ctx context.Context, body PostBoardsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsImportResponse, error) {
+	rsp, err := c.PostBoardsImport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsImportResponse(rsp)
+}
+
+// DeleteBoardsIdBoardWithResponse request returning *DeleteBoardsIdBoardResponse
+func (c *ClientWithResponses) DeleteBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardResponse, error) {
+	rsp, err := c.DeleteBoardsIdBoard(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBoardsIdBoardResponse(rsp)
+}
+
+// GetBoardsIdBoardWithResponse request returning *GetBoardsIdBoardResponse
+func (c *ClientWithResponses) GetBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardResponse, error) {
+	rsp, err := c.GetBoardsIdBoard(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardsIdBoardResponse(rsp)
+}
+
+// PutBoardsIdBoardWithBodyWithResponse request with arbitrary body returning *PutBoardsIdBoardResponse
+func (c *ClientWithResponses) PutBoardsIdBoardWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error) {
+	rsp, err := c.PutBoardsIdBoardWithBody(ctx, idBoard, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBoardsIdBoardResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutBoardsIdBoardWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PutBoardsIdBoardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardResponse, error) {
+	rsp, err := c.PutBoardsIdBoard(ctx, idBoard, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBoardsIdBoardResponse(rsp)
+}
+
+// GetBoardsIdBoardCustomFieldsWithResponse request returning *GetBoardsIdBoardCustomFieldsResponse
+func (c *ClientWithResponses) GetBoardsIdBoardCustomFieldsWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardCustomFieldsResponse, error) {
+	rsp, err := c.GetBoardsIdBoardCustomFields(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardsIdBoardCustomFieldsResponse(rsp)
+}
+
+// PostBoardsIdBoardCustomFieldsWithBodyWithResponse request with arbitrary body returning *PostBoardsIdBoardCustomFieldsResponse
+func (c *ClientWithResponses) PostBoardsIdBoardCustomFieldsWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardCustomFieldsResponse, error) {
+	rsp, err := c.PostBoardsIdBoardCustomFieldsWithBody(ctx, idBoard, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardCustomFieldsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBoardsIdBoardCustomFieldsWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardCustomFieldsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardCustomFieldsResponse, error) {
+	rsp, err := c.PostBoardsIdBoardCustomFields(ctx, idBoard, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardCustomFieldsResponse(rsp)
+}
+
+// DeleteBoardsIdBoardCustomFieldsIdFieldWithResponse request returning *DeleteBoardsIdBoardCustomFieldsIdFieldResponse
+func (c *ClientWithResponses) DeleteBoardsIdBoardCustomFieldsIdFieldWithResponse(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardCustomFieldsIdFieldResponse, error) {
+	rsp, err := c.DeleteBoardsIdBoardCustomFieldsIdField(ctx, idBoard, idField, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBoardsIdBoardCustomFieldsIdFieldResponse(rsp)
+}
+
+// PutBoardsIdBoardCustomFieldsIdFieldWithBodyWithResponse request with arbitrary body returning *PutBoardsIdBoardCustomFieldsIdFieldResponse
+func (c *ClientWithResponses) PutBoardsIdBoardCustomFieldsIdFieldWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardCustomFieldsIdFieldResponse, error) {
+	rsp, err := c.PutBoardsIdBoardCustomFieldsIdFieldWithBody(ctx, idBoard, idField, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBoardsIdBoardCustomFieldsIdFieldResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutBoardsIdBoardCustomFieldsIdFieldWithResponse(ctx context.Context, idBoard openapi_types.UUID, idField openapi_types.UUID, body PutBoardsIdBoardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardCustomFieldsIdFieldResponse, error) {
+	rsp, err := c.PutBoardsIdBoardCustomFieldsIdField(ctx, idBoard, idField, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBoardsIdBoardCustomFieldsIdFieldResponse(rsp)
+}
+
+// GetBoardsIdBoardExportWithResponse request returning *GetBoardsIdBoardExportResponse
+func (c *ClientWithResponses) GetBoardsIdBoardExportWithResponse(ctx context.Context, idBoard openapi_types.UUID, params *GetBoardsIdBoardExportParams, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardExportResponse, error) {
+	rsp, err := c.GetBoardsIdBoardExport(ctx, idBoard, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardsIdBoardExportResponse(rsp)
+}
+
+// GetBoardsIdBoardLabelsWithResponse request returning *GetBoardsIdBoardLabelsResponse
+func (c *ClientWithResponses) GetBoardsIdBoardLabelsWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardLabelsResponse, error) {
+	rsp, err := c.GetBoardsIdBoardLabels(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardsIdBoardLabelsResponse(rsp)
+}
+
+// PostBoardsIdBoardLabelsWithBodyWithResponse request with arbitrary body returning *PostBoardsIdBoardLabelsResponse
+func (c *ClientWithResponses) PostBoardsIdBoardLabelsWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardLabelsResponse, error) {
+	rsp, err := c.PostBoardsIdBoardLabelsWithBody(ctx, idBoard, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardLabelsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBoardsIdBoardLabelsWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardLabelsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardLabelsResponse, error) {
+	rsp, err := c.PostBoardsIdBoardLabels(ctx, idBoard, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardLabelsResponse(rsp)
+}
+
+// DeleteBoardsIdBoardMembersIdMemberWithResponse request returning *DeleteBoardsIdBoardMembersIdMemberResponse
+func (c *ClientWithResponses) DeleteBoardsIdBoardMembersIdMemberWithResponse(ctx context.Context, idBoard openapi_types.UUID, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardMembersIdMemberResponse, error) {
+	rsp, err := c.DeleteBoardsIdBoardMembersIdMember(ctx, idBoard, idMember, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBoardsIdBoardMembersIdMemberResponse(rsp)
+}
+
+// DeleteBoardsIdBoardWatchWithResponse request returning *DeleteBoardsIdBoardWatchResponse
+func (c *ClientWithResponses) DeleteBoardsIdBoardWatchWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardWatchResponse, error) {
+	rsp, err := c.DeleteBoardsIdBoardWatch(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBoardsIdBoardWatchResponse(rsp)
+}
+
+// PostBoardsIdBoardWatchWithResponse request returning *PostBoardsIdBoardWatchResponse
+func (c *ClientWithResponses) PostBoardsIdBoardWatchWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWatchResponse, error) {
+	rsp, err := c.PostBoardsIdBoardWatch(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardWatchResponse(rsp)
+}
+
+// GetBoardsIdBoardWebhooksWithResponse request returning *GetBoardsIdBoardWebhooksResponse
+func (c *ClientWithResponses) GetBoardsIdBoardWebhooksWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardWebhooksResponse, error) {
+	rsp, err := c.GetBoardsIdBoardWebhooks(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardsIdBoardWebhooksResponse(rsp)
+}
+
+// PostBoardsIdBoardWebhooksWithBodyWithResponse request with arbitrary body returning *PostBoardsIdBoardWebhooksResponse
+func (c *ClientWithResponses) PostBoardsIdBoardWebhooksWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWebhooksResponse, error) {
+	rsp, err := c.PostBoardsIdBoardWebhooksWithBody(ctx, idBoard, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBoardsIdBoardWebhooksWithResponse(ctx context.Context, idBoard openapi_types.UUID, body PostBoardsIdBoardWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWebhooksResponse, error) {
+	rsp, err := c.PostBoardsIdBoardWebhooks(ctx, idBoard, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardWebhooksResponse(rsp)
+}
+
+// DeleteBoardsIdBoardWebhooksIdWebhookWithResponse request returning *DeleteBoardsIdBoardWebhooksIdWebhookResponse
+func (c *ClientWithResponses) DeleteBoardsIdBoardWebhooksIdWebhookWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBoardsIdBoardWebhooksIdWebhookResponse, error) {
+	rsp, err := c.DeleteBoardsIdBoardWebhooksIdWebhook(ctx, idBoard, idWebhook, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBoardsIdBoardWebhooksIdWebhookResponse(rsp)
+}
+
+// PutBoardsIdBoardWebhooksIdWebhookWithBodyWithResponse request with arbitrary body returning *PutBoardsIdBoardWebhooksIdWebhookResponse
+func (c *ClientWithResponses) PutBoardsIdBoardWebhooksIdWebhookWithBodyWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardWebhooksIdWebhookResponse, error) {
+	rsp, err := c.PutBoardsIdBoardWebhooksIdWebhookWithBody(ctx, idBoard, idWebhook, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBoardsIdBoardWebhooksIdWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutBoardsIdBoardWebhooksIdWebhookWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, body PutBoardsIdBoardWebhooksIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBoardsIdBoardWebhooksIdWebhookResponse, error) {
+	rsp, err := c.PutBoardsIdBoardWebhooksIdWebhook(ctx, idBoard, idWebhook, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBoardsIdBoardWebhooksIdWebhookResponse(rsp)
+}
+
+// GetBoardsIdBoardWebhooksIdWebhookDeliveriesWithResponse request returning *GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse
+func (c *ClientWithResponses) GetBoardsIdBoardWebhooksIdWebhookDeliveriesWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params *GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse, error) {
+	rsp, err := c.GetBoardsIdBoardWebhooksIdWebhookDeliveries(ctx, idBoard, idWebhook, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse(rsp)
+}
+
+// PostBoardsIdBoardWebhooksIdWebhookTestWithResponse request returning *PostBoardsIdBoardWebhooksIdWebhookTestResponse
+func (c *ClientWithResponses) PostBoardsIdBoardWebhooksIdWebhookTestWithResponse(ctx context.Context, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostBoardsIdBoardWebhooksIdWebhookTestResponse, error) {
+	rsp, err := c.PostBoardsIdBoardWebhooksIdWebhookTest(ctx, idBoard, idWebhook, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBoardsIdBoardWebhooksIdWebhookTestResponse(rsp)
+}
+
+// PostCardsWithBodyWithResponse request with arbitrary body returning *PostCardsResponse
+func (c *ClientWithResponses) PostCardsWithBodyWithResponse(ctx context.Context, params *PostCardsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCardsResponse, error) {
+	rsp, err := c.PostCardsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCardsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCardsWithResponse(ctx context.Context, params *PostCardsParams, body PostCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCardsResponse, error) {
+	rsp, err := c.PostCards(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCardsResponse(rsp)
+}
+
+// PostCardsBulkWithBodyWithResponse request with arbitrary body returning *PostCardsBulkResponse
+func (c *ClientWithResponses) PostCardsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCardsBulkResponse, error) {
+	rsp, err := c.PostCardsBulkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCardsBulkResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCardsBulkWithResponse(ctx context.Context, body PostCardsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCardsBulkResponse, error) {
+	rsp, err := c.PostCardsBulk(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCardsBulkResponse(rsp)
+}
+
+// DeleteCardsIdCardWithResponse request returning *DeleteCardsIdCardResponse
+func (c *ClientWithResponses) DeleteCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCardsIdCardResponse, error) {
+	rsp, err := c.DeleteCardsIdCard(ctx, idCard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCardsIdCardResponse(rsp)
+}
+
+// GetCardsIdCardWithResponse request returning *GetCardsIdCardResponse
+func (c *ClientWithResponses) GetCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetCardsIdCardResponse, error) {
+	rsp, err := c.GetCardsIdCard(ctx, idCard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardsIdCardResponse(rsp)
+}
+
+// PutCardsIdCardWithBodyWithResponse request with arbitrary body returning *PutCardsIdCardResponse
+func (c *ClientWithResponses) PutCardsIdCardWithBodyWithResponse(ctx context.Context, idCard openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error) {
+	rsp, err := c.PutCardsIdCardWithBody(ctx, idCard, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCardsIdCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutCardsIdCardWithResponse(ctx context.Context, idCard openapi_types.UUID, body PutCardsIdCardJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCardsIdCardResponse, error) {
+	rsp, err := c.PutCardsIdCard(ctx, idCard, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCardsIdCardResponse(rsp)
+}
+
+// PutCardsIdCardCustomFieldsIdFieldWithBodyWithResponse request with arbitrary body returning *PutCardsIdCardCustomFieldsIdFieldResponse
+func (c *ClientWithResponses) PutCardsIdCardCustomFieldsIdFieldWithBodyWithResponse(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCardsIdCardCustomFieldsIdFieldResponse, error) {
+	rsp, err := c.PutCardsIdCardCustomFieldsIdFieldWithBody(ctx, idCard, idField, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCardsIdCardCustomFieldsIdFieldResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutCardsIdCardCustomFieldsIdFieldWithResponse(ctx context.Context, idCard openapi_types.UUID, idField openapi_types.UUID, body PutCardsIdCardCustomFieldsIdFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCardsIdCardCustomFieldsIdFieldResponse, error) {
+	rsp, err := c.PutCardsIdCardCustomFieldsIdField(ctx, idCard, idField, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCardsIdCardCustomFieldsIdFieldResponse(rsp)
+}
+
+// DeleteCardsIdCardWatchWithResponse request returning *DeleteCardsIdCardWatchResponse
+func (c *ClientWithResponses) DeleteCardsIdCardWatchWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCardsIdCardWatchResponse, error) {
+	rsp, err := c.DeleteCardsIdCardWatch(ctx, idCard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCardsIdCardWatchResponse(rsp)
+}
+
+// PostCardsIdCardWatchWithResponse request returning *PostCardsIdCardWatchResponse
+func (c *ClientWithResponses) PostCardsIdCardWatchWithResponse(ctx context.Context, idCard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostCardsIdCardWatchResponse, error) {
+	rsp, err := c.PostCardsIdCardWatch(ctx, idCard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCardsIdCardWatchResponse(rsp)
+}
+
+// PostListsWithBodyWithResponse request with arbitrary body returning *PostListsResponse
+func (c *ClientWithResponses) PostListsWithBodyWithResponse(ctx context.Context, params *PostListsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostListsResponse, error) {
+	rsp, err := c.PostListsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostListsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostListsWithResponse(ctx context.Context, params *PostListsParams, body PostListsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostListsResponse, error) {
+	rsp, err := c.PostLists(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostListsResponse(rsp)
+}
+
+// DeleteListsIdListWithResponse request returning *DeleteListsIdListResponse
+func (c *ClientWithResponses) DeleteListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteListsIdListResponse, error) {
+	rsp, err := c.DeleteListsIdList(ctx, idList, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteListsIdListResponse(rsp)
+}
+
+// GetListsIdListWithResponse request returning *GetListsIdListResponse
+func (c *ClientWithResponses) GetListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetListsIdListResponse, error) {
+	rsp, err := c.GetListsIdList(ctx, idList, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetListsIdListResponse(rsp)
+}
+
+// PutListsIdListWithBodyWithResponse request with arbitrary body returning *PutListsIdListResponse
+func (c *ClientWithResponses) PutListsIdListWithBodyWithResponse(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error) {
+	rsp, err := c.PutListsIdListWithBody(ctx, idList, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutListsIdListResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutListsIdListWithResponse(ctx context.Context, idList openapi_types.UUID, body PutListsIdListJSONRequestBody, reqEditors ...RequestEditorFn) (*PutListsIdListResponse, error) {
+	rsp, err := c.PutListsIdList(ctx, idList, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutListsIdListResponse(rsp)
+}
+
+// PostListsIdListArchiveAllCardsWithResponse request returning *PostListsIdListArchiveAllCardsResponse
+func (c *ClientWithResponses) PostListsIdListArchiveAllCardsWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostListsIdListArchiveAllCardsResponse, error) {
+	rsp, err := c.PostListsIdListArchiveAllCards(ctx, idList, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostListsIdListArchiveAllCardsResponse(rsp)
+}
+
+// PostListsIdListMoveAllCardsWithBodyWithResponse request with arbitrary body returning *PostListsIdListMoveAllCardsResponse
+func (c *ClientWithResponses) PostListsIdListMoveAllCardsWithBodyWithResponse(ctx context.Context, idList openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostListsIdListMoveAllCardsResponse, error) {
+	rsp, err := c.PostListsIdListMoveAllCardsWithBody(ctx, idList, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostListsIdListMoveAllCardsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostListsIdListMoveAllCardsWithResponse(ctx context.Context, idList openapi_types.UUID, body PostListsIdListMoveAllCardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostListsIdListMoveAllCardsResponse, error) {
+	rsp, err := c.PostListsIdListMoveAllCards(ctx, idList, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostListsIdListMoveAllCardsResponse(rsp)
+}
+
+// DeleteListsIdListWatchWithResponse request returning *DeleteListsIdListWatchResponse
+func (c *ClientWithResponses) DeleteListsIdListWatchWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteListsIdListWatchResponse, error) {
+	rsp, err := c.DeleteListsIdListWatch(ctx, idList, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteListsIdListWatchResponse(rsp)
+}
+
+// PostListsIdListWatchWithResponse request returning *PostListsIdListWatchResponse
+func (c *ClientWithResponses) PostListsIdListWatchWithResponse(ctx context.Context, idList openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostListsIdListWatchResponse, error) {
+	rsp, err := c.PostListsIdListWatch(ctx, idList, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostListsIdListWatchResponse(rsp)
+}
+
+// GetMembersWithResponse request returning *GetMembersResponse
+func (c *ClientWithResponses) GetMembersWithResponse(ctx context.Context, params *GetMembersParams, reqEditors ...RequestEditorFn) (*GetMembersResponse, error) {
+	rsp, err := c.GetMembers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersResponse(rsp)
+}
+
+// DeleteMembersBoardsIdBoardStarWithResponse request returning *DeleteMembersBoardsIdBoardStarResponse
+func (c *ClientWithResponses) DeleteMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMembersBoardsIdBoardStarResponse, error) {
+	rsp, err := c.DeleteMembersBoardsIdBoardStar(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMembersBoardsIdBoardStarResponse(rsp)
+}
+
+// PostMembersBoardsIdBoardStarWithResponse request returning *PostMembersBoardsIdBoardStarResponse
+func (c *ClientWithResponses) PostMembersBoardsIdBoardStarWithResponse(ctx context.Context, idBoard openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostMembersBoardsIdBoardStarResponse, error) {
+	rsp, err := c.PostMembersBoardsIdBoardStar(ctx, idBoard, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersBoardsIdBoardStarResponse(rsp)
+}
+
+// PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse request with arbitrary body returning *PostMembersBoardsNameBoardUniqueJoinResponse
+func (c *ClientWithResponses) PostMembersBoardsNameBoardUniqueJoinWithBodyWithResponse(ctx context.Context, nameBoardUnique string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error) {
+	rsp, err := c.PostMembersBoardsNameBoardUniqueJoinWithBody(ctx, nameBoardUnique, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersBoardsNameBoardUniqueJoinResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMembersBoardsNameBoardUniqueJoinWithResponse(ctx context.Context, nameBoardUnique string, body PostMembersBoardsNameBoardUniqueJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersBoardsNameBoardUniqueJoinResponse, error) {
+	rsp, err := c.PostMembersBoardsNameBoardUniqueJoin(ctx, nameBoardUnique, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersBoardsNameBoardUniqueJoinResponse(rsp)
+}
+
+// DeleteMembersMeWithBodyWithResponse request with arbitrary body returning *DeleteMembersMeResponse
+func (c *ClientWithResponses) DeleteMembersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMembersMeResponse, error) {
+	rsp, err := c.DeleteMembersMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMembersMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteMembersMeWithResponse(ctx context.Context, body DeleteMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMembersMeResponse, error) {
+	rsp, err := c.DeleteMembersMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMembersMeResponse(rsp)
+}
+
+// GetMembersMeWithResponse request returning *GetMembersMeResponse
+func (c *ClientWithResponses) GetMembersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeResponse, error) {
+	rsp, err := c.GetMembersMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMeResponse(rsp)
+}
+
+// PutMembersMeWithBodyWithResponse request with arbitrary body returning *PutMembersMeResponse
+func (c *ClientWithResponses) PutMembersMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error) {
+	rsp, err := c.PutMembersMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMembersMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutMembersMeWithResponse(ctx context.Context, body PutMembersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMembersMeResponse, error) {
+	rsp, err := c.PutMembersMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMembersMeResponse(rsp)
+}
+
+// PutMembersMeAvatarWithBodyWithResponse request with arbitrary body returning *PutMembersMeAvatarResponse
+func (c *ClientWithResponses) PutMembersMeAvatarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMembersMeAvatarResponse, error) {
+	rsp, err := c.PutMembersMeAvatarWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMembersMeAvatarResponse(rsp)
+}
+
+// GetMembersMeExportWithResponse request returning *GetMembersMeExportResponse
+func (c *ClientWithResponses) GetMembersMeExportWithResponse(ctx context.Context, params *GetMembersMeExportParams, reqEditors ...RequestEditorFn) (*GetMembersMeExportResponse, error) {
+	rsp, err := c.GetMembersMeExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMeExportResponse(rsp)
+}
+
+// GetMembersMeExportIdExportWithResponse request returning *GetMembersMeExportIdExportResponse
+func (c *ClientWithResponses) GetMembersMeExportIdExportWithResponse(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMembersMeExportIdExportResponse, error) {
+	rsp, err := c.GetMembersMeExportIdExport(ctx, idExport, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMeExportIdExportResponse(rsp)
+}
+
+// GetMembersMeExportIdExportDownloadWithResponse request returning *GetMembersMeExportIdExportDownloadResponse
+func (c *ClientWithResponses) GetMembersMeExportIdExportDownloadWithResponse(ctx context.Context, idExport openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMembersMeExportIdExportDownloadResponse, error) {
+	rsp, err := c.GetMembersMeExportIdExportDownload(ctx, idExport, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMeExportIdExportDownloadResponse(rsp)
+}
+
+// GetMembersMeMfaWithResponse request returning *GetMembersMeMfaResponse
+func (c *ClientWithResponses) GetMembersMeMfaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeMfaResponse, error) {
+	rsp, err := c.GetMembersMeMfa(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMeMfaResponse(rsp)
+}
+
+// PostMembersMeMfaRecoveryCodesWithBodyWithResponse request with arbitrary body returning *PostMembersMeMfaRecoveryCodesResponse
+func (c *ClientWithResponses) PostMembersMeMfaRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeMfaRecoveryCodesResponse, error) {
+	rsp, err := c.PostMembersMeMfaRecoveryCodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeMfaRecoveryCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMembersMeMfaRecoveryCodesWithResponse(ctx context.Context, body PostMembersMeMfaRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeMfaRecoveryCodesResponse, error) {
+	rsp, err := c.PostMembersMeMfaRecoveryCodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeMfaRecoveryCodesResponse(rsp)
+}
+
+// PostMembersMeMfaTotpWithResponse request returning *PostMembersMeMfaTotpResponse
+func (c *ClientWithResponses) PostMembersMeMfaTotpWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpResponse, error) {
+	rsp, err := c.PostMembersMeMfaTotp(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeMfaTotpResponse(rsp)
+}
+
+// PostMembersMeMfaTotpConfirmWithBodyWithResponse request with arbitrary body returning *PostMembersMeMfaTotpConfirmResponse
+func (c *ClientWithResponses) PostMembersMeMfaTotpConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpConfirmResponse, error) {
+	rsp, err := c.PostMembersMeMfaTotpConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeMfaTotpConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMembersMeMfaTotpConfirmWithResponse(ctx context.Context, body PostMembersMeMfaTotpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpConfirmResponse, error) {
+	rsp, err := c.PostMembersMeMfaTotpConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeMfaTotpConfirmResponse(rsp)
+}
+
+// PostMembersMeMfaTotpDisableWithBodyWithResponse request with arbitrary body returning *PostMembersMeMfaTotpDisableResponse
+func (c *ClientWithResponses) PostMembersMeMfaTotpDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpDisableResponse, error) {
+	rsp, err := c.PostMembersMeMfaTotpDisableWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeMfaTotpDisableResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMembersMeMfaTotpDisableWithResponse(ctx context.Context, body PostMembersMeMfaTotpDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeMfaTotpDisableResponse, error) {
+	rsp, err := c.PostMembersMeMfaTotpDisable(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeMfaTotpDisableResponse(rsp)
+}
+
+// GetMembersMeNotificationsWithResponse request returning *GetMembersMeNotificationsResponse
+func (c *ClientWithResponses) GetMembersMeNotificationsWithResponse(ctx context.Context, params *GetMembersMeNotificationsParams, reqEditors ...RequestEditorFn) (*GetMembersMeNotificationsResponse, error) {
+	rsp, err := c.GetMembersMeNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMeNotificationsResponse(rsp)
+}
+
+// PostMembersMeNotificationsReadAllWithResponse request returning *PostMembersMeNotificationsReadAllResponse
+func (c *ClientWithResponses) PostMembersMeNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostMembersMeNotificationsReadAllResponse, error) {
+	rsp, err := c.PostMembersMeNotificationsReadAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeNotificationsReadAllResponse(rsp)
+}
+
+// PostMembersMeNotificationsIdNotificationReadWithResponse request returning *PostMembersMeNotificationsIdNotificationReadResponse
+func (c *ClientWithResponses) PostMembersMeNotificationsIdNotificationReadWithResponse(ctx context.Context, idNotification openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostMembersMeNotificationsIdNotificationReadResponse, error) {
+	rsp, err := c.PostMembersMeNotificationsIdNotificationRead(ctx, idNotification, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeNotificationsIdNotificationReadResponse(rsp)
+}
+
+// GetMembersMePreferencesWithResponse request returning *GetMembersMePreferencesResponse
+func (c *ClientWithResponses) GetMembersMePreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMePreferencesResponse, error) {
+	rsp, err := c.GetMembersMePreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMePreferencesResponse(rsp)
+}
+
+// PatchMembersMePreferencesWithBodyWithResponse request with arbitrary body returning *PatchMembersMePreferencesResponse
+func (c *ClientWithResponses) PatchMembersMePreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMembersMePreferencesResponse, error) {
+	rsp, err := c.PatchMembersMePreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMembersMePreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchMembersMePreferencesWithResponse(ctx context.Context, body PatchMembersMePreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMembersMePreferencesResponse, error) {
+	rsp, err := c.PatchMembersMePreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMembersMePreferencesResponse(rsp)
+}
+
+// GetMembersMeTokensWithResponse request returning *GetMembersMeTokensResponse
+func (c *ClientWithResponses) GetMembersMeTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMembersMeTokensResponse, error) {
+	rsp, err := c.GetMembersMeTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersMeTokensResponse(rsp)
+}
+
+// PostMembersMeTokensWithBodyWithResponse request with arbitrary body returning *PostMembersMeTokensResponse
+func (c *ClientWithResponses) PostMembersMeTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMembersMeTokensResponse, error) {
+	rsp, err := c.PostMembersMeTokensWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeTokensResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMembersMeTokensWithResponse(ctx context.Context, body PostMembersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMembersMeTokensResponse, error) {
+	rsp, err := c.PostMembersMeTokens(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMembersMeTokensResponse(rsp)
+}
+
+// DeleteMembersMeTokensIdTokenWithResponse request returning *DeleteMembersMeTokensIdTokenResponse
+func (c *ClientWithResponses) DeleteMembersMeTokensIdTokenWithResponse(ctx context.Context, idToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMembersMeTokensIdTokenResponse, error) {
+	rsp, err := c.DeleteMembersMeTokensIdToken(ctx, idToken, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMembersMeTokensIdTokenResponse(rsp)
+}
+
+// GetMembersIdMemberWithResponse request returning *GetMembersIdMemberResponse
+func (c *ClientWithResponses) GetMembersIdMemberWithResponse(ctx context.Context, idMember openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMembersIdMemberResponse, error) {
+	rsp, err := c.GetMembersIdMember(ctx, idMember, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembersIdMemberResponse(rsp)
+}
+
+// ParseGetAliveResponse parses an HTTP response from a GetAliveWithResponse call
+func ParseGetAliveResponse(rsp *http.Response) (*GetAliveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAliveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLoginResponse parses an HTTP response from a PostAuthLoginWithResponse call
+func ParsePostAuthLoginResponse(rsp *http.Response) (*PostAuthLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyAttempts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthMfaVerifyResponse parses an HTTP response from a PostAuthMfaVerifyWithResponse call
+func ParsePostAuthMfaVerifyResponse(rsp *http.Response) (*PostAuthMfaVerifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthMfaVerifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyAttempts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthOidcProviderCallbackResponse parses an HTTP response from a GetAuthOidcProviderCallbackWithResponse call
+func ParseGetAuthOidcProviderCallbackResponse(rsp *http.Response) (*GetAuthOidcProviderCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthOidcProviderCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthOidcProviderStartResponse parses an HTTP response from a GetAuthOidcProviderStartWithResponse call
+func ParseGetAuthOidcProviderStartResponse(rsp *http.Response) (*GetAuthOidcProviderStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthOidcProviderStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest BadGateway
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthPasswordForgotResponse parses an HTTP response from a PostAuthPasswordForgotWithResponse call
+func ParsePostAuthPasswordForgotResponse(rsp *http.Response) (*PostAuthPasswordForgotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthPasswordForgotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthPasswordResetResponse parses an HTTP response from a PostAuthPasswordResetWithResponse call
+func ParsePostAuthPasswordResetResponse(rsp *http.Response) (*PostAuthPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthRefreshResponse parses an HTTP response from a PostAuthRefreshWithResponse call
+func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenRefreshResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthRegisterResponse parses an HTTP response from a PostAuthRegisterWithResponse call
+func ParsePostAuthRegisterResponse(rsp *http.Response) (*PostAuthRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RegisterResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthVerifyEmailResponse parses an HTTP response from a PostAuthVerifyEmailWithResponse call
+func ParsePostAuthVerifyEmailResponse(rsp *http.Response) (*PostAuthVerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthVerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthVerifyEmailResendResponse parses an HTTP response from a PostAuthVerifyEmailResendWithResponse call
+func ParsePostAuthVerifyEmailResendResponse(rsp *http.Response) (*PostAuthVerifyEmailResendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthVerifyEmailResendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MessageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardsResponse parses an HTTP response from a GetBoardsWithResponse call
+func ParseGetBoardsResponse(rsp *http.Response) (*GetBoardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BoardsListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBoardsResponse parses an HTTP response from a PostBoardsWithResponse call
+func ParsePostBoardsResponse(rsp *http.Response) (*PostBoardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBoardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BoardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBoardsImportResponse parses an HTTP response from a PostBoardsImportWithResponse call
+func ParsePostBoardsImportResponse(rsp *http.Response) (*PostBoardsImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBoardsImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ImportBoardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBoardsIdBoardResponse parses an HTTP response from a DeleteBoardsIdBoardWithResponse call
+func ParseDeleteBoardsIdBoardResponse(rsp *http.Response) (*DeleteBoardsIdBoardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBoardsIdBoardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardsIdBoardResponse parses an HTTP response from a GetBoardsIdBoardWithResponse call
+func ParseGetBoardsIdBoardResponse(rsp *http.Response) (*GetBoardsIdBoardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardsIdBoardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BoardWithDetailsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutBoardsIdBoardResponse parses an HTTP response from a PutBoardsIdBoardWithResponse call
+func ParsePutBoardsIdBoardResponse(rsp *http.Response) (*PutBoardsIdBoardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBoardsIdBoardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BoardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardsIdBoardCustomFieldsResponse parses an HTTP response from a GetBoardsIdBoardCustomFieldsWithResponse call
+func ParseGetBoardsIdBoardCustomFieldsResponse(rsp *http.Response) (*GetBoardsIdBoardCustomFieldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardsIdBoardCustomFieldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomFieldsListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBoardsIdBoardCustomFieldsResponse parses an HTTP response from a PostBoardsIdBoardCustomFieldsWithResponse call
+func ParsePostBoardsIdBoardCustomFieldsResponse(rsp *http.Response) (*PostBoardsIdBoardCustomFieldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBoardsIdBoardCustomFieldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CustomFieldResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBoardsIdBoardCustomFieldsIdFieldResponse parses an HTTP response from a DeleteBoardsIdBoardCustomFieldsIdFieldWithResponse call
+func ParseDeleteBoardsIdBoardCustomFieldsIdFieldResponse(rsp *http.Response) (*DeleteBoardsIdBoardCustomFieldsIdFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBoardsIdBoardCustomFieldsIdFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutBoardsIdBoardCustomFieldsIdFieldResponse parses an HTTP response from a PutBoardsIdBoardCustomFieldsIdFieldWithResponse call
+func ParsePutBoardsIdBoardCustomFieldsIdFieldResponse(rsp *http.Response) (*PutBoardsIdBoardCustomFieldsIdFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBoardsIdBoardCustomFieldsIdFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomFieldResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardsIdBoardExportResponse parses an HTTP response from a GetBoardsIdBoardExportWithResponse call
+func ParseGetBoardsIdBoardExportResponse(rsp *http.Response) (*GetBoardsIdBoardExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardsIdBoardExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BoardExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardsIdBoardLabelsResponse parses an HTTP response from a GetBoardsIdBoardLabelsWithResponse call
+func ParseGetBoardsIdBoardLabelsResponse(rsp *http.Response) (*GetBoardsIdBoardLabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardsIdBoardLabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LabelsListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBoardsIdBoardLabelsResponse parses an HTTP response from a PostBoardsIdBoardLabelsWithResponse call
+func ParsePostBoardsIdBoardLabelsResponse(rsp *http.Response) (*PostBoardsIdBoardLabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBoardsIdBoardLabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LabelResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBoardsIdBoardMembersIdMemberResponse parses an HTTP response from a DeleteBoardsIdBoardMembersIdMemberWithResponse call
+func ParseDeleteBoardsIdBoardMembersIdMemberResponse(rsp *http.Response) (*DeleteBoardsIdBoardMembersIdMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBoardsIdBoardMembersIdMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBoardsIdBoardWatchResponse parses an HTTP response from a DeleteBoardsIdBoardWatchWithResponse call
+func ParseDeleteBoardsIdBoardWatchResponse(rsp *http.Response) (*DeleteBoardsIdBoardWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBoardsIdBoardWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnwatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBoardsIdBoardWatchResponse parses an HTTP response from a PostBoardsIdBoardWatchWithResponse call
+func ParsePostBoardsIdBoardWatchResponse(rsp *http.Response) (*PostBoardsIdBoardWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBoardsIdBoardWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardsIdBoardWebhooksResponse parses an HTTP response from a GetBoardsIdBoardWebhooksWithResponse call
+func ParseGetBoardsIdBoardWebhooksResponse(rsp *http.Response) (*GetBoardsIdBoardWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardsIdBoardWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhooksListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBoardsIdBoardWebhooksResponse parses an HTTP response from a PostBoardsIdBoardWebhooksWithResponse call
+func ParsePostBoardsIdBoardWebhooksResponse(rsp *http.Response) (*PostBoardsIdBoardWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBoardsIdBoardWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookCreatedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBoardsIdBoardWebhooksIdWebhookResponse parses an HTTP response from a DeleteBoardsIdBoardWebhooksIdWebhookWithResponse call
+func ParseDeleteBoardsIdBoardWebhooksIdWebhookResponse(rsp *http.Response) (*DeleteBoardsIdBoardWebhooksIdWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBoardsIdBoardWebhooksIdWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutBoardsIdBoardWebhooksIdWebhookResponse parses an HTTP response from a PutBoardsIdBoardWebhooksIdWebhookWithResponse call
+func ParsePutBoardsIdBoardWebhooksIdWebhookResponse(rsp *http.Response) (*PutBoardsIdBoardWebhooksIdWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBoardsIdBoardWebhooksIdWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse parses an HTTP response from a GetBoardsIdBoardWebhooksIdWebhookDeliveriesWithResponse call
+func ParseGetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse(rsp *http.Response) (*GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardsIdBoardWebhooksIdWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryLogsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBoardsIdBoardWebhooksIdWebhookTestResponse parses an HTTP response from a PostBoardsIdBoardWebhooksIdWebhookTestWithResponse call
+func ParsePostBoardsIdBoardWebhooksIdWebhookTestResponse(rsp *http.Response) (*PostBoardsIdBoardWebhooksIdWebhookTestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBoardsIdBoardWebhooksIdWebhookTestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryLogResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCardsResponse parses an HTTP response from a PostCardsWithResponse call
+func ParsePostCardsResponse(rsp *http.Response) (*PostCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest WIPLimitExceeded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCardsBulkResponse parses an HTTP response from a PostCardsBulkWithResponse call
+func ParsePostCardsBulkResponse(rsp *http.Response) (*PostCardsBulkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCardsBulkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkCardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest WIPLimitExceeded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCardsIdCardResponse parses an HTTP response from a DeleteCardsIdCardWithResponse call
+func ParseDeleteCardsIdCardResponse(rsp *http.Response) (*DeleteCardsIdCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCardsIdCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCardsIdCardResponse parses an HTTP response from a GetCardsIdCardWithResponse call
+func ParseGetCardsIdCardResponse(rsp *http.Response) (*GetCardsIdCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCardsIdCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutCardsIdCardResponse parses an HTTP response from a PutCardsIdCardWithResponse call
+func ParsePutCardsIdCardResponse(rsp *http.Response) (*PutCardsIdCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutCardsIdCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest WIPLimitExceeded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutCardsIdCardCustomFieldsIdFieldResponse parses an HTTP response from a PutCardsIdCardCustomFieldsIdFieldWithResponse call
+func ParsePutCardsIdCardCustomFieldsIdFieldResponse(rsp *http.Response) (*PutCardsIdCardCustomFieldsIdFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutCardsIdCardCustomFieldsIdFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CardCustomFieldValueResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCardsIdCardWatchResponse parses an HTTP response from a DeleteCardsIdCardWatchWithResponse call
+func ParseDeleteCardsIdCardWatchResponse(rsp *http.Response) (*DeleteCardsIdCardWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCardsIdCardWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnwatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCardsIdCardWatchResponse parses an HTTP response from a PostCardsIdCardWatchWithResponse call
+func ParsePostCardsIdCardWatchResponse(rsp *http.Response) (*PostCardsIdCardWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCardsIdCardWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostListsResponse parses an HTTP response from a PostListsWithResponse call
+func ParsePostListsResponse(rsp *http.Response) (*PostListsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostListsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteListsIdListResponse parses an HTTP response from a DeleteListsIdListWithResponse call
+func ParseDeleteListsIdListResponse(rsp *http.Response) (*DeleteListsIdListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteListsIdListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetListsIdListResponse parses an HTTP response from a GetListsIdListWithResponse call
+func ParseGetListsIdListResponse(rsp *http.Response) (*GetListsIdListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetListsIdListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListWithCardsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutListsIdListResponse parses an HTTP response from a PutListsIdListWithResponse call
+func ParsePutListsIdListResponse(rsp *http.Response) (*PutListsIdListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutListsIdListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostListsIdListArchiveAllCardsResponse parses an HTTP response from a PostListsIdListArchiveAllCardsWithResponse call
+func ParsePostListsIdListArchiveAllCardsResponse(rsp *http.Response) (*PostListsIdListArchiveAllCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostListsIdListArchiveAllCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCardsAffectedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostListsIdListMoveAllCardsResponse parses an HTTP response from a PostListsIdListMoveAllCardsWithResponse call
+func ParsePostListsIdListMoveAllCardsResponse(rsp *http.Response) (*PostListsIdListMoveAllCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostListsIdListMoveAllCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCardsAffectedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest WIPLimitExceeded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteListsIdListWatchResponse parses an HTTP response from a DeleteListsIdListWatchWithResponse call
+func ParseDeleteListsIdListWatchResponse(rsp *http.Response) (*DeleteListsIdListWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteListsIdListWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnwatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostListsIdListWatchResponse parses an HTTP response from a PostListsIdListWatchWithResponse call
+func ParsePostListsIdListWatchResponse(rsp *http.Response) (*PostListsIdListWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostListsIdListWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersResponse parses an HTTP response from a GetMembersWithResponse call
+func ParseGetMembersResponse(rsp *http.Response) (*GetMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MembersSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMembersBoardsIdBoardStarResponse parses an HTTP response from a DeleteMembersBoardsIdBoardStarWithResponse call
+func ParseDeleteMembersBoardsIdBoardStarResponse(rsp *http.Response) (*DeleteMembersBoardsIdBoardStarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMembersBoardsIdBoardStarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnstarBoardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersBoardsIdBoardStarResponse parses an HTTP response from a PostMembersBoardsIdBoardStarWithResponse call
+func ParsePostMembersBoardsIdBoardStarResponse(rsp *http.Response) (*PostMembersBoardsIdBoardStarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersBoardsIdBoardStarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StarBoardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersBoardsNameBoardUniqueJoinResponse parses an HTTP response from a PostMembersBoardsNameBoardUniqueJoinWithResponse call
+func ParsePostMembersBoardsNameBoardUniqueJoinResponse(rsp *http.Response) (*PostMembersBoardsNameBoardUniqueJoinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersBoardsNameBoardUniqueJoinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JoinBoardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyAttempts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMembersMeResponse parses an HTTP response from a DeleteMembersMeWithResponse call
+func ParseDeleteMembersMeResponse(rsp *http.Response) (*DeleteMembersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMembersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMeResponse parses an HTTP response from a GetMembersMeWithResponse call
+func ParseGetMembersMeResponse(rsp *http.Response) (*GetMembersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutMembersMeResponse parses an HTTP response from a PutMembersMeWithResponse call
+func ParsePutMembersMeResponse(rsp *http.Response) (*PutMembersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutMembersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutMembersMeAvatarResponse parses an HTTP response from a PutMembersMeAvatarWithResponse call
+func ParsePutMembersMeAvatarResponse(rsp *http.Response) (*PutMembersMeAvatarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutMembersMeAvatarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMeExportResponse parses an HTTP response from a GetMembersMeExportWithResponse call
+func ParseGetMembersMeExportResponse(rsp *http.Response) (*GetMembersMeExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMeExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DataExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMeExportIdExportResponse parses an HTTP response from a GetMembersMeExportIdExportWithResponse call
+func ParseGetMembersMeExportIdExportResponse(rsp *http.Response) (*GetMembersMeExportIdExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMeExportIdExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMeExportIdExportDownloadResponse parses an HTTP response from a GetMembersMeExportIdExportDownloadWithResponse call
+func ParseGetMembersMeExportIdExportDownloadResponse(rsp *http.Response) (*GetMembersMeExportIdExportDownloadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMeExportIdExportDownloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMeMfaResponse parses an HTTP response from a GetMembersMeMfaWithResponse call
+func ParseGetMembersMeMfaResponse(rsp *http.Response) (*GetMembersMeMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMeMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MFAStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersMeMfaRecoveryCodesResponse parses an HTTP response from a PostMembersMeMfaRecoveryCodesWithResponse call
+func ParsePostMembersMeMfaRecoveryCodesResponse(rsp *http.Response) (*PostMembersMeMfaRecoveryCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersMeMfaRecoveryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersMeMfaTotpResponse parses an HTTP response from a PostMembersMeMfaTotpWithResponse call
+func ParsePostMembersMeMfaTotpResponse(rsp *http.Response) (*PostMembersMeMfaTotpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersMeMfaTotpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TOTPEnrolmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersMeMfaTotpConfirmResponse parses an HTTP response from a PostMembersMeMfaTotpConfirmWithResponse call
+func ParsePostMembersMeMfaTotpConfirmResponse(rsp *http.Response) (*PostMembersMeMfaTotpConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersMeMfaTotpConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersMeMfaTotpDisableResponse parses an HTTP response from a PostMembersMeMfaTotpDisableWithResponse call
+func ParsePostMembersMeMfaTotpDisableResponse(rsp *http.Response) (*PostMembersMeMfaTotpDisableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersMeMfaTotpDisableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMeNotificationsResponse parses an HTTP response from a GetMembersMeNotificationsWithResponse call
+func ParseGetMembersMeNotificationsResponse(rsp *http.Response) (*GetMembersMeNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMeNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationsListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersMeNotificationsReadAllResponse parses an HTTP response from a PostMembersMeNotificationsReadAllWithResponse call
+func ParsePostMembersMeNotificationsReadAllResponse(rsp *http.Response) (*PostMembersMeNotificationsReadAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersMeNotificationsReadAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Marked Number of notifications that were unread
+			Marked *int `json:"marked,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersMeNotificationsIdNotificationReadResponse parses an HTTP response from a PostMembersMeNotificationsIdNotificationReadWithResponse call
+func ParsePostMembersMeNotificationsIdNotificationReadResponse(rsp *http.Response) (*PostMembersMeNotificationsIdNotificationReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersMeNotificationsIdNotificationReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMePreferencesResponse parses an HTTP response from a GetMembersMePreferencesWithResponse call
+func ParseGetMembersMePreferencesResponse(rsp *http.Response) (*GetMembersMePreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMePreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchMembersMePreferencesResponse parses an HTTP response from a PatchMembersMePreferencesWithResponse call
+func ParsePatchMembersMePreferencesResponse(rsp *http.Response) (*PatchMembersMePreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchMembersMePreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersMeTokensResponse parses an HTTP response from a GetMembersMeTokensWithResponse call
+func ParseGetMembersMeTokensResponse(rsp *http.Response) (*GetMembersMeTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersMeTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessTokensListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMembersMeTokensResponse parses an HTTP response from a PostMembersMeTokensWithResponse call
+func ParsePostMembersMeTokensResponse(rsp *http.Response) (*PostMembersMeTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMembersMeTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AccessTokenCreatedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMembersMeTokensIdTokenResponse parses an HTTP response from a DeleteMembersMeTokensIdTokenWithResponse call
+func ParseDeleteMembersMeTokensIdTokenResponse(rsp *http.Response) (*DeleteMembersMeTokensIdTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMembersMeTokensIdTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembersIdMemberResponse parses an HTTP response from a GetMembersIdMemberWithResponse call
+func ParseGetMembersIdMemberResponse(rsp *http.Response) (*GetMembersIdMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembersIdMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemberProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// health check
+	// (GET /alive)
+	GetAlive(w http.ResponseWriter, r *http.Request)
+	// Login user
+	// (POST /auth/login)
+	PostAuthLogin(w http.ResponseWriter, r *http.Request)
+	// Complete two-factor login
+	// (POST /auth/mfa/verify)
+	PostAuthMfaVerify(w http.ResponseWriter, r *http.Request)
+	// Complete single sign-on
+	// (GET /auth/oidc/{provider}/callback)
+	GetAuthOidcProviderCallback(w http.ResponseWriter, r *http.Request, provider string, params GetAuthOidcProviderCallbackParams)
+	// Start single sign-on
+	// (GET /auth/oidc/{provider}/start)
+	GetAuthOidcProviderStart(w http.ResponseWriter, r *http.Request, provider string)
+	// Request a password reset
+	// (POST /auth/password/forgot)
+	PostAuthPasswordForgot(w http.ResponseWriter, r *http.Request)
+	// Reset password
+	// (POST /auth/password/reset)
+	PostAuthPasswordReset(w http.ResponseWriter, r *http.Request)
+	// Refresh access token
+	// (POST /auth/refresh)
+	PostAuthRefresh(w http.ResponseWriter, r *http.Request)
+	// Register a new user
+	// (POST /auth/register)
+	PostAuthRegister(w http.ResponseWriter, r *http.Request)
+	// Verify email
+	// (POST /auth/verify-email)
+	PostAuthVerifyEmail(w http.ResponseWriter, r *http.Request)
+	// Resend verification email
+	// (POST /auth/verify-email/resend)
+	PostAuthVerifyEmailResend(w http.ResponseWriter, r *http.Request)
+	// Get all boards of current member
+	// (GET /boards)
+	GetBoards(w http.ResponseWriter, r *http.Request, params GetBoardsParams)
+	// Create a new board
+	// (POST /boards)
+	PostBoards(w http.ResponseWriter, r *http.Request)
+	// Import a board
+	// (POST /boards/import)
+	PostBoardsImport(w http.ResponseWriter, r *http.Request)
+	// Delete board
+	// (DELETE /boards/{idBoard})
+	DeleteBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Get board with lists
+	// (GET /boards/{idBoard})
+	GetBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Update board
+	// (PUT /boards/{idBoard})
+	PutBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Get board custom fields
+	// (GET /boards/{idBoard}/custom-fields)
+	GetBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Create a custom field
+	// (POST /boards/{idBoard}/custom-fields)
+	PostBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Delete a custom field
+	// (DELETE /boards/{idBoard}/custom-fields/{idField})
+	DeleteBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idField openapi_types.UUID)
+	// Update a custom field
+	// (PUT /boards/{idBoard}/custom-fields/{idField})
+	PutBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idField openapi_types.UUID)
+	// Export board
+	// (GET /boards/{idBoard}/export)
+	GetBoardsIdBoardExport(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, params GetBoardsIdBoardExportParams)
+	// Get board labels
+	// (GET /boards/{idBoard}/labels)
+	GetBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Create a label
+	// (POST /boards/{idBoard}/labels)
+	PostBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Remove member from board (or leave from board if not an owner)
+	// (DELETE /boards/{idBoard}/members/{idMember})
+	DeleteBoardsIdBoardMembersIdMember(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idMember openapi_types.UUID)
+	// Stop watching a board
+	// (DELETE /boards/{idBoard}/watch)
+	DeleteBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Watch a board
+	// (POST /boards/{idBoard}/watch)
+	PostBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Get board webhooks
+	// (GET /boards/{idBoard}/webhooks)
+	GetBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Create a webhook
+	// (POST /boards/{idBoard}/webhooks)
+	PostBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Delete a webhook
+	// (DELETE /boards/{idBoard}/webhooks/{idWebhook})
+	DeleteBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID)
+	// Update a webhook
+	// (PUT /boards/{idBoard}/webhooks/{idWebhook})
+	PutBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID)
+	// Get webhook delivery logs
+	// (GET /boards/{idBoard}/webhooks/{idWebhook}/deliveries)
+	GetBoardsIdBoardWebhooksIdWebhookDeliveries(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams)
+	// Send a test event
+	// (POST /boards/{idBoard}/webhooks/{idWebhook}/test)
+	PostBoardsIdBoardWebhooksIdWebhookTest(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID)
+	// Create a new card
+	// (POST /cards)
+	PostCards(w http.ResponseWriter, r *http.Request, params PostCardsParams)
+	// Apply an operation to multiple cards
+	// (POST /cards/bulk)
+	PostCardsBulk(w http.ResponseWriter, r *http.Request)
+	// Delete card
+	// (DELETE /cards/{idCard})
+	DeleteCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
+	// Get card with full info
+	// (GET /cards/{idCard})
+	GetCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
+	// Update card
+	// (PUT /cards/{idCard})
+	PutCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
+	// Set a custom field value
+	// (PUT /cards/{idCard}/custom-fields/{idField})
+	PutCardsIdCardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID, idField openapi_types.UUID)
+	// Stop watching a card
+	// (DELETE /cards/{idCard}/watch)
+	DeleteCardsIdCardWatch(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
+	// Watch a card
+	// (POST /cards/{idCard}/watch)
+	PostCardsIdCardWatch(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID)
+	// Create a new list
+	// (POST /lists)
+	PostLists(w http.ResponseWriter, r *http.Request, params PostListsParams)
+	// Delete list
+	// (DELETE /lists/{idList})
+	DeleteListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
+	// Get list with cards
+	// (GET /lists/{idList})
+	GetListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
+	// Update list
+	// (PUT /lists/{idList})
+	PutListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
+	// Archive all cards in a list
+	// (POST /lists/{idList}/archive-all-cards)
+	PostListsIdListArchiveAllCards(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
+	// Move all cards to another list
+	// (POST /lists/{idList}/move-all-cards)
+	PostListsIdListMoveAllCards(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
+	// Stop watching a list
+	// (DELETE /lists/{idList}/watch)
+	DeleteListsIdListWatch(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
+	// Watch a list
+	// (POST /lists/{idList}/watch)
+	PostListsIdListWatch(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID)
+	// Search members
+	// (GET /members)
+	GetMembers(w http.ResponseWriter, r *http.Request, params GetMembersParams)
+	// Unstar a board
+	// (DELETE /members/boards/{idBoard}/star)
+	DeleteMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Star a board
+	// (POST /members/boards/{idBoard}/star)
+	PostMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID)
+	// Join a board
+	// (POST /members/boards/{nameBoardUnique}/join)
+	PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r *http.Request, nameBoardUnique string)
+	// Delete current user account
+	// (DELETE /members/me)
+	DeleteMembersMe(w http.ResponseWriter, r *http.Request)
+	// Get current user info
+	// (GET /members/me)
+	GetMembersMe(w http.ResponseWriter, r *http.Request)
+	// Update current user info
+	// (PUT /members/me)
+	PutMembersMe(w http.ResponseWriter, r *http.Request)
+	// Upload avatar
+	// (PUT /members/me/avatar)
+	PutMembersMeAvatar(w http.ResponseWriter, r *http.Request)
+	// Export personal data
+	// (GET /members/me/export)
+	GetMembersMeExport(w http.ResponseWriter, r *http.Request, params GetMembersMeExportParams)
+	// Get personal data export status
+	// (GET /members/me/export/{idExport})
+	GetMembersMeExportIdExport(w http.ResponseWriter, r *http.Request, idExport openapi_types.UUID)
+	// Download personal data export
+	// (GET /members/me/export/{idExport}/download)
+	GetMembersMeExportIdExportDownload(w http.ResponseWriter, r *http.Request, idExport openapi_types.UUID)
+	// Get two-factor authentication status
+	// (GET /members/me/mfa)
+	GetMembersMeMfa(w http.ResponseWriter, r *http.Request)
+	// Regenerate recovery codes
+	// (POST /members/me/mfa/recovery-codes)
+	PostMembersMeMfaRecoveryCodes(w http.ResponseWriter, r *http.Request)
+	// Start TOTP enrolment
+	// (POST /members/me/mfa/totp)
+	PostMembersMeMfaTotp(w http.ResponseWriter, r *http.Request)
+	// Confirm TOTP enrolment
+	// (POST /members/me/mfa/totp/confirm)
+	PostMembersMeMfaTotpConfirm(w http.ResponseWriter, r *http.Request)
+	// Disable TOTP
+	// (POST /members/me/mfa/totp/disable)
+	PostMembersMeMfaTotpDisable(w http.ResponseWriter, r *http.Request)
+	// Get notifications
+	// (GET /members/me/notifications)
+	GetMembersMeNotifications(w http.ResponseWriter, r *http.Request, params GetMembersMeNotificationsParams)
+	// Mark all notifications read
+	// (POST /members/me/notifications/read-all)
+	PostMembersMeNotificationsReadAll(w http.ResponseWriter, r *http.Request)
+	// Mark notification read
+	// (POST /members/me/notifications/{idNotification}/read)
+	PostMembersMeNotificationsIdNotificationRead(w http.ResponseWriter, r *http.Request, idNotification openapi_types.UUID)
+	// Get preferences
+	// (GET /members/me/preferences)
+	GetMembersMePreferences(w http.ResponseWriter, r *http.Request)
+	// Update preferences
+	// (PATCH /members/me/preferences)
+	PatchMembersMePreferences(w http.ResponseWriter, r *http.Request)
+	// Get personal access tokens
+	// (GET /members/me/tokens)
+	GetMembersMeTokens(w http.ResponseWriter, r *http.Request)
+	// Create a personal access token
+	// (POST /members/me/tokens)
+	PostMembersMeTokens(w http.ResponseWriter, r *http.Request)
+	// Revoke a personal access token
+	// (DELETE /members/me/tokens/{idToken})
+	DeleteMembersMeTokensIdToken(w http.ResponseWriter, r *http.Request, idToken openapi_types.UUID)
+	// Get member profile
+	// (GET /members/{idMember})
+	GetMembersIdMember(w http.ResponseWriter, r *http.Request, idMember openapi_types.UUID)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// health check
+// (GET /alive)
+func (_ Unimplemented) GetAlive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Login user
+// (POST /auth/login)
+func (_ Unimplemented) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete two-factor login
+// (POST /auth/mfa/verify)
+func (_ Unimplemented) PostAuthMfaVerify(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete single sign-on
+// (GET /auth/oidc/{provider}/callback)
+func (_ Unimplemented) GetAuthOidcProviderCallback(w http.ResponseWriter, r *http.Request, provider string, params GetAuthOidcProviderCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start single sign-on
+// (GET /auth/oidc/{provider}/start)
+func (_ Unimplemented) GetAuthOidcProviderStart(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request a password reset
+// (POST /auth/password/forgot)
+func (_ Unimplemented) PostAuthPasswordForgot(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reset password
+// (POST /auth/password/reset)
+func (_ Unimplemented) PostAuthPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Refresh access token
+// (POST /auth/refresh)
+func (_ Unimplemented) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a new user
+// (POST /auth/register)
+func (_ Unimplemented) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify email
+// (POST /auth/verify-email)
+func (_ Unimplemented) PostAuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resend verification email
+// (POST /auth/verify-email/resend)
+func (_ Unimplemented) PostAuthVerifyEmailResend(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all boards of current member
+// (GET /boards)
+func (_ Unimplemented) GetBoards(w http.ResponseWriter, r *http.Request, params GetBoardsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new board
+// (POST /boards)
+func (_ Unimplemented) PostBoards(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import a board
+// (POST /boards/import)
+func (_ Unimplemented) PostBoardsImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete board
+// (DELETE /boards/{idBoard})
 func (_ Unimplemented) DeleteBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get board with lists
-// (GET /boards/{idBoard})
-func (_ Unimplemented) GetBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Get board with lists
+// (GET /boards/{idBoard})
+func (_ Unimplemented) GetBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update board
+// (PUT /boards/{idBoard})
+func (_ Unimplemented) PutBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get board custom fields
+// (GET /boards/{idBoard}/custom-fields)
+func (_ Unimplemented) GetBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a custom field
+// (POST /boards/{idBoard}/custom-fields)
+func (_ Unimplemented) PostBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a custom field
+// (DELETE /boards/{idBoard}/custom-fields/{idField})
+func (_ Unimplemented) DeleteBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idField openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a custom field
+// (PUT /boards/{idBoard}/custom-fields/{idField})
+func (_ Unimplemented) PutBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idField openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export board
+// (GET /boards/{idBoard}/export)
+func (_ Unimplemented) GetBoardsIdBoardExport(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, params GetBoardsIdBoardExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get board labels
+// (GET /boards/{idBoard}/labels)
+func (_ Unimplemented) GetBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a label
+// (POST /boards/{idBoard}/labels)
+func (_ Unimplemented) PostBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove member from board (or leave from board if not an owner)
+// (DELETE /boards/{idBoard}/members/{idMember})
+func (_ Unimplemented) DeleteBoardsIdBoardMembersIdMember(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idMember openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop watching a board
+// (DELETE /boards/{idBoard}/watch)
+func (_ Unimplemented) DeleteBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch a board
+// (POST /boards/{idBoard}/watch)
+func (_ Unimplemented) PostBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get board webhooks
+// (GET /boards/{idBoard}/webhooks)
+func (_ Unimplemented) GetBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a webhook
+// (POST /boards/{idBoard}/webhooks)
+func (_ Unimplemented) PostBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook
+// (DELETE /boards/{idBoard}/webhooks/{idWebhook})
+func (_ Unimplemented) DeleteBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a webhook
+// (PUT /boards/{idBoard}/webhooks/{idWebhook})
+func (_ Unimplemented) PutBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get webhook delivery logs
+// (GET /boards/{idBoard}/webhooks/{idWebhook}/deliveries)
+func (_ Unimplemented) GetBoardsIdBoardWebhooksIdWebhookDeliveries(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a test event
+// (POST /boards/{idBoard}/webhooks/{idWebhook}/test)
+func (_ Unimplemented) PostBoardsIdBoardWebhooksIdWebhookTest(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new card
+// (POST /cards)
+func (_ Unimplemented) PostCards(w http.ResponseWriter, r *http.Request, params PostCardsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply an operation to multiple cards
+// (POST /cards/bulk)
+func (_ Unimplemented) PostCardsBulk(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete card
+// (DELETE /cards/{idCard})
+func (_ Unimplemented) DeleteCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get card with full info
+// (GET /cards/{idCard})
+func (_ Unimplemented) GetCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update card
+// (PUT /cards/{idCard})
+func (_ Unimplemented) PutCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set a custom field value
+// (PUT /cards/{idCard}/custom-fields/{idField})
+func (_ Unimplemented) PutCardsIdCardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID, idField openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop watching a card
+// (DELETE /cards/{idCard}/watch)
+func (_ Unimplemented) DeleteCardsIdCardWatch(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch a card
+// (POST /cards/{idCard}/watch)
+func (_ Unimplemented) PostCardsIdCardWatch(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new list
+// (POST /lists)
+func (_ Unimplemented) PostLists(w http.ResponseWriter, r *http.Request, params PostListsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete list
+// (DELETE /lists/{idList})
+func (_ Unimplemented) DeleteListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get list with cards
+// (GET /lists/{idList})
+func (_ Unimplemented) GetListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update list
+// (PUT /lists/{idList})
+func (_ Unimplemented) PutListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Archive all cards in a list
+// (POST /lists/{idList}/archive-all-cards)
+func (_ Unimplemented) PostListsIdListArchiveAllCards(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move all cards to another list
+// (POST /lists/{idList}/move-all-cards)
+func (_ Unimplemented) PostListsIdListMoveAllCards(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop watching a list
+// (DELETE /lists/{idList}/watch)
+func (_ Unimplemented) DeleteListsIdListWatch(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch a list
+// (POST /lists/{idList}/watch)
+func (_ Unimplemented) PostListsIdListWatch(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search members
+// (GET /members)
+func (_ Unimplemented) GetMembers(w http.ResponseWriter, r *http.Request, params GetMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unstar a board
+// (DELETE /members/boards/{idBoard}/star)
+func (_ Unimplemented) DeleteMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Star a board
+// (POST /members/boards/{idBoard}/star)
+func (_ Unimplemented) PostMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Join a board
+// (POST /members/boards/{nameBoardUnique}/join)
+func (_ Unimplemented) PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r *http.Request, nameBoardUnique string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete current user account
+// (DELETE /members/me)
+func (_ Unimplemented) DeleteMembersMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get current user info
+// (GET /members/me)
+func (_ Unimplemented) GetMembersMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update current user info
+// (PUT /members/me)
+func (_ Unimplemented) PutMembersMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload avatar
+// (PUT /members/me/avatar)
+func (_ Unimplemented) PutMembersMeAvatar(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export personal data
+// (GET /members/me/export)
+func (_ Unimplemented) GetMembersMeExport(w http.ResponseWriter, r *http.Request, params GetMembersMeExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get personal data export status
+// (GET /members/me/export/{idExport})
+func (_ Unimplemented) GetMembersMeExportIdExport(w http.ResponseWriter, r *http.Request, idExport openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download personal data export
+// (GET /members/me/export/{idExport}/download)
+func (_ Unimplemented) GetMembersMeExportIdExportDownload(w http.ResponseWriter, r *http.Request, idExport openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get two-factor authentication status
+// (GET /members/me/mfa)
+func (_ Unimplemented) GetMembersMeMfa(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Regenerate recovery codes
+// (POST /members/me/mfa/recovery-codes)
+func (_ Unimplemented) PostMembersMeMfaRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start TOTP enrolment
+// (POST /members/me/mfa/totp)
+func (_ Unimplemented) PostMembersMeMfaTotp(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm TOTP enrolment
+// (POST /members/me/mfa/totp/confirm)
+func (_ Unimplemented) PostMembersMeMfaTotpConfirm(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Disable TOTP
+// (POST /members/me/mfa/totp/disable)
+func (_ Unimplemented) PostMembersMeMfaTotpDisable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get notifications
+// (GET /members/me/notifications)
+func (_ Unimplemented) GetMembersMeNotifications(w http.ResponseWriter, r *http.Request, params GetMembersMeNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark all notifications read
+// (POST /members/me/notifications/read-all)
+func (_ Unimplemented) PostMembersMeNotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark notification read
+// (POST /members/me/notifications/{idNotification}/read)
+func (_ Unimplemented) PostMembersMeNotificationsIdNotificationRead(w http.ResponseWriter, r *http.Request, idNotification openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get preferences
+// (GET /members/me/preferences)
+func (_ Unimplemented) GetMembersMePreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update preferences
+// (PATCH /members/me/preferences)
+func (_ Unimplemented) PatchMembersMePreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get personal access tokens
+// (GET /members/me/tokens)
+func (_ Unimplemented) GetMembersMeTokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a personal access token
+// (POST /members/me/tokens)
+func (_ Unimplemented) PostMembersMeTokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a personal access token
+// (DELETE /members/me/tokens/{idToken})
+func (_ Unimplemented) DeleteMembersMeTokensIdToken(w http.ResponseWriter, r *http.Request, idToken openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get member profile
+// (GET /members/{idMember})
+func (_ Unimplemented) GetMembersIdMember(w http.ResponseWriter, r *http.Request, idMember openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAlive operation middleware
+func (siw *ServerInterfaceWrapper) GetAlive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthMfaVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthMfaVerify(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthMfaVerify(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAuthOidcProviderCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthOidcProviderCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthOidcProviderCallbackParams
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthOidcProviderCallback(w, r, provider, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAuthOidcProviderStart operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthOidcProviderStart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthOidcProviderStart(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthPasswordForgot operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthPasswordForgot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthPasswordForgot(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthPasswordReset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthPasswordReset(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthVerifyEmail(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAuthVerifyEmailResend operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthVerifyEmailResend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthVerifyEmailResend(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBoards operation middleware
+func (siw *ServerInterfaceWrapper) GetBoards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBoardsParams
+
+	// ------------- Optional query parameter "starred" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "starred", r.URL.Query(), &params.Starred)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "starred", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoards(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostBoards operation middleware
+func (siw *ServerInterfaceWrapper) PostBoards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBoards(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostBoardsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostBoardsImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBoardsImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBoardsIdBoard operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBoardsIdBoard(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBoardsIdBoard operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardsIdBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardsIdBoard(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutBoardsIdBoard operation middleware
+func (siw *ServerInterfaceWrapper) PutBoardsIdBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBoardsIdBoard(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBoardsIdBoardCustomFields operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardsIdBoardCustomFields(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostBoardsIdBoardCustomFields operation middleware
+func (siw *ServerInterfaceWrapper) PostBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBoardsIdBoardCustomFields(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBoardsIdBoardCustomFieldsIdField operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "idField" -------------
+	var idField openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idField", chi.URLParam(r, "idField"), &idField, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idField", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBoardsIdBoardCustomFieldsIdField(w, r, idBoard, idField)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutBoardsIdBoardCustomFieldsIdField operation middleware
+func (siw *ServerInterfaceWrapper) PutBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "idField" -------------
+	var idField openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idField", chi.URLParam(r, "idField"), &idField, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idField", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBoardsIdBoardCustomFieldsIdField(w, r, idBoard, idField)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBoardsIdBoardExport operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardsIdBoardExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBoardsIdBoardExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardsIdBoardExport(w, r, idBoard, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBoardsIdBoardLabels operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardsIdBoardLabels(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostBoardsIdBoardLabels operation middleware
+func (siw *ServerInterfaceWrapper) PostBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBoardsIdBoardLabels(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBoardsIdBoardMembersIdMember operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoardMembersIdMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "idMember" -------------
+	var idMember openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idMember", chi.URLParam(r, "idMember"), &idMember, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idMember", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBoardsIdBoardMembersIdMember(w, r, idBoard, idMember)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBoardsIdBoardWatch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBoardsIdBoardWatch(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostBoardsIdBoardWatch operation middleware
+func (siw *ServerInterfaceWrapper) PostBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBoardsIdBoardWatch(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBoardsIdBoardWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardsIdBoardWebhooks(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostBoardsIdBoardWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBoardsIdBoardWebhooks(w, r, idBoard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBoardsIdBoardWebhooksIdWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "idWebhook" -------------
+	var idWebhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idWebhook", chi.URLParam(r, "idWebhook"), &idWebhook, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idWebhook", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBoardsIdBoardWebhooksIdWebhook(w, r, idBoard, idWebhook)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutBoardsIdBoardWebhooksIdWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PutBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "idWebhook" -------------
+	var idWebhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idWebhook", chi.URLParam(r, "idWebhook"), &idWebhook, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idWebhook", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBoardsIdBoardWebhooksIdWebhook(w, r, idBoard, idWebhook)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBoardsIdBoardWebhooksIdWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardsIdBoardWebhooksIdWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "idWebhook" -------------
+	var idWebhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idWebhook", chi.URLParam(r, "idWebhook"), &idWebhook, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idWebhook", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardsIdBoardWebhooksIdWebhookDeliveries(w, r, idBoard, idWebhook, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostBoardsIdBoardWebhooksIdWebhookTest operation middleware
+func (siw *ServerInterfaceWrapper) PostBoardsIdBoardWebhooksIdWebhookTest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "idWebhook" -------------
+	var idWebhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idWebhook", chi.URLParam(r, "idWebhook"), &idWebhook, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idWebhook", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBoardsIdBoardWebhooksIdWebhookTest(w, r, idBoard, idWebhook)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostCards operation middleware
+func (siw *ServerInterfaceWrapper) PostCards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostCardsParams
+
+	// ------------- Required query parameter "idList" -------------
+
+	if paramValue := r.URL.Query().Get("idList"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "idList"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "idList", r.URL.Query(), &params.IdList)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCards(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostCardsBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostCardsBulk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCardsBulk(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCardsIdCard operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCardsIdCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idCard" -------------
+	var idCard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCardsIdCard(w, r, idCard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCardsIdCard operation middleware
+func (siw *ServerInterfaceWrapper) GetCardsIdCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idCard" -------------
+	var idCard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCardsIdCard(w, r, idCard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutCardsIdCard operation middleware
+func (siw *ServerInterfaceWrapper) PutCardsIdCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idCard" -------------
+	var idCard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Update board
-// (PUT /boards/{idBoard})
-func (_ Unimplemented) PutBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCardsIdCard(w, r, idCard)
+	}))
 
-// Remove member from board (or leave from board if not an owner)
-// (DELETE /boards/{idBoard}/members/{idMember})
-func (_ Unimplemented) DeleteBoardsIdBoardMembersIdMember(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idMember openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Create a new card
-// (POST /cards)
-func (_ Unimplemented) PostCards(w http.ResponseWriter, r *http.Request, params PostCardsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Delete card
-// (DELETE /cards/{idCard})
-func (_ Unimplemented) DeleteCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// PutCardsIdCardCustomFieldsIdField operation middleware
+func (siw *ServerInterfaceWrapper) PutCardsIdCardCustomFieldsIdField(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Get card with full info
-// (GET /cards/{idCard})
-func (_ Unimplemented) GetCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Update card
-// (PUT /cards/{idCard})
-func (_ Unimplemented) PutCardsIdCard(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "idCard" -------------
+	var idCard openapi_types.UUID
 
-// Create a new list
-// (POST /lists)
-func (_ Unimplemented) PostLists(w http.ResponseWriter, r *http.Request, params PostListsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
+		return
+	}
 
-// Delete list
-// (DELETE /lists/{idList})
-func (_ Unimplemented) DeleteListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "idField" -------------
+	var idField openapi_types.UUID
 
-// Get list with cards
-// (GET /lists/{idList})
-func (_ Unimplemented) GetListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+	err = runtime.BindStyledParameterWithOptions("simple", "idField", chi.URLParam(r, "idField"), &idField, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idField", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCardsIdCardCustomFieldsIdField(w, r, idCard, idField)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Update list
-// (PUT /lists/{idList})
-func (_ Unimplemented) PutListsIdList(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// DeleteCardsIdCardWatch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCardsIdCardWatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idCard" -------------
+	var idCard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCardsIdCardWatch(w, r, idCard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Unstar a board
-// (DELETE /members/boards/{idBoard}/star)
-func (_ Unimplemented) DeleteMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostCardsIdCardWatch operation middleware
+func (siw *ServerInterfaceWrapper) PostCardsIdCardWatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idCard" -------------
+	var idCard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCardsIdCardWatch(w, r, idCard)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Star a board
-// (POST /members/boards/{idBoard}/star)
-func (_ Unimplemented) PostMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostLists operation middleware
+func (siw *ServerInterfaceWrapper) PostLists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostListsParams
+
+	// ------------- Required query parameter "idBoard" -------------
+
+	if paramValue := r.URL.Query().Get("idBoard"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "idBoard"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "idBoard", r.URL.Query(), &params.IdBoard)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLists(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Join a board
-// (POST /members/boards/{nameBoardUnique}/join)
-func (_ Unimplemented) PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r *http.Request, nameBoardUnique string) {
-	w.WriteHeader(http.StatusNotImplemented)
+// DeleteListsIdList operation middleware
+func (siw *ServerInterfaceWrapper) DeleteListsIdList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idList" -------------
+	var idList openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteListsIdList(w, r, idList)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Get current user info
-// (GET /members/me)
-func (_ Unimplemented) GetMembersMe(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetListsIdList operation middleware
+func (siw *ServerInterfaceWrapper) GetListsIdList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idList" -------------
+	var idList openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetListsIdList(w, r, idList)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Update current user info
-// (PUT /members/me)
-func (_ Unimplemented) PutMembersMe(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PutListsIdList operation middleware
+func (siw *ServerInterfaceWrapper) PutListsIdList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idList" -------------
+	var idList openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutListsIdList(w, r, idList)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// PostListsIdListArchiveAllCards operation middleware
+func (siw *ServerInterfaceWrapper) PostListsIdListArchiveAllCards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	var err error
 
-// GetAlive operation middleware
-func (siw *ServerInterfaceWrapper) GetAlive(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Path parameter "idList" -------------
+	var idList openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAlive(w, r)
+		siw.Handler.PostListsIdListArchiveAllCards(w, r, idList)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4118,12 +14231,25 @@ func (siw *ServerInterfaceWrapper) GetAlive(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostAuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+// PostListsIdListMoveAllCards operation middleware
+func (siw *ServerInterfaceWrapper) PostListsIdListMoveAllCards(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "idList" -------------
+	var idList openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthLogin(w, r)
+		siw.Handler.PostListsIdListMoveAllCards(w, r, idList)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4133,12 +14259,25 @@ func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostAuthRefresh operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+// DeleteListsIdListWatch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteListsIdListWatch(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "idList" -------------
+	var idList openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthRefresh(w, r)
+		siw.Handler.DeleteListsIdListWatch(w, r, idList)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4148,12 +14287,25 @@ func (siw *ServerInterfaceWrapper) PostAuthRefresh(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostAuthRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+// PostListsIdListWatch operation middleware
+func (siw *ServerInterfaceWrapper) PostListsIdListWatch(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "idList" -------------
+	var idList openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthRegister(w, r)
+		siw.Handler.PostListsIdListWatch(w, r, idList)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4163,8 +14315,8 @@ func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetBoards operation middleware
-func (siw *ServerInterfaceWrapper) GetBoards(w http.ResponseWriter, r *http.Request) {
+// GetMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetMembers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -4172,34 +14324,33 @@ func (siw *ServerInterfaceWrapper) GetBoards(w http.ResponseWriter, r *http.Requ
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBoardsParams
+	var params GetMembersParams
 
-	// ------------- Optional query parameter "starred" -------------
+	// ------------- Required query parameter "query" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "starred", r.URL.Query(), &params.Starred)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "starred", Err: err})
+	if paramValue := r.URL.Query().Get("query"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "query", r.URL.Query(), &params.Query)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBoards(w, r, params)
+		siw.Handler.GetMembers(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4209,14 +14360,25 @@ func (siw *ServerInterfaceWrapper) GetBoards(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostBoards operation middleware
-func (siw *ServerInterfaceWrapper) PostBoards(w http.ResponseWriter, r *http.Request) {
+// DeleteMembersBoardsIdBoardStar operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "idBoard" -------------
+	var idBoard openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostBoards(w, r)
+		siw.Handler.DeleteMembersBoardsIdBoardStar(w, r, idBoard)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4226,8 +14388,8 @@ func (siw *ServerInterfaceWrapper) PostBoards(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteBoardsIdBoard operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoard(w http.ResponseWriter, r *http.Request) {
+// PostMembersBoardsIdBoardStar operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -4244,7 +14406,7 @@ func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoard(w http.ResponseWriter, r
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBoardsIdBoard(w, r, idBoard)
+		siw.Handler.PostMembersBoardsIdBoardStar(w, r, idBoard)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4254,25 +14416,25 @@ func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoard(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetBoardsIdBoard operation middleware
-func (siw *ServerInterfaceWrapper) GetBoardsIdBoard(w http.ResponseWriter, r *http.Request) {
+// PostMembersBoardsNameBoardUniqueJoin operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "idBoard" -------------
-	var idBoard openapi_types.UUID
+	// ------------- Path parameter "nameBoardUnique" -------------
+	var nameBoardUnique string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "nameBoardUnique", chi.URLParam(r, "nameBoardUnique"), &nameBoardUnique, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nameBoardUnique", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBoardsIdBoard(w, r, idBoard)
+		siw.Handler.PostMembersBoardsNameBoardUniqueJoin(w, r, nameBoardUnique)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4282,25 +14444,31 @@ func (siw *ServerInterfaceWrapper) GetBoardsIdBoard(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutBoardsIdBoard operation middleware
-func (siw *ServerInterfaceWrapper) PutBoardsIdBoard(w http.ResponseWriter, r *http.Request) {
+// DeleteMembersMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMembersMe(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Path parameter "idBoard" -------------
-	var idBoard openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMembersMe(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMembersMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutBoardsIdBoard(w, r, idBoard)
+		siw.Handler.GetMembersMe(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4310,34 +14478,31 @@ func (siw *ServerInterfaceWrapper) PutBoardsIdBoard(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteBoardsIdBoardMembersIdMember operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoardMembersIdMember(w http.ResponseWriter, r *http.Request) {
+// PutMembersMe operation middleware
+func (siw *ServerInterfaceWrapper) PutMembersMe(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Path parameter "idBoard" -------------
-	var idBoard openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutMembersMe(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "idMember" -------------
-	var idMember openapi_types.UUID
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "idMember", chi.URLParam(r, "idMember"), &idMember, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idMember", Err: err})
-		return
-	}
+// PutMembersMeAvatar operation middleware
+func (siw *ServerInterfaceWrapper) PutMembersMeAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBoardsIdBoardMembersIdMember(w, r, idBoard, idMember)
+		siw.Handler.PutMembersMeAvatar(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4347,8 +14512,8 @@ func (siw *ServerInterfaceWrapper) DeleteBoardsIdBoardMembersIdMember(w http.Res
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostCards operation middleware
-func (siw *ServerInterfaceWrapper) PostCards(w http.ResponseWriter, r *http.Request) {
+// GetMembersMeExport operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMeExport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -4356,25 +14521,46 @@ func (siw *ServerInterfaceWrapper) PostCards(w http.ResponseWriter, r *http.Requ
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostCardsParams
-
-	// ------------- Required query parameter "idList" -------------
+	var params GetMembersMeExportParams
 
-	if paramValue := r.URL.Query().Get("idList"); paramValue != "" {
+	// ------------- Optional query parameter "format" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "idList"})
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "idList", r.URL.Query(), &params.IdList)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMembersMeExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMembersMeExportIdExport operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMeExportIdExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "idExport" -------------
+	var idExport openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "idExport", chi.URLParam(r, "idExport"), &idExport, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idExport", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostCards(w, r, params)
+		siw.Handler.GetMembersMeExportIdExport(w, r, idExport)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4384,25 +14570,76 @@ func (siw *ServerInterfaceWrapper) PostCards(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteCardsIdCard operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCardsIdCard(w http.ResponseWriter, r *http.Request) {
+// GetMembersMeExportIdExportDownload operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMeExportIdExportDownload(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "idCard" -------------
-	var idCard openapi_types.UUID
+	// ------------- Path parameter "idExport" -------------
+	var idExport openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "idExport", chi.URLParam(r, "idExport"), &idExport, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idExport", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCardsIdCard(w, r, idCard)
+		siw.Handler.GetMembersMeExportIdExportDownload(w, r, idExport)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMembersMeMfa operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMeMfa(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMembersMeMfa(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostMembersMeMfaRecoveryCodes operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersMeMfaRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMembersMeMfaRecoveryCodes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostMembersMeMfaTotp operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersMeMfaTotp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMembersMeMfaTotp(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4412,25 +14649,14 @@ func (siw *ServerInterfaceWrapper) DeleteCardsIdCard(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCardsIdCard operation middleware
-func (siw *ServerInterfaceWrapper) GetCardsIdCard(w http.ResponseWriter, r *http.Request) {
+// PostMembersMeMfaTotpConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersMeMfaTotpConfirm(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "idCard" -------------
-	var idCard openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCardsIdCard(w, r, idCard)
+		siw.Handler.PostMembersMeMfaTotpConfirm(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4440,25 +14666,14 @@ func (siw *ServerInterfaceWrapper) GetCardsIdCard(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutCardsIdCard operation middleware
-func (siw *ServerInterfaceWrapper) PutCardsIdCard(w http.ResponseWriter, r *http.Request) {
+// PostMembersMeMfaTotpDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersMeMfaTotpDisable(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "idCard" -------------
-	var idCard openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "idCard", chi.URLParam(r, "idCard"), &idCard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idCard", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutCardsIdCard(w, r, idCard)
+		siw.Handler.PostMembersMeMfaTotpDisable(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4468,8 +14683,8 @@ func (siw *ServerInterfaceWrapper) PutCardsIdCard(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostLists operation middleware
-func (siw *ServerInterfaceWrapper) PostLists(w http.ResponseWriter, r *http.Request) {
+// GetMembersMeNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMeNotifications(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -4477,25 +14692,34 @@ func (siw *ServerInterfaceWrapper) PostLists(w http.ResponseWriter, r *http.Requ
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostListsParams
+	var params GetMembersMeNotificationsParams
 
-	// ------------- Required query parameter "idBoard" -------------
+	// ------------- Optional query parameter "unread" -------------
 
-	if paramValue := r.URL.Query().Get("idBoard"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "unread", r.URL.Query(), &params.Unread)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unread", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "idBoard"})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "idBoard", r.URL.Query(), &params.IdBoard)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLists(w, r, params)
+		siw.Handler.GetMembersMeNotifications(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4505,25 +14729,14 @@ func (siw *ServerInterfaceWrapper) PostLists(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteListsIdList operation middleware
-func (siw *ServerInterfaceWrapper) DeleteListsIdList(w http.ResponseWriter, r *http.Request) {
+// PostMembersMeNotificationsReadAll operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersMeNotificationsReadAll(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "idList" -------------
-	var idList openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteListsIdList(w, r, idList)
+		siw.Handler.PostMembersMeNotificationsReadAll(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4533,25 +14746,25 @@ func (siw *ServerInterfaceWrapper) DeleteListsIdList(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetListsIdList operation middleware
-func (siw *ServerInterfaceWrapper) GetListsIdList(w http.ResponseWriter, r *http.Request) {
+// PostMembersMeNotificationsIdNotificationRead operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersMeNotificationsIdNotificationRead(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "idList" -------------
-	var idList openapi_types.UUID
+	// ------------- Path parameter "idNotification" -------------
+	var idNotification openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "idNotification", chi.URLParam(r, "idNotification"), &idNotification, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idNotification", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetListsIdList(w, r, idList)
+		siw.Handler.PostMembersMeNotificationsIdNotificationRead(w, r, idNotification)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4561,25 +14774,14 @@ func (siw *ServerInterfaceWrapper) GetListsIdList(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutListsIdList operation middleware
-func (siw *ServerInterfaceWrapper) PutListsIdList(w http.ResponseWriter, r *http.Request) {
+// GetMembersMePreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMePreferences(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "idList" -------------
-	var idList openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "idList", chi.URLParam(r, "idList"), &idList, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idList", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutListsIdList(w, r, idList)
+		siw.Handler.GetMembersMePreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4589,25 +14791,14 @@ func (siw *ServerInterfaceWrapper) PutListsIdList(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteMembersBoardsIdBoardStar operation middleware
-func (siw *ServerInterfaceWrapper) DeleteMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request) {
+// PatchMembersMePreferences operation middleware
+func (siw *ServerInterfaceWrapper) PatchMembersMePreferences(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "idBoard" -------------
-	var idBoard openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteMembersBoardsIdBoardStar(w, r, idBoard)
+		siw.Handler.PatchMembersMePreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4617,25 +14808,31 @@ func (siw *ServerInterfaceWrapper) DeleteMembersBoardsIdBoardStar(w http.Respons
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostMembersBoardsIdBoardStar operation middleware
-func (siw *ServerInterfaceWrapper) PostMembersBoardsIdBoardStar(w http.ResponseWriter, r *http.Request) {
+// GetMembersMeTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersMeTokens(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Path parameter "idBoard" -------------
-	var idBoard openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMembersMeTokens(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "idBoard", chi.URLParam(r, "idBoard"), &idBoard, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idBoard", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostMembersMeTokens operation middleware
+func (siw *ServerInterfaceWrapper) PostMembersMeTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostMembersBoardsIdBoardStar(w, r, idBoard)
+		siw.Handler.PostMembersMeTokens(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4645,25 +14842,25 @@ func (siw *ServerInterfaceWrapper) PostMembersBoardsIdBoardStar(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostMembersBoardsNameBoardUniqueJoin operation middleware
-func (siw *ServerInterfaceWrapper) PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r *http.Request) {
+// DeleteMembersMeTokensIdToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMembersMeTokensIdToken(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "nameBoardUnique" -------------
-	var nameBoardUnique string
+	// ------------- Path parameter "idToken" -------------
+	var idToken openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nameBoardUnique", chi.URLParam(r, "nameBoardUnique"), &nameBoardUnique, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "idToken", chi.URLParam(r, "idToken"), &idToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nameBoardUnique", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idToken", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostMembersBoardsNameBoardUniqueJoin(w, r, nameBoardUnique)
+		siw.Handler.DeleteMembersMeTokensIdToken(w, r, idToken)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4673,31 +14870,25 @@ func (siw *ServerInterfaceWrapper) PostMembersBoardsNameBoardUniqueJoin(w http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetMembersMe operation middleware
-func (siw *ServerInterfaceWrapper) GetMembersMe(w http.ResponseWriter, r *http.Request) {
+// GetMembersIdMember operation middleware
+func (siw *ServerInterfaceWrapper) GetMembersIdMember(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMembersMe(w, r)
-	}))
+	// ------------- Path parameter "idMember" -------------
+	var idMember openapi_types.UUID
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "idMember", chi.URLParam(r, "idMember"), &idMember, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idMember", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// PutMembersMe operation middleware
-func (siw *ServerInterfaceWrapper) PutMembersMe(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutMembersMe(w, r)
+		siw.Handler.GetMembersIdMember(w, r, idMember)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4826,18 +15017,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/verify", wrapper.PostAuthMfaVerify)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oidc/{provider}/callback", wrapper.GetAuthOidcProviderCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oidc/{provider}/start", wrapper.GetAuthOidcProviderStart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password/forgot", wrapper.PostAuthPasswordForgot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password/reset", wrapper.PostAuthPasswordReset)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/refresh", wrapper.PostAuthRefresh)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/register", wrapper.PostAuthRegister)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/verify-email", wrapper.PostAuthVerifyEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/verify-email/resend", wrapper.PostAuthVerifyEmailResend)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/boards", wrapper.GetBoards)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/boards", wrapper.PostBoards)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/boards/import", wrapper.PostBoardsImport)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/boards/{idBoard}", wrapper.DeleteBoardsIdBoard)
 	})
@@ -4847,12 +15062,60 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/boards/{idBoard}", wrapper.PutBoardsIdBoard)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/boards/{idBoard}/custom-fields", wrapper.GetBoardsIdBoardCustomFields)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/boards/{idBoard}/custom-fields", wrapper.PostBoardsIdBoardCustomFields)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/boards/{idBoard}/custom-fields/{idField}", wrapper.DeleteBoardsIdBoardCustomFieldsIdField)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/boards/{idBoard}/custom-fields/{idField}", wrapper.PutBoardsIdBoardCustomFieldsIdField)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/boards/{idBoard}/export", wrapper.GetBoardsIdBoardExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/boards/{idBoard}/labels", wrapper.GetBoardsIdBoardLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/boards/{idBoard}/labels", wrapper.PostBoardsIdBoardLabels)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/boards/{idBoard}/members/{idMember}", wrapper.DeleteBoardsIdBoardMembersIdMember)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/boards/{idBoard}/watch", wrapper.DeleteBoardsIdBoardWatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/boards/{idBoard}/watch", wrapper.PostBoardsIdBoardWatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/boards/{idBoard}/webhooks", wrapper.GetBoardsIdBoardWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/boards/{idBoard}/webhooks", wrapper.PostBoardsIdBoardWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/boards/{idBoard}/webhooks/{idWebhook}", wrapper.DeleteBoardsIdBoardWebhooksIdWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/boards/{idBoard}/webhooks/{idWebhook}", wrapper.PutBoardsIdBoardWebhooksIdWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/boards/{idBoard}/webhooks/{idWebhook}/deliveries", wrapper.GetBoardsIdBoardWebhooksIdWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/boards/{idBoard}/webhooks/{idWebhook}/test", wrapper.PostBoardsIdBoardWebhooksIdWebhookTest)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/cards", wrapper.PostCards)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/cards/bulk", wrapper.PostCardsBulk)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/cards/{idCard}", wrapper.DeleteCardsIdCard)
 	})
@@ -4862,6 +15125,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/cards/{idCard}", wrapper.PutCardsIdCard)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/cards/{idCard}/custom-fields/{idField}", wrapper.PutCardsIdCardCustomFieldsIdField)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/cards/{idCard}/watch", wrapper.DeleteCardsIdCardWatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/cards/{idCard}/watch", wrapper.PostCardsIdCardWatch)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/lists", wrapper.PostLists)
 	})
@@ -4874,6 +15146,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/lists/{idList}", wrapper.PutListsIdList)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lists/{idList}/archive-all-cards", wrapper.PostListsIdListArchiveAllCards)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lists/{idList}/move-all-cards", wrapper.PostListsIdListMoveAllCards)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/lists/{idList}/watch", wrapper.DeleteListsIdListWatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lists/{idList}/watch", wrapper.PostListsIdListWatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members", wrapper.GetMembers)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/members/boards/{idBoard}/star", wrapper.DeleteMembersBoardsIdBoardStar)
 	})
@@ -4883,12 +15170,69 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/members/boards/{nameBoardUnique}/join", wrapper.PostMembersBoardsNameBoardUniqueJoin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/members/me", wrapper.DeleteMembersMe)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/members/me", wrapper.GetMembersMe)
 	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/members/me", wrapper.PutMembersMe)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/members/me/avatar", wrapper.PutMembersMeAvatar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/me/export", wrapper.GetMembersMeExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/me/export/{idExport}", wrapper.GetMembersMeExportIdExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/me/export/{idExport}/download", wrapper.GetMembersMeExportIdExportDownload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/me/mfa", wrapper.GetMembersMeMfa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/members/me/mfa/recovery-codes", wrapper.PostMembersMeMfaRecoveryCodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/members/me/mfa/totp", wrapper.PostMembersMeMfaTotp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/members/me/mfa/totp/confirm", wrapper.PostMembersMeMfaTotpConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/members/me/mfa/totp/disable", wrapper.PostMembersMeMfaTotpDisable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/me/notifications", wrapper.GetMembersMeNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/members/me/notifications/read-all", wrapper.PostMembersMeNotificationsReadAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/members/me/notifications/{idNotification}/read", wrapper.PostMembersMeNotificationsIdNotificationRead)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/me/preferences", wrapper.GetMembersMePreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/members/me/preferences", wrapper.PatchMembersMePreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/me/tokens", wrapper.GetMembersMeTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/members/me/tokens", wrapper.PostMembersMeTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/members/me/tokens/{idToken}", wrapper.DeleteMembersMeTokensIdToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/members/{idMember}", wrapper.GetMembersIdMember)
+	})
 
 	return r
 }
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	"github.com/tasks-control/core-back-end/internal/config"
 	"github.com/tasks-control/core-back-end/internal/handler"
@@ -31,7 +33,18 @@ func main() {
 		log.Fatal(err)
 	}
 
+	// Deliver queued webhook events in the background
+	go svc.RunWebhookDispatcher(context.Background())
+	// Generate queued personal data exports in the background
+	go svc.RunDataExportWorker(context.Background())
+	// Delete notifications past their retention
+	go svc.RunNotificationCleanup(context.Background())
+	// Delete failed login and join attempts that no longer lock anything
+	go svc.RunAuthFailureCleanup(context.Background())
+	// Email digests of unread notifications
+	go svc.RunEmailDigests(context.Background())
+
 	h := handler.NewHandler(svc)
 
-	server.NewServer(h, svc).Run(cfg.ServerPort)
+	server.NewServer(h, svc, cfg.RateLimit, cfg.CORS).Run(cfg.ServerPort)
 }
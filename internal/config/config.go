@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/tasks-control/core-back-end/internal/mailer"
+	"github.com/tasks-control/core-back-end/internal/oidc"
 	"github.com/tasks-control/core-back-end/internal/repository"
+	"github.com/tasks-control/core-back-end/internal/storage"
 
 	"github.com/go-playground/validator/v10"
 
@@ -14,17 +17,112 @@ import (
 )
 
 type Config struct {
-	ServerPort string            `validate:"required" yaml:"serverPort"`
-	Database   repository.Config `validate:"required" yaml:"database"`
-	JWT        JWTConfig         `validate:"required" yaml:"jwt"`
+	ServerPort    string              `validate:"required" yaml:"serverPort"`
+	CORS          CORSConfig          `yaml:"cors"`
+	Database      repository.Config   `validate:"required" yaml:"database"`
+	JWT           JWTConfig           `validate:"required" yaml:"jwt"`
+	Webhooks      WebhooksConfig      `validate:"required" yaml:"webhooks"`
+	Auth          AuthConfig          `validate:"required" yaml:"auth"`
+	Mail          mailer.Config       `validate:"required" yaml:"mail"`
+	RateLimit     RateLimitConfig     `yaml:"rateLimit"`
+	OIDC          oidc.Config         `yaml:"oidc"`
+	DataExport    DataExportConfig    `validate:"required" yaml:"dataExport"`
+	Storage       storage.Config      `validate:"required" yaml:"storage"`
+	Notifications NotificationsConfig `validate:"required" yaml:"notifications"`
+}
+
+// CORSConfig lists the frontend origins trusted with credentialed requests.
+// Other origins can still call the API with bearer tokens, but never with cookies.
+type CORSConfig struct {
+	CredentialedOrigins []string `validate:"dive,url" yaml:"credentialedOrigins"` // e.g. http://localhost:3000, sends the OIDC state cookie
 }
 
 type JWTConfig struct {
-	SecretEnv            string `validate:"required" yaml:"secretEnv"`
+	SecretEnv            string `validate:"required_without=KeySetFile" yaml:"secretEnv"`  // HS256 secret, verification only when KeySetFile is set
+	KeySetFile           string `yaml:"keySetFile"`                                        // key set manifest, switches signing to RS256/EdDSA
 	AccessTokenDuration  int    `validate:"required,min=60" yaml:"accessTokenDuration"`    // seconds
 	RefreshTokenDuration int    `validate:"required,min=3600" yaml:"refreshTokenDuration"` // seconds
 }
 
+// RequireVerifiedEmail modes
+const (
+	RequireVerifiedEmailNone  = "none"
+	RequireVerifiedEmailLogin = "login"
+	RequireVerifiedEmailJoin  = "join"
+)
+
+type AuthConfig struct {
+	PasswordResetTTL          int           `validate:"required,min=60" yaml:"passwordResetTTL"`                     // seconds
+	PasswordResetURL          string        `validate:"required" yaml:"passwordResetURL"`                            // link sent by email, "{token}" is replaced
+	EmailVerificationTTL      int           `validate:"required,min=60" yaml:"emailVerificationTTL"`                 // seconds
+	EmailVerificationURL      string        `validate:"required" yaml:"emailVerificationURL"`                        // link sent by email, "{token}" is replaced
+	EmailVerificationCooldown int           `validate:"required,min=1" yaml:"emailVerificationCooldown"`             // seconds between resends
+	RequireVerifiedEmail      string        `validate:"omitempty,oneof=none login join" yaml:"requireVerifiedEmail"` // what unverified members can't do
+	MFAIssuer                 string        `validate:"required" yaml:"mfaIssuer"`                                   // issuer shown in authenticator apps
+	MFAChallengeTTL           int           `validate:"required,min=30" yaml:"mfaChallengeTTL"`                      // seconds to enter the second factor after the password
+	Lockout                   LockoutConfig `validate:"required" yaml:"lockout"`
+}
+
+// LockoutConfig limits password guessing on login and board join and code guessing on two-factor login
+type LockoutConfig struct {
+	AccountMaxFailures int `validate:"required,min=1" yaml:"accountMaxFailures"` // failures before an account is locked
+	IPMaxFailures      int `validate:"required,min=1" yaml:"ipMaxFailures"`      // failures before an IP address is locked
+	BaseDuration       int `validate:"required,min=1" yaml:"baseDuration"`       // seconds, doubled for every further failure
+	MaxDuration        int `validate:"required,min=1" yaml:"maxDuration"`        // seconds
+	ResetAfter         int `validate:"required,min=1" yaml:"resetAfter"`         // seconds without failures before the count starts over
+}
+
+// Rate limiter stores
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+type RateLimitConfig struct {
+	Enabled bool             `yaml:"enabled"`
+	Store   string           `validate:"omitempty,oneof=memory postgres" yaml:"store"` // memory by default, postgres shares limits between instances
+	Groups  []RateLimitGroup `validate:"dive" yaml:"groups"`
+	// Limited by IP address before authentication, so requests with invalid tokens can't flood the API
+	IPGroups []RateLimitGroup `validate:"dive" yaml:"ipGroups"`
+}
+
+// RateLimitGroup is a token bucket limit for the API routes under a path prefix
+type RateLimitGroup struct {
+	Name       string `validate:"required" yaml:"name"`
+	PathPrefix string `validate:"required,startswith=/" yaml:"pathPrefix"` // matched against the API route, the longest prefix wins
+	Requests   int    `validate:"required,min=1" yaml:"requests"`          // bucket capacity, the allowed burst
+	Period     int    `validate:"required,min=1" yaml:"period"`            // seconds to refill the whole bucket
+}
+
+type WebhooksConfig struct {
+	MaxAttempts         int  `validate:"required,min=1" yaml:"maxAttempts"`
+	InitialBackoff      int  `validate:"required,min=1" yaml:"initialBackoff"` // seconds, doubled after every failed attempt
+	MaxBackoff          int  `validate:"required,min=1" yaml:"maxBackoff"`     // seconds
+	RequestTimeout      int  `validate:"required,min=1" yaml:"requestTimeout"` // seconds
+	PollInterval        int  `validate:"required,min=1" yaml:"pollInterval"`   // seconds
+	BatchSize           int  `validate:"required,min=1" yaml:"batchSize"`
+	AllowPrivateTargets bool `yaml:"allowPrivateTargets"` // allow delivering to loopback and private network addresses
+}
+
+// NotificationsConfig controls how long in-app notifications are kept and how they are emailed
+type NotificationsConfig struct {
+	ReadRetention   int `validate:"required,min=3600" yaml:"readRetention"`   // seconds read notifications are kept
+	UnreadRetention int `validate:"required,min=3600" yaml:"unreadRetention"` // seconds unread notifications are kept
+	CleanupInterval int `validate:"required,min=1" yaml:"cleanupInterval"`    // seconds
+	DigestInterval  int `validate:"required,min=1" yaml:"digestInterval"`     // seconds between checks for due email digests
+	DigestHour      int `validate:"min=0,max=23" yaml:"digestHour"`           // hour of the day in the member's time zone digests are sent
+	DigestMaxItems  int `validate:"required,min=1" yaml:"digestMaxItems"`     // notifications listed in one digest, the rest are counted
+}
+
+// DataExportConfig controls personal data exports of members
+type DataExportConfig struct {
+	Dir           string `validate:"required" yaml:"dir"`                // where background exports are written
+	SyncCardLimit int    `validate:"min=0" yaml:"syncCardLimit"`         // members with more created cards get a background export
+	TTL           int    `validate:"required,min=60" yaml:"ttl"`         // seconds a background export can be downloaded
+	PollInterval  int    `validate:"required,min=1" yaml:"pollInterval"` // seconds
+	StaleAfter    int    `validate:"required,min=60" yaml:"staleAfter"`  // seconds before an unfinished export is picked up again
+}
+
 func GetConfig() (cfg *Config) {
 	log := utils.Logger()
 	configPath := flag.String("c", "./cmd/core-back/config.yaml", "path to config")
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetMembersMeTokens retrieves personal access tokens of the current user
+func (h *Handler) GetMembersMeTokens(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Get tokens
+	tokens, err := h.Service.GetPersonalAccessTokens(r.Context(), userID)
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to get personal access tokens")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	apiTokens := make([]v1.PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		apiTokens = append(apiTokens, accessTokenToAPIResponse(token))
+	}
+
+	response := struct {
+		Tokens []v1.PersonalAccessToken `json:"tokens"`
+	}{
+		Tokens: apiTokens,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostMembersMeTokens creates a personal access token for the current user
+func (h *Handler) PostMembersMeTokens(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.CreateAccessTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Name is required")
+		return
+	}
+	if len(req.Name) > 100 {
+		utils.RespondError(w, http.StatusBadRequest, "Name must be at most 100 characters")
+		return
+	}
+
+	// Create token
+	token, plaintext, err := h.Service.CreatePersonalAccessToken(r.Context(), service.CreateAccessTokenRequest{
+		MemberID:  userID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTokenScope) {
+			utils.RespondError(w, http.StatusBadRequest, "Scopes must be one or more of boards:read, boards:write, members:read, members:write")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidTokenExpiry) {
+			utils.RespondError(w, http.StatusBadRequest, "Expiry must be in the future")
+			return
+		}
+		if errors.Is(err, service.ErrTooManyAccessTokens) {
+			utils.RespondError(w, http.StatusBadRequest, "Personal access token limit reached")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to create personal access token")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// The token value is only returned once
+	response := struct {
+		AccessToken v1.PersonalAccessToken `json:"accessToken"`
+		Token       string                 `json:"token"`
+	}{
+		AccessToken: accessTokenToAPIResponse(token),
+		Token:       plaintext,
+	}
+
+	utils.RespondJSON(w, http.StatusCreated, response)
+}
+
+// DeleteMembersMeTokensIdToken revokes a personal access token of the current user
+func (h *Handler) DeleteMembersMeTokensIdToken(w http.ResponseWriter, r *http.Request, idToken openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Delete token
+	err := h.Service.DeletePersonalAccessToken(r.Context(), idToken, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrAccessTokenNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "Token not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to delete personal access token")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to convert internal PersonalAccessToken model to API response
+func accessTokenToAPIResponse(token *models.PersonalAccessToken) v1.PersonalAccessToken {
+	id := openapi_types.UUID(token.ID)
+	scopes := []string(token.Scopes)
+
+	return v1.PersonalAccessToken{
+		Id:          &id,
+		Name:        &token.Name,
+		TokenPrefix: &token.TokenPrefix,
+		Scopes:      &scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   &token.CreatedAt,
+	}
+}
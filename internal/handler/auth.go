@@ -58,7 +58,7 @@ func (h *Handler) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Convert to response format
-	response := memberToAPIResponse(member)
+	response := memberToAPIResponse(member, h.Service.AvatarURL(member))
 	utils.RespondJSON(w, http.StatusCreated, response)
 }
 
@@ -82,28 +82,48 @@ func (h *Handler) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
 
 	// Login user
 	authResp, err := h.Service.Login(r.Context(), service.LoginRequest{
-		Email:    string(req.Email),
-		Password: req.Password,
+		Email:     string(req.Email),
+		Password:  req.Password,
+		IPAddress: utils.ClientIP(r),
 	})
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidCredentials) {
 			utils.RespondError(w, http.StatusUnauthorized, "Invalid credentials")
 			return
 		}
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			utils.RespondError(w, http.StatusForbidden, "Email address is not verified")
+			return
+		}
+		if respondLockedOut(w, err) {
+			return
+		}
 		utils.Logger().WithError(err).Error("Failed to login user")
 		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
-	// Convert to response format
-	response := v1.LoginResponse{
+	utils.RespondJSON(w, http.StatusOK, h.loginResponse(authResp))
+}
+
+// loginResponse converts tokens, or a second factor challenge, to the login response format
+func (h *Handler) loginResponse(authResp *service.AuthResponse) v1.LoginResponse {
+	// Second factor required, the client exchanges the challenge at /auth/mfa/verify
+	if authResp.MFAToken != "" {
+		mfaRequired := true
+		return v1.LoginResponse{
+			MfaRequired:       &mfaRequired,
+			MfaToken:          &authResp.MFAToken,
+			MfaTokenExpiresIn: &authResp.MFAExpiresIn,
+		}
+	}
+
+	return v1.LoginResponse{
 		AccessToken:  &authResp.AccessToken,
 		RefreshToken: &authResp.RefreshToken,
 		ExpiresIn:    &authResp.ExpiresIn,
-		User:         memberToAPIResponsePtr(authResp.User),
+		User:         memberToAPIResponsePtr(authResp.User, h.Service.AvatarURL(authResp.User)),
 	}
-
-	utils.RespondJSON(w, http.StatusOK, response)
 }
 
 // PostAuthRefresh handles access token refresh
@@ -142,22 +162,149 @@ func (h *Handler) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to convert internal Member model to API response
-func memberToAPIResponse(member *models.Member) v1.Member {
+func memberToAPIResponse(member *models.Member, avatarURL *string) v1.Member {
 	email := openapi_types.Email(member.Email)
 	id := openapi_types.UUID(member.ID)
 
 	return v1.Member{
-		Id:        &id,
-		Email:     &email,
-		Username:  &member.Username,
-		FullName:  member.FullName,
-		CreatedAt: &member.CreatedAt,
-		UpdatedAt: &member.UpdatedAt,
+		Id:              &id,
+		Email:           &email,
+		Username:        &member.Username,
+		FullName:        member.FullName,
+		EmailVerifiedAt: member.EmailVerifiedAt,
+		AvatarUrl:       avatarURL,
+		CreatedAt:       &member.CreatedAt,
+		UpdatedAt:       &member.UpdatedAt,
 	}
 }
 
 // Helper function to convert internal Member model to API response pointer
-func memberToAPIResponsePtr(member *models.Member) *v1.Member {
-	resp := memberToAPIResponse(member)
+func memberToAPIResponsePtr(member *models.Member, avatarURL *string) *v1.Member {
+	resp := memberToAPIResponse(member, avatarURL)
 	return &resp
 }
+
+// PostAuthPasswordForgot handles password reset requests
+func (h *Handler) PostAuthPasswordForgot(w http.ResponseWriter, r *http.Request) {
+	var req v1.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Email == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Email is required")
+		return
+	}
+
+	// Request reset
+	err := h.Service.RequestPasswordReset(r.Context(), string(req.Email))
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to request password reset")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	message := "If an account uses this email, a password reset link has been sent"
+	utils.RespondJSON(w, http.StatusAccepted, v1.MessageResponse{Message: &message})
+}
+
+// PostAuthPasswordReset handles setting a new password with a reset token
+func (h *Handler) PostAuthPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req v1.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Token == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Token is required")
+		return
+	}
+	if len(req.Password) < 8 {
+		utils.RespondError(w, http.StatusBadRequest, "Password must be at least 8 characters long")
+		return
+	}
+
+	// Reset password
+	err := h.Service.ResetPassword(r.Context(), service.ResetPasswordRequest{
+		Token:     req.Token,
+		Password:  req.Password,
+		IPAddress: utils.ClientIP(r),
+		UserAgent: r.UserAgent(),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidResetToken) {
+			utils.RespondError(w, http.StatusBadRequest, "Invalid or expired reset token")
+			return
+		}
+		if errors.Is(err, service.ErrPasswordTooShort) {
+			utils.RespondError(w, http.StatusBadRequest, "Password must be at least 8 characters long")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to reset password")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	message := "Password has been reset, please log in again"
+	utils.RespondJSON(w, http.StatusOK, v1.MessageResponse{Message: &message})
+}
+
+// PostAuthVerifyEmail handles email verification with a token from the verification link
+func (h *Handler) PostAuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req v1.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Token == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Token is required")
+		return
+	}
+
+	// Verify email
+	err := h.Service.VerifyEmail(r.Context(), req.Token)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidVerificationToken) {
+			utils.RespondError(w, http.StatusBadRequest, "Invalid or expired verification token")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to verify email")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	message := "Email address has been verified"
+	utils.RespondJSON(w, http.StatusOK, v1.MessageResponse{Message: &message})
+}
+
+// PostAuthVerifyEmailResend handles requests for a new verification email
+func (h *Handler) PostAuthVerifyEmailResend(w http.ResponseWriter, r *http.Request) {
+	var req v1.ResendVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Email == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Email is required")
+		return
+	}
+
+	// Resend verification
+	err := h.Service.ResendEmailVerification(r.Context(), string(req.Email))
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to resend email verification")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	message := "If an unverified account uses this email, a verification link has been sent"
+	utils.RespondJSON(w, http.StatusAccepted, v1.MessageResponse{Message: &message})
+}
@@ -147,7 +147,7 @@ func (h *Handler) GetBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoa
 
 	members := make([]v1.Member, 0, len(boardWithDetails.Members))
 	for _, member := range boardWithDetails.Members {
-		members = append(members, memberToAPIResponse(member))
+		members = append(members, memberToAPIResponse(member, h.Service.AvatarURL(member)))
 	}
 
 	response := struct {
@@ -181,6 +181,7 @@ func (h *Handler) PutBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoa
 		NameBoardUnique: req.NameBoardUnique,
 		Description:     req.Description,
 		Password:        req.Password,
+		WIPLimitMode:    req.WipLimitMode,
 	})
 	if err != nil {
 		if errors.Is(err, service.ErrNotBoardMember) {
@@ -203,6 +204,10 @@ func (h *Handler) PutBoardsIdBoard(w http.ResponseWriter, r *http.Request, idBoa
 			utils.RespondError(w, http.StatusBadRequest, "Board unique name must contain only lowercase letters, numbers, and hyphens")
 			return
 		}
+		if errors.Is(err, service.ErrInvalidWIPLimitMode) {
+			utils.RespondError(w, http.StatusBadRequest, "WIP limit mode must be soft or hard")
+			return
+		}
 		utils.Logger().WithError(err).Error("Failed to update board")
 		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
 		return
@@ -260,7 +265,7 @@ func (h *Handler) PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r
 	}
 
 	// Join board
-	board, err := h.Service.JoinBoard(r.Context(), nameBoardUnique, req.Password, userID)
+	board, err := h.Service.JoinBoard(r.Context(), nameBoardUnique, req.Password, userID, utils.ClientIP(r))
 	if err != nil {
 		if errors.Is(err, service.ErrBoardNotFound) {
 			utils.RespondError(w, http.StatusNotFound, "Board not found")
@@ -274,6 +279,13 @@ func (h *Handler) PostMembersBoardsNameBoardUniqueJoin(w http.ResponseWriter, r
 			utils.RespondError(w, http.StatusConflict, "Already a member of this board")
 			return
 		}
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			utils.RespondError(w, http.StatusForbidden, "Verify your email address before joining boards")
+			return
+		}
+		if respondLockedOut(w, err) {
+			return
+		}
 		utils.Logger().WithError(err).Error("Failed to join board")
 		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
 		return
@@ -396,6 +408,8 @@ func boardToAPIResponse(board *models.Board, starred bool) v1.Board {
 		Description:     board.Description,
 		IdMemberCreator: &idCreator,
 		Starred:         &starred,
+		Watching:        board.Watching,
+		WipLimitMode:    &board.WIPLimitMode,
 		CreatedAt:       &board.CreatedAt,
 		UpdatedAt:       &board.UpdatedAt,
 	}
@@ -437,6 +451,7 @@ func listToAPIResponse(list *models.List) v1.List {
 		IdBoard:   &idBoard,
 		Position:  &position,
 		Archived:  &list.Archived,
+		WipLimit:  list.WIPLimit,
 		CreatedAt: &list.CreatedAt,
 		UpdatedAt: &list.UpdatedAt,
 	}
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -13,6 +14,9 @@ import (
 	"github.com/tasks-control/core-back-end/pkg/utils"
 )
 
+// maxBulkCards limits the number of cards accepted by a single bulk request
+const maxBulkCards = 100
+
 // PostCards creates a new card
 func (h *Handler) PostCards(w http.ResponseWriter, r *http.Request, params v1.PostCardsParams) {
 	// Get authenticated user
@@ -44,13 +48,17 @@ func (h *Handler) PostCards(w http.ResponseWriter, r *http.Request, params v1.Po
 
 	// Create card
 	card, err := h.Service.CreateCard(r.Context(), service.CreateCardRequest{
-		Title:       req.Title,
-		Description: req.Description,
-		IDList:      listID,
-		Position:    positionPtr,
-		MemberID:    userID,
+		Title:          req.Title,
+		Description:    req.Description,
+		IDList:         listID,
+		Position:       positionPtr,
+		MemberID:       userID,
+		IgnoreWIPLimit: req.IgnoreWipLimit != nil && *req.IgnoreWipLimit,
 	})
 	if err != nil {
+		if respondWIPLimitError(w, err) {
+			return
+		}
 		if errors.Is(err, service.ErrNotBoardMember) {
 			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
 			return
@@ -122,13 +130,17 @@ func (h *Handler) PutCardsIdCard(w http.ResponseWriter, r *http.Request, idCard
 
 	// Update card
 	card, err := h.Service.UpdateCard(r.Context(), idCard, userID, service.UpdateCardRequest{
-		Title:       req.Title,
-		Description: req.Description,
-		IDList:      (*uuid.UUID)(idListPtr),
-		Position:    positionPtr,
-		Archived:    req.Archived,
+		Title:          req.Title,
+		Description:    req.Description,
+		IDList:         (*uuid.UUID)(idListPtr),
+		Position:       positionPtr,
+		Archived:       req.Archived,
+		IgnoreWIPLimit: req.IgnoreWipLimit != nil && *req.IgnoreWipLimit,
 	})
 	if err != nil {
+		if respondWIPLimitError(w, err) {
+			return
+		}
 		if errors.Is(err, service.ErrNotBoardMember) {
 			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
 			return
@@ -174,3 +186,110 @@ func (h *Handler) DeleteCardsIdCard(w http.ResponseWriter, r *http.Request, idCa
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// PostCardsBulk applies one operation to multiple cards
+func (h *Handler) PostCardsBulk(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.BulkCardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if len(req.IdCards) == 0 {
+		utils.RespondError(w, http.StatusBadRequest, "At least one card ID is required")
+		return
+	}
+	if len(req.IdCards) > maxBulkCards {
+		utils.RespondError(w, http.StatusBadRequest, fmt.Sprintf("At most %d cards can be processed at once", maxBulkCards))
+		return
+	}
+	if req.Operation == v1.Move && req.IdList == nil {
+		utils.RespondError(w, http.StatusBadRequest, "idList is required for move operation")
+		return
+	}
+	if req.Operation == v1.AssignLabel && req.IdLabel == nil {
+		utils.RespondError(w, http.StatusBadRequest, "idLabel is required for assignLabel operation")
+		return
+	}
+
+	cardIDs := make([]uuid.UUID, 0, len(req.IdCards))
+	for _, id := range req.IdCards {
+		cardIDs = append(cardIDs, uuid.UUID(id))
+	}
+
+	// Apply bulk operation
+	results, err := h.Service.BulkUpdateCards(r.Context(), service.BulkCardRequest{
+		CardIDs:        cardIDs,
+		Operation:      string(req.Operation),
+		IDList:         (*uuid.UUID)(req.IdList),
+		IDLabel:        (*uuid.UUID)(req.IdLabel),
+		MemberID:       userID,
+		IgnoreWIPLimit: req.IgnoreWipLimit != nil && *req.IgnoreWipLimit,
+	})
+	if err != nil {
+		if respondWIPLimitError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidBulkOperation) {
+			utils.RespondError(w, http.StatusBadRequest, "Invalid bulk operation")
+			return
+		}
+		if errors.Is(err, service.ErrListNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "List not found")
+			return
+		}
+		if errors.Is(err, service.ErrLabelNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "Label not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to apply bulk card operation")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	apiResults := make([]v1.BulkCardResult, 0, len(results))
+	for _, result := range results {
+		apiResult := v1.BulkCardResult{
+			IdCard:  openapi_types.UUID(result.CardID),
+			Success: result.Err == nil,
+		}
+		if result.Err != nil {
+			message := result.Err.Error()
+			apiResult.Error = &message
+		}
+		apiResults = append(apiResults, apiResult)
+	}
+
+	response := struct {
+		Results []v1.BulkCardResult `json:"results"`
+	}{
+		Results: apiResults,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// respondWIPLimitError writes a 409 naming the full list when err is a WIP limit error and reports whether it was one
+func respondWIPLimitError(w http.ResponseWriter, err error) bool {
+	var limitErr *service.WIPLimitError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+
+	utils.RespondErrorWithDetails(w, http.StatusConflict,
+		fmt.Sprintf("List %q is at its WIP limit of %d cards", limitErr.ListName, limitErr.Limit),
+		map[string]interface{}{
+			"idList":    limitErr.ListID,
+			"listName":  limitErr.ListName,
+			"wipLimit":  limitErr.Limit,
+			"cardCount": limitErr.Count,
+			"mode":      limitErr.Mode,
+		})
+	return true
+}
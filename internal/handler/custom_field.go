@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetBoardsIdBoardCustomFields retrieves all custom fields of a board
+func (h *Handler) GetBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Get custom fields
+	fields, err := h.Service.GetBoardCustomFields(r.Context(), idBoard, userID)
+	if err != nil {
+		if respondCustomFieldError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to get board custom fields")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	apiFields := make([]v1.CustomField, 0, len(fields))
+	for _, field := range fields {
+		apiFields = append(apiFields, customFieldToAPIResponse(field))
+	}
+
+	response := struct {
+		CustomFields []v1.CustomField `json:"customFields"`
+	}{
+		CustomFields: apiFields,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostBoardsIdBoardCustomFields creates a new custom field on a board
+func (h *Handler) PostBoardsIdBoardCustomFields(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.CreateCustomFieldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Name == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Name is required")
+		return
+	}
+	if req.Type == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Type is required")
+		return
+	}
+
+	var options []string
+	if req.Options != nil {
+		options = *req.Options
+	}
+
+	// Create custom field
+	field, err := h.Service.CreateCustomField(r.Context(), service.CreateCustomFieldRequest{
+		Name:     req.Name,
+		Type:     req.Type,
+		Options:  options,
+		BoardID:  idBoard,
+		MemberID: userID,
+	})
+	if err != nil {
+		if respondCustomFieldError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to create custom field")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusCreated, customFieldToAPIResponse(field))
+}
+
+// PutBoardsIdBoardCustomFieldsIdField updates a custom field
+func (h *Handler) PutBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idField openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.UpdateCustomFieldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	updateReq := service.UpdateCustomFieldRequest{
+		Name:     req.Name,
+		Position: req.Position,
+	}
+	if req.Options != nil {
+		options := make([]service.CustomFieldOptionRequest, 0, len(*req.Options))
+		for _, option := range *req.Options {
+			options = append(options, service.CustomFieldOptionRequest{
+				ID:    option.Id,
+				Value: option.Value,
+			})
+		}
+		updateReq.Options = &options
+	}
+
+	// Update custom field
+	field, err := h.Service.UpdateCustomField(r.Context(), idBoard, idField, userID, updateReq)
+	if err != nil {
+		if respondCustomFieldError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to update custom field")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, customFieldToAPIResponse(field))
+}
+
+// DeleteBoardsIdBoardCustomFieldsIdField deletes a custom field
+func (h *Handler) DeleteBoardsIdBoardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idField openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	err := h.Service.DeleteCustomField(r.Context(), idBoard, idField, userID)
+	if err != nil {
+		if respondCustomFieldError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to delete custom field")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PutCardsIdCardCustomFieldsIdField sets or clears the value of a custom field on a card
+func (h *Handler) PutCardsIdCardCustomFieldsIdField(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID, idField openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.SetCustomFieldValueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	value, err := h.Service.SetCardCustomFieldValue(r.Context(), idCard, idField, userID, service.SetCustomFieldValueRequest{
+		Text:     req.Text,
+		Number:   req.Number,
+		Date:     req.Date,
+		Checked:  req.Checked,
+		IDOption: req.IdOption,
+	})
+	if err != nil {
+		if respondCustomFieldError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to set card custom field value")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	if value == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, cardCustomFieldValueToAPIResponse(value))
+}
+
+// respondCustomFieldError writes the response for custom field errors and reports whether err was one
+func respondCustomFieldError(w http.ResponseWriter, err error) bool {
+	var invalidValue *service.CustomFieldValueError
+	switch {
+	case errors.Is(err, service.ErrNotBoardMember):
+		utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+	case errors.Is(err, service.ErrNotBoardOwner):
+		utils.RespondError(w, http.StatusForbidden, "Only board owners can manage custom fields")
+	case errors.Is(err, service.ErrCustomFieldNotFound):
+		utils.RespondError(w, http.StatusNotFound, "Custom field not found")
+	case errors.Is(err, service.ErrCardNotFound):
+		utils.RespondError(w, http.StatusNotFound, "Card not found")
+	case errors.Is(err, service.ErrCustomFieldNameTaken):
+		utils.RespondError(w, http.StatusConflict, err.Error())
+	case errors.As(err, &invalidValue):
+		utils.RespondErrorWithDetails(w, http.StatusBadRequest, err.Error(),
+			map[string]string{"field": invalidValue.Field, "type": invalidValue.Type})
+	case errors.Is(err, service.ErrInvalidCustomFieldName),
+		errors.Is(err, service.ErrInvalidCustomFieldType),
+		errors.Is(err, service.ErrInvalidCustomFieldOptions),
+		errors.Is(err, service.ErrTooManyCustomFields):
+		utils.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		return false
+	}
+	return true
+}
+
+// Helper function to convert internal CustomField model to API response
+func customFieldToAPIResponse(field *models.CustomField) v1.CustomField {
+	id := openapi_types.UUID(field.ID)
+	idBoard := openapi_types.UUID(field.IDBoard)
+
+	response := v1.CustomField{
+		Id:        &id,
+		IdBoard:   &idBoard,
+		Name:      &field.Name,
+		Type:      &field.Type,
+		Position:  &field.Position,
+		CreatedAt: &field.CreatedAt,
+		UpdatedAt: &field.UpdatedAt,
+	}
+
+	if field.Type == models.CustomFieldDropdown {
+		options := make([]v1.CustomFieldOption, 0, len(field.Options))
+		for _, option := range field.Options {
+			optionID := openapi_types.UUID(option.ID)
+			options = append(options, v1.CustomFieldOption{
+				Id:    &optionID,
+				Value: option.Value,
+			})
+		}
+		response.Options = &options
+	}
+
+	return response
+}
+
+// Helper function to convert internal CardCustomFieldValue model to API response
+func cardCustomFieldValueToAPIResponse(value *models.CardCustomFieldValue) v1.CardCustomFieldValue {
+	return v1.CardCustomFieldValue{
+		IdCustomField: value.IDCustomField,
+		Text:          value.Text,
+		Number:        value.Number,
+		Date:          value.Date,
+		Checked:       value.Checked,
+		IdOption:      value.IDOption,
+		UpdatedAt:     &value.UpdatedAt,
+	}
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetMembersMeExport exports the current user's personal data, in the background for large accounts
+func (h *Handler) GetMembersMeExport(w http.ResponseWriter, r *http.Request, params v1.GetMembersMeExportParams) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	format := models.DataExportFormatJSON
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if !service.ValidDataExportFormat(format) {
+		utils.RespondError(w, http.StatusBadRequest, "Format must be json or zip")
+		return
+	}
+
+	inline, err := h.Service.CanExportMemberDataInline(r.Context(), userID)
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to plan personal data export")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Large accounts are exported in the background
+	if !inline {
+		export, err := h.Service.QueueMemberDataExport(r.Context(), userID, format)
+		if err != nil {
+			utils.Logger().WithError(err).Error("Failed to queue personal data export")
+			utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		utils.RespondJSON(w, http.StatusAccepted, dataExportToAPIResponse(export))
+		return
+	}
+
+	out := &attachmentWriter{w: w, contentType: dataExportContentType(format), filename: dataExportFilename(format, time.Now())}
+	err = h.Service.WriteMemberDataExport(r.Context(), userID, format, out)
+	if err != nil {
+		// Once streaming has started the status code is already sent. Abort the connection instead of
+		// finishing the response, so the client sees an incomplete transfer rather than a truncated export.
+		if out.started {
+			utils.Logger().WithError(err).Error("Failed to stream personal data export")
+			panic(http.ErrAbortHandler)
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "User not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to export personal data")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+}
+
+// GetMembersMeExportIdExport retrieves the status of a background personal data export
+func (h *Handler) GetMembersMeExportIdExport(w http.ResponseWriter, r *http.Request, idExport openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	export, err := h.Service.GetMemberDataExport(r.Context(), idExport, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrDataExportNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "Export not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to get personal data export")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, dataExportToAPIResponse(export))
+}
+
+// GetMembersMeExportIdExportDownload sends the archive of a ready background personal data export
+func (h *Handler) GetMembersMeExportIdExportDownload(w http.ResponseWriter, r *http.Request, idExport openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	export, file, err := h.Service.OpenMemberDataExport(r.Context(), idExport, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrDataExportNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "Export not found")
+			return
+		}
+		if errors.Is(err, service.ErrDataExportNotReady) {
+			if export.Status == models.DataExportStatusFailed {
+				utils.RespondError(w, http.StatusConflict, "Export failed, please request a new one")
+				return
+			}
+			utils.RespondError(w, http.StatusConflict, "Export is not ready yet")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to open personal data export")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	defer file.Close()
+
+	modified := export.CreatedAt
+	if export.CompletedAt != nil {
+		modified = *export.CompletedAt
+	}
+	filename := dataExportFilename(export.Format, modified)
+	w.Header().Set("Content-Type", dataExportContentType(export.Format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	http.ServeContent(w, r, filename, modified, file)
+}
+
+// attachmentWriter sends the download headers with the first write,
+// so errors before any output can still be answered with a JSON error
+type attachmentWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (a *attachmentWriter) Write(p []byte) (int, error) {
+	if !a.started {
+		a.started = true
+		a.w.Header().Set("Content-Type", a.contentType)
+		a.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.filename))
+		a.w.WriteHeader(http.StatusOK)
+	}
+	return a.w.Write(p)
+}
+
+func dataExportContentType(format string) string {
+	if format == models.DataExportFormatZIP {
+		return "application/zip"
+	}
+	return "application/json"
+}
+
+func dataExportFilename(format string, exportedAt time.Time) string {
+	return "personal-data-" + exportedAt.UTC().Format("2006-01-02") + "." + format
+}
+
+// dataExportToAPIResponse converts a models.MemberDataExport to v1.DataExport
+func dataExportToAPIResponse(export *models.MemberDataExport) v1.DataExport {
+	return v1.DataExport{
+		Id:          export.ID,
+		Format:      export.Format,
+		Status:      export.Status,
+		SizeBytes:   export.SizeBytes,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+	}
+}
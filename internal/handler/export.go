@@ -0,0 +1,353 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetBoardsIdBoardExport streams a board export as JSON or CSV
+func (h *Handler) GetBoardsIdBoardExport(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, params v1.GetBoardsIdBoardExportParams) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Pick exporter for the requested format
+	format := v1.Json
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	var exporter exportWriter
+	switch format {
+	case v1.Json:
+		exporter = &jsonBoardExporter{w: w, avatarURL: h.Service.AvatarURL}
+	case v1.Csv:
+		exporter = &csvBoardExporter{w: w}
+	default:
+		utils.RespondError(w, http.StatusBadRequest, "Format must be json or csv")
+		return
+	}
+
+	// Export board
+	err := h.Service.ExportBoard(r.Context(), idBoard, userID, exporter)
+	if err != nil {
+		// Once streaming has started the status code is already sent. Abort the connection instead of
+		// finishing the response, so the client sees an incomplete transfer rather than a truncated export.
+		if exporter.started() {
+			utils.Logger().WithError(err).Error("Failed to stream board export")
+			panic(http.ErrAbortHandler)
+		}
+		if errors.Is(err, service.ErrNotBoardMember) {
+			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+			return
+		}
+		if errors.Is(err, service.ErrBoardNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "Board not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to export board")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+}
+
+// exportWriter is a service.BoardExporter that knows whether it already wrote to the response
+type exportWriter interface {
+	service.BoardExporter
+	started() bool
+}
+
+// jsonBoardExporter writes a BoardExport JSON document, streaming cards one by one
+type jsonBoardExporter struct {
+	w         http.ResponseWriter
+	avatarURL func(member *models.Member) *string
+	wroteHead bool
+	cardCount int
+}
+
+func (e *jsonBoardExporter) started() bool {
+	return e.wroteHead
+}
+
+// WriteHeader sends the response headers and everything except the cards
+func (e *jsonBoardExporter) WriteHeader(export *service.BoardExport) error {
+	board := boardToAPIResponse(export.Board, false)
+	board.Starred = nil
+
+	lists := make([]v1.List, 0, len(export.Lists))
+	for _, list := range export.Lists {
+		lists = append(lists, listToAPIResponse(list))
+	}
+
+	members := make([]boardExportMember, 0, len(export.Members))
+	for _, member := range export.Members {
+		members = append(members, boardExportMember{
+			Member:   memberToAPIResponse(&member.Member, e.avatarURL(&member.Member)),
+			Role:     member.Role,
+			JoinedAt: member.JoinedAt,
+		})
+	}
+
+	labels := make([]v1.Label, 0, len(export.Labels))
+	for _, label := range export.Labels {
+		labels = append(labels, labelToAPIResponse(label))
+	}
+
+	customFields := make([]v1.CustomField, 0, len(export.CustomFields))
+	for _, field := range export.CustomFields {
+		customFields = append(customFields, customFieldToAPIResponse(field))
+	}
+
+	// Cards are streamed afterwards, so the document is closed in Finish
+	head, err := json.Marshal(struct {
+		Version      int                 `json:"version"`
+		ExportedAt   time.Time           `json:"exportedAt"`
+		Board        v1.Board            `json:"board"`
+		Lists        []v1.List           `json:"lists"`
+		Members      []boardExportMember `json:"members"`
+		Labels       []v1.Label          `json:"labels"`
+		CustomFields []v1.CustomField    `json:"customFields"`
+	}{
+		Version:      service.BoardExportVersion,
+		ExportedAt:   time.Now().UTC(),
+		Board:        board,
+		Lists:        lists,
+		Members:      members,
+		Labels:       labels,
+		CustomFields: customFields,
+	})
+	if err != nil {
+		return err
+	}
+
+	e.w.Header().Set("Content-Type", "application/json")
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Board.NameBoardUnique+".json"))
+	e.w.WriteHeader(http.StatusOK)
+	e.wroteHead = true
+
+	// Drop the closing brace and open the cards array
+	if _, err := e.w.Write(head[:len(head)-1]); err != nil {
+		return err
+	}
+	_, err = io.WriteString(e.w, `,"cards":[`)
+	return err
+}
+
+// WriteCard appends a card to the cards array
+func (e *jsonBoardExporter) WriteCard(card *models.Card, labelIDs []uuid.UUID) error {
+	idLabels := make([]openapi_types.UUID, 0, len(labelIDs))
+	for _, labelID := range labelIDs {
+		idLabels = append(idLabels, openapi_types.UUID(labelID))
+	}
+
+	data, err := json.Marshal(boardExportCard{
+		Card:     cardToAPIResponse(card),
+		IdLabels: idLabels,
+	})
+	if err != nil {
+		return err
+	}
+
+	if e.cardCount > 0 {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+	e.cardCount++
+
+	_, err = e.w.Write(data)
+	return err
+}
+
+// Finish closes the cards array and the document
+func (e *jsonBoardExporter) Finish() error {
+	_, err := io.WriteString(e.w, "]}\n")
+	return err
+}
+
+// boardExportMember mirrors the BoardExportMember schema
+type boardExportMember struct {
+	v1.Member
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+// boardExportCard mirrors the BoardExportCard schema
+type boardExportCard struct {
+	v1.Card
+	IdLabels []openapi_types.UUID `json:"idLabels"`
+}
+
+// csvBoardExporter writes one CSV row per card
+type csvBoardExporter struct {
+	w         http.ResponseWriter
+	csv       *csv.Writer
+	wroteHead bool
+	lists     map[uuid.UUID]*models.List
+	labels    map[uuid.UUID]*models.Label
+	members   map[uuid.UUID]*models.BoardMemberWithRole
+	fields    []*models.CustomField
+	options   map[uuid.UUID]string
+}
+
+func (e *csvBoardExporter) started() bool {
+	return e.wroteHead
+}
+
+// WriteHeader sends the response headers and the CSV header row
+func (e *csvBoardExporter) WriteHeader(export *service.BoardExport) error {
+	e.lists = make(map[uuid.UUID]*models.List, len(export.Lists))
+	for _, list := range export.Lists {
+		e.lists[list.ID] = list
+	}
+	e.labels = make(map[uuid.UUID]*models.Label, len(export.Labels))
+	for _, label := range export.Labels {
+		e.labels[label.ID] = label
+	}
+	e.members = make(map[uuid.UUID]*models.BoardMemberWithRole, len(export.Members))
+	for _, member := range export.Members {
+		e.members[member.ID] = member
+	}
+
+	// Every custom field gets a column after the fixed ones
+	e.fields = export.CustomFields
+	e.options = make(map[uuid.UUID]string)
+	for _, field := range export.CustomFields {
+		for _, option := range field.Options {
+			e.options[option.ID] = option.Value
+		}
+	}
+
+	e.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Board.NameBoardUnique+".csv"))
+	e.w.WriteHeader(http.StatusOK)
+	e.wroteHead = true
+
+	e.csv = csv.NewWriter(e.w)
+	header := []string{
+		"card_id",
+		"title",
+		"description",
+		"list_id",
+		"list_name",
+		"list_archived",
+		"position",
+		"archived",
+		"labels",
+		"created_by",
+		"created_at",
+		"updated_at",
+	}
+	// Prefixed so a field named like a fixed column can't be mistaken for it
+	for _, field := range e.fields {
+		header = append(header, "custom:"+field.Name)
+	}
+	return e.csv.Write(header)
+}
+
+// WriteCard writes a single card row
+func (e *csvBoardExporter) WriteCard(card *models.Card, labelIDs []uuid.UUID) error {
+	description := ""
+	if card.Description != nil {
+		description = *card.Description
+	}
+
+	listName := ""
+	listArchived := false
+	if list, ok := e.lists[card.IDList]; ok {
+		listName = list.Name
+		listArchived = list.Archived
+	}
+
+	labelNames := make([]string, 0, len(labelIDs))
+	for _, labelID := range labelIDs {
+		label, ok := e.labels[labelID]
+		if !ok {
+			continue
+		}
+		if label.Name != nil && *label.Name != "" {
+			labelNames = append(labelNames, *label.Name)
+		} else {
+			labelNames = append(labelNames, label.Color)
+		}
+	}
+
+	// Members who left the board are exported by ID
+	createdBy := card.CreatedBy.String()
+	if member, ok := e.members[card.CreatedBy]; ok {
+		createdBy = member.Username
+	}
+
+	row := []string{
+		card.ID.String(),
+		csvText(card.Title),
+		csvText(description),
+		card.IDList.String(),
+		csvText(listName),
+		strconv.FormatBool(listArchived),
+		strconv.FormatFloat(card.Position, 'f', -1, 64),
+		strconv.FormatBool(card.Archived),
+		csvText(strings.Join(labelNames, "; ")),
+		csvText(createdBy),
+		card.CreatedAt.UTC().Format(time.RFC3339),
+		card.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	values := make(map[uuid.UUID]*models.CardCustomFieldValue, len(card.CustomFields))
+	for _, value := range card.CustomFields {
+		values[value.IDCustomField] = value
+	}
+	for _, field := range e.fields {
+		row = append(row, e.formatCustomFieldValue(values[field.ID]))
+	}
+
+	return e.csv.Write(row)
+}
+
+// formatCustomFieldValue renders a card value for its column, empty when the card has none
+func (e *csvBoardExporter) formatCustomFieldValue(value *models.CardCustomFieldValue) string {
+	switch {
+	case value == nil:
+		return ""
+	case value.Text != nil:
+		return csvText(*value.Text)
+	case value.Number != nil:
+		return strconv.FormatFloat(*value.Number, 'f', -1, 64)
+	case value.Date != nil:
+		return value.Date.UTC().Format(time.RFC3339)
+	case value.Checked != nil:
+		return strconv.FormatBool(*value.Checked)
+	case value.IDOption != nil:
+		return csvText(e.options[*value.IDOption])
+	}
+	return ""
+}
+
+// csvText escapes user text so spreadsheets don't evaluate it as a formula, prefixing cells that start
+// with a formula character with a quote
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// Finish flushes buffered rows
+func (e *csvBoardExporter) Finish() error {
+	e.csv.Flush()
+	return e.csv.Error()
+}
@@ -1,9 +1,16 @@
 package handler
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"path"
+	"strconv"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/internal/storage"
+	"github.com/tasks-control/core-back-end/pkg/utils"
 )
 
 type Handler struct {
@@ -18,6 +25,53 @@ func (h *Handler) GetAlive(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetJWKS publishes the public keys verifying our JWTs so other services don't need a shared secret
+func (h *Handler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	// Verifiers may cache keys, new keys are published before they start signing
+	w.Header().Del("Expires")
+	w.Header().Del("Pragma")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	utils.RespondJSON(w, http.StatusOK, h.Service.JWTManager.JWKS())
+}
+
+// GetFile serves uploaded files of the local storage driver. Keys are never reused, so files can be cached for good.
+func (h *Handler) GetFile(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "*")
+	file, modTime, err := h.Service.Storage.Open(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "File not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to open file")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	defer file.Close()
+
+	// Let ServeContent pick the type from the file extension
+	w.Header().Del("Content-Type")
+	w.Header().Del("Expires")
+	w.Header().Del("Pragma")
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, path.Base(key), modTime, file)
+}
+
+// respondLockedOut writes a 429 with Retry-After for a lockout after failed attempts and reports whether it did
+func respondLockedOut(w http.ResponseWriter, err error) bool {
+	var lockedOut *service.LockedOutError
+	if !errors.As(err, &lockedOut) {
+		return false
+	}
+
+	retryAfter := int(math.Ceil(lockedOut.RetryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	utils.RespondError(w, http.StatusTooManyRequests, "Too many failed attempts, try again later")
+	return true
+}
+
 func (h *Handler) Readiness(w http.ResponseWriter, r *http.Request) {
 	//TODO implement me
 	w.WriteHeader(http.StatusNotImplemented)
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// maxImportBodySize limits the size of an uploaded board import
+const maxImportBodySize = 32 << 20
+
+// PostBoardsImport creates a new board from a board export or a Trello export
+func (h *Handler) PostBoardsImport(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.ImportBoardRequest
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportBodySize)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.NameBoardUnique == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Name board unique is required")
+		return
+	}
+	if req.Password == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Password is required")
+		return
+	}
+	if len(req.Data) == 0 {
+		utils.RespondError(w, http.StatusBadRequest, "Data is required")
+		return
+	}
+
+	data, err := json.Marshal(req.Data)
+	if err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Import board
+	result, err := h.Service.ImportBoard(r.Context(), service.ImportBoardRequest{
+		Name:            req.Name,
+		NameBoardUnique: req.NameBoardUnique,
+		Password:        req.Password,
+		Data:            data,
+		MemberID:        userID,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrBoardAlreadyExists) {
+			utils.RespondError(w, http.StatusConflict, "Board with this unique name already exists")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidBoardUniqueName) {
+			utils.RespondError(w, http.StatusBadRequest, "Board unique name must contain only lowercase letters, numbers, and hyphens")
+			return
+		}
+		if errors.Is(err, service.ErrUnsupportedImportFormat) {
+			utils.RespondError(w, http.StatusBadRequest, "Data must be a board export or a Trello board export")
+			return
+		}
+		var invalidName *service.ImportNameError
+		if errors.As(err, &invalidName) {
+			utils.RespondErrorWithDetails(w, http.StatusBadRequest, err.Error(),
+				map[string]string{"type": invalidName.Type, "id": invalidName.ID})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidImportBoardName) {
+			utils.RespondError(w, http.StatusBadRequest, "Name must be 1 to 100 characters")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidImportData) {
+			utils.RespondError(w, http.StatusBadRequest, "Import data is malformed")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to import board")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	report := result.Report
+	unmappedFields := make([]v1.ImportUnmappedField, 0, len(report.UnmappedFields))
+	for _, field := range report.UnmappedFields {
+		unmappedFields = append(unmappedFields, v1.ImportUnmappedField{
+			Field: field.Field,
+			Count: field.Count,
+		})
+	}
+
+	skipped := make([]v1.ImportSkippedItem, 0, len(report.Skipped))
+	for _, item := range report.Skipped {
+		skipped = append(skipped, v1.ImportSkippedItem{
+			Type:   item.Type,
+			Id:     item.ID,
+			Reason: item.Reason,
+		})
+	}
+
+	response := struct {
+		Board  v1.Board        `json:"board"`
+		Report v1.ImportReport `json:"report"`
+	}{
+		Board: boardToAPIResponse(result.Board, false),
+		Report: v1.ImportReport{
+			Source:             v1.ImportReportSource(report.Source),
+			ListsImported:      report.ListsImported,
+			CardsImported:      report.CardsImported,
+			LabelsImported:     report.LabelsImported,
+			ChecklistsImported: report.ChecklistsImported,
+			UnmappedFields:     unmappedFields,
+			Skipped:            skipped,
+		},
+	}
+
+	utils.RespondJSON(w, http.StatusCreated, response)
+}
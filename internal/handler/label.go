@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetBoardsIdBoardLabels retrieves all labels of a board
+func (h *Handler) GetBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Get labels
+	labels, err := h.Service.GetBoardLabels(r.Context(), idBoard, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotBoardMember) {
+			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to get board labels")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	apiLabels := make([]v1.Label, 0, len(labels))
+	for _, label := range labels {
+		apiLabels = append(apiLabels, labelToAPIResponse(label))
+	}
+
+	response := struct {
+		Labels []v1.Label `json:"labels"`
+	}{
+		Labels: apiLabels,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostBoardsIdBoardLabels creates a new label on a board
+func (h *Handler) PostBoardsIdBoardLabels(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.CreateLabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Color == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Color is required")
+		return
+	}
+	if len(req.Color) > 20 {
+		utils.RespondError(w, http.StatusBadRequest, "Color must be at most 20 characters")
+		return
+	}
+	if req.Name != nil && len(*req.Name) > 100 {
+		utils.RespondError(w, http.StatusBadRequest, "Name must be at most 100 characters")
+		return
+	}
+
+	// Create label
+	label, err := h.Service.CreateLabel(r.Context(), service.CreateLabelRequest{
+		Name:     req.Name,
+		Color:    req.Color,
+		IDBoard:  idBoard,
+		MemberID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrNotBoardMember) {
+			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to create label")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	response := labelToAPIResponse(label)
+	utils.RespondJSON(w, http.StatusCreated, response)
+}
+
+// Helper function to convert internal Label model to API response
+func labelToAPIResponse(label *models.Label) v1.Label {
+	id := openapi_types.UUID(label.ID)
+	idBoard := openapi_types.UUID(label.IDBoard)
+
+	return v1.Label{
+		Id:        &id,
+		IdBoard:   &idBoard,
+		Name:      label.Name,
+		Color:     &label.Color,
+		CreatedAt: &label.CreatedAt,
+	}
+}
@@ -132,6 +132,7 @@ func (h *Handler) PutListsIdList(w http.ResponseWriter, r *http.Request, idList
 		Name:     req.Name,
 		Position: positionPtr,
 		Archived: req.Archived,
+		WIPLimit: req.WipLimit,
 	})
 	if err != nil {
 		if errors.Is(err, service.ErrNotBoardMember) {
@@ -142,6 +143,10 @@ func (h *Handler) PutListsIdList(w http.ResponseWriter, r *http.Request, idList
 			utils.RespondError(w, http.StatusNotFound, "List not found")
 			return
 		}
+		if errors.Is(err, service.ErrInvalidWIPLimit) {
+			utils.RespondError(w, http.StatusBadRequest, "WIP limit must not be negative")
+			return
+		}
 		utils.Logger().WithError(err).Error("Failed to update list")
 		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
 		return
@@ -176,6 +181,84 @@ func (h *Handler) DeleteListsIdList(w http.ResponseWriter, r *http.Request, idLi
 	w.WriteHeader(http.StatusOK)
 }
 
+// PostListsIdListArchiveAllCards archives all cards in a list
+func (h *Handler) PostListsIdListArchiveAllCards(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Archive cards
+	count, err := h.Service.ArchiveAllListCards(r.Context(), idList, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotBoardMember) {
+			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+			return
+		}
+		if errors.Is(err, service.ErrListNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "List not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to archive list cards")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	response := struct {
+		Count int `json:"count"`
+	}{
+		Count: count,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostListsIdListMoveAllCards moves all cards in a list to another list
+func (h *Handler) PostListsIdListMoveAllCards(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.MoveAllCardsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Move cards
+	count, err := h.Service.MoveAllListCards(r.Context(), idList, req.IdList, userID, req.IgnoreWipLimit != nil && *req.IgnoreWipLimit)
+	if err != nil {
+		if respondWIPLimitError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrNotBoardMember) {
+			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+			return
+		}
+		if errors.Is(err, service.ErrListNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "List not found")
+			return
+		}
+		if errors.Is(err, service.ErrSameList) {
+			utils.RespondError(w, http.StatusBadRequest, "Target list must differ from the source list")
+			return
+		}
+		if errors.Is(err, service.ErrListInOtherBoard) {
+			utils.RespondError(w, http.StatusBadRequest, "Target list belongs to a different board")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to move list cards")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	response := struct {
+		Count int `json:"count"`
+	}{
+		Count: count,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
 // Helper function to convert internal Card model to API response
 func cardToAPIResponse(card *models.Card) v1.Card {
 	id := openapi_types.UUID(card.ID)
@@ -183,7 +266,7 @@ func cardToAPIResponse(card *models.Card) v1.Card {
 	createdBy := openapi_types.UUID(card.CreatedBy)
 	position := float32(card.Position)
 
-	return v1.Card{
+	response := v1.Card{
 		Id:          &id,
 		Title:       &card.Title,
 		Description: card.Description,
@@ -191,7 +274,19 @@ func cardToAPIResponse(card *models.Card) v1.Card {
 		Position:    &position,
 		Archived:    &card.Archived,
 		CreatedBy:   &createdBy,
+		Watching:    card.Watching,
 		CreatedAt:   &card.CreatedAt,
 		UpdatedAt:   &card.UpdatedAt,
 	}
+
+	// Values are only loaded for a single card and exports
+	if card.CustomFields != nil {
+		customFields := make([]v1.CardCustomFieldValue, 0, len(card.CustomFields))
+		for _, value := range card.CustomFields {
+			customFields = append(customFields, cardCustomFieldValueToAPIResponse(value))
+		}
+		response.CustomFields = &customFields
+	}
+
+	return response
 }
@@ -3,10 +3,14 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	v1 "github.com/tasks-control/core-back-end/api/v1"
 	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
 	"github.com/tasks-control/core-back-end/internal/service"
 	"github.com/tasks-control/core-back-end/pkg/utils"
 )
@@ -17,7 +21,7 @@ func (h *Handler) GetMembersMe(w http.ResponseWriter, r *http.Request) {
 	user := middleware.MustGetUserFromContext(r.Context())
 
 	// Convert to API response
-	response := memberToAPIResponse(user)
+	response := memberToAPIResponse(user, h.Service.AvatarURL(user))
 	utils.RespondJSON(w, http.StatusOK, response)
 }
 
@@ -63,16 +67,27 @@ func (h *Handler) PutMembersMe(w http.ResponseWriter, r *http.Request) {
 
 	// Update member profile
 	member, err := h.Service.UpdateMemberProfile(r.Context(), userID, service.UpdateMemberRequest{
-		Email:    emailPtr,
-		Username: req.Username,
-		FullName: req.FullName,
-		Password: req.Password,
+		Email:           emailPtr,
+		Username:        req.Username,
+		FullName:        req.FullName,
+		Password:        req.Password,
+		CurrentPassword: req.CurrentPassword,
+		IPAddress:       utils.ClientIP(r),
+		UserAgent:       r.UserAgent(),
 	})
 	if err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			utils.RespondError(w, http.StatusNotFound, "User not found")
 			return
 		}
+		if errors.Is(err, service.ErrCurrentPasswordRequired) {
+			utils.RespondError(w, http.StatusBadRequest, "Current password is required to change email or password")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidCurrentPassword) {
+			utils.RespondError(w, http.StatusForbidden, "Current password is incorrect")
+			return
+		}
 		if errors.Is(err, service.ErrEmailAlreadyTaken) {
 			utils.RespondError(w, http.StatusConflict, "Email is already taken")
 			return
@@ -87,6 +102,189 @@ func (h *Handler) PutMembersMe(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Convert to API response
-	response := memberToAPIResponse(member)
+	response := memberToAPIResponse(member, h.Service.AvatarURL(member))
 	utils.RespondJSON(w, http.StatusOK, response)
 }
+
+// DeleteMembersMe deletes the current authenticated user's account
+func (h *Handler) DeleteMembersMe(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.CurrentPassword == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Current password is required to delete the account")
+		return
+	}
+
+	var transfers []models.BoardOwnershipTransfer
+	if req.TransferOwnership != nil {
+		for _, transfer := range *req.TransferOwnership {
+			transfers = append(transfers, models.BoardOwnershipTransfer{
+				IDBoard:  transfer.IdBoard,
+				IDMember: transfer.IdMember,
+			})
+		}
+	}
+
+	err := h.Service.DeleteAccount(r.Context(), service.DeleteAccountRequest{
+		MemberID:          userID,
+		CurrentPassword:   req.CurrentPassword,
+		TransferOwnership: transfers,
+	})
+	if err != nil {
+		var ownedBoardsErr *service.OwnedBoardsError
+		if errors.As(err, &ownedBoardsErr) {
+			type blockingBoard struct {
+				ID          uuid.UUID `json:"id"`
+				Name        string    `json:"name"`
+				MemberCount *int      `json:"memberCount,omitempty"`
+			}
+			boards := make([]blockingBoard, 0, len(ownedBoardsErr.Boards))
+			for _, board := range ownedBoardsErr.Boards {
+				boards = append(boards, blockingBoard{ID: board.ID, Name: board.Name, MemberCount: board.MemberCount})
+			}
+			utils.RespondErrorWithDetails(w, http.StatusConflict, "Transfer ownership of your shared boards before deleting your account",
+				map[string]interface{}{"boards": boards})
+			return
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "User not found")
+			return
+		}
+		if errors.Is(err, service.ErrCurrentPasswordRequired) {
+			utils.RespondError(w, http.StatusBadRequest, "Current password is required to delete the account")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidCurrentPassword) {
+			utils.RespondError(w, http.StatusForbidden, "Current password is incorrect")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidOwnershipTransfer) {
+			utils.RespondError(w, http.StatusBadRequest, "Ownership can only be transferred for boards you own, to another member of the board")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to delete member account")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PutMembersMeAvatar replaces the current user's avatar with the uploaded image
+func (h *Handler) PutMembersMeAvatar(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Read one byte more than allowed to tell a too large image apart
+	data, err := io.ReadAll(io.LimitReader(r.Body, service.MaxAvatarBytes+1))
+	if err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if len(data) == 0 {
+		utils.RespondError(w, http.StatusBadRequest, "Image is required")
+		return
+	}
+
+	member, err := h.Service.UpdateMemberAvatar(r.Context(), userID, data)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidAvatarImage) {
+			utils.RespondError(w, http.StatusBadRequest, "Image must be a PNG, JPEG or GIF")
+			return
+		}
+		if errors.Is(err, service.ErrAvatarTooLarge) {
+			utils.RespondError(w, http.StatusRequestEntityTooLarge, "Image is too large")
+			return
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "User not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to update avatar")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, memberToAPIResponse(member, h.Service.AvatarURL(member)))
+}
+
+// GetMembers searches members by username or full name prefix, or by exact email
+func (h *Handler) GetMembers(w http.ResponseWriter, r *http.Request, params v1.GetMembersParams) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	limit := 10
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 50 {
+		utils.RespondError(w, http.StatusBadRequest, "Limit must be between 1 and 50")
+		return
+	}
+	if len(params.Query) > 255 {
+		utils.RespondError(w, http.StatusBadRequest, "Query is too long")
+		return
+	}
+
+	members, err := h.Service.SearchMembers(r.Context(), userID, params.Query, limit)
+	if err != nil {
+		if errors.Is(err, service.ErrSearchQueryRequired) {
+			utils.RespondError(w, http.StatusBadRequest, "Query is required")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to search members")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	profiles := make([]v1.MemberProfile, 0, len(members))
+	for _, member := range members {
+		profiles = append(profiles, memberToAPIProfile(member, h.Service.AvatarURL(member)))
+	}
+
+	response := struct {
+		Members []v1.MemberProfile `json:"members"`
+	}{
+		Members: profiles,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// GetMembersIdMember retrieves the public profile of a member
+func (h *Handler) GetMembersIdMember(w http.ResponseWriter, r *http.Request, idMember openapi_types.UUID) {
+	member, err := h.Service.GetMemberProfile(r.Context(), idMember)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "Member not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to get member profile")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, memberToAPIProfile(member, h.Service.AvatarURL(member)))
+}
+
+// memberToAPIProfile converts a models.Member to the public v1.MemberProfile, leaving out the email
+func memberToAPIProfile(member *models.Member, avatarURL *string) v1.MemberProfile {
+	id := openapi_types.UUID(member.ID)
+
+	return v1.MemberProfile{
+		Id:        &id,
+		Username:  &member.Username,
+		FullName:  member.FullName,
+		AvatarUrl: avatarURL,
+		CreatedAt: &member.CreatedAt,
+	}
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// PostAuthMfaVerify completes a login with the challenge token and a second factor code
+func (h *Handler) PostAuthMfaVerify(w http.ResponseWriter, r *http.Request) {
+	var req v1.VerifyMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.MfaToken == "" {
+		utils.RespondError(w, http.StatusBadRequest, "MFA token is required")
+		return
+	}
+	if req.Code == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Code is required")
+		return
+	}
+
+	// Verify second factor
+	authResp, err := h.Service.VerifyMFALogin(r.Context(), service.VerifyMFARequest{
+		Token:     req.MfaToken,
+		Code:      req.Code,
+		IPAddress: utils.ClientIP(r),
+		UserAgent: r.UserAgent(),
+	})
+	if err != nil {
+		if respondLockedOut(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidMFAChallenge) {
+			utils.RespondError(w, http.StatusUnauthorized, "Invalid or expired MFA token, please log in again")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidMFACode) {
+			utils.RespondError(w, http.StatusUnauthorized, "Invalid two-factor code")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to verify two-factor login")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to response format
+	response := v1.LoginResponse{
+		AccessToken:  &authResp.AccessToken,
+		RefreshToken: &authResp.RefreshToken,
+		ExpiresIn:    &authResp.ExpiresIn,
+		User:         memberToAPIResponsePtr(authResp.User, h.Service.AvatarURL(authResp.User)),
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// GetMembersMeMfa retrieves the two-factor authentication status of the current user
+func (h *Handler) GetMembersMeMfa(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Get status
+	status, err := h.Service.GetMFAStatus(r.Context(), userID)
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to get two-factor status")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	response := struct {
+		Enabled                bool       `json:"enabled"`
+		ConfirmedAt            *time.Time `json:"confirmedAt,omitempty"`
+		RecoveryCodesRemaining int        `json:"recoveryCodesRemaining"`
+	}{
+		Enabled:                status.Enabled,
+		ConfirmedAt:            status.ConfirmedAt,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostMembersMeMfaTotp starts TOTP enrolment for the current user
+func (h *Handler) PostMembersMeMfaTotp(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Generate secret
+	enrolment, err := h.Service.StartTOTPEnrolment(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrMFAAlreadyEnabled) {
+			utils.RespondError(w, http.StatusConflict, "Two-factor authentication is already enabled")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to start TOTP enrolment")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	response := struct {
+		Secret     string `json:"secret"`
+		OtpauthURI string `json:"otpauthUri"`
+	}{
+		Secret:     enrolment.Secret,
+		OtpauthURI: enrolment.OTPAuthURI,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostMembersMeMfaTotpConfirm enables TOTP for the current user
+func (h *Handler) PostMembersMeMfaTotpConfirm(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Code == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Code is required")
+		return
+	}
+
+	// Confirm enrolment
+	codes, err := h.Service.ConfirmTOTPEnrolment(r.Context(), userID, req.Code, utils.ClientIP(r), r.UserAgent())
+	if err != nil {
+		if errors.Is(err, service.ErrMFANotEnrolled) {
+			utils.RespondError(w, http.StatusBadRequest, "Start TOTP enrolment first")
+			return
+		}
+		if errors.Is(err, service.ErrMFAAlreadyEnabled) {
+			utils.RespondError(w, http.StatusConflict, "Two-factor authentication is already enabled")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidMFACode) {
+			utils.RespondError(w, http.StatusBadRequest, "Invalid two-factor code")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to confirm TOTP enrolment")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	respondRecoveryCodes(w, codes)
+}
+
+// PostMembersMeMfaTotpDisable turns off two-factor authentication for the current user
+func (h *Handler) PostMembersMeMfaTotpDisable(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.DisableMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Code == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Code is required")
+		return
+	}
+
+	// Disable second factor
+	err := h.Service.DisableMFA(r.Context(), service.DisableMFARequest{
+		MemberID:        userID,
+		CurrentPassword: req.CurrentPassword,
+		Code:            req.Code,
+		IPAddress:       utils.ClientIP(r),
+		UserAgent:       r.UserAgent(),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrMFANotEnabled) {
+			utils.RespondError(w, http.StatusBadRequest, "Two-factor authentication is not enabled")
+			return
+		}
+		if errors.Is(err, service.ErrCurrentPasswordRequired) {
+			utils.RespondError(w, http.StatusBadRequest, "Current password is required")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidCurrentPassword) {
+			utils.RespondError(w, http.StatusForbidden, "Current password is incorrect")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidMFACode) {
+			utils.RespondError(w, http.StatusForbidden, "Invalid two-factor code")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to disable two-factor authentication")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PostMembersMeMfaRecoveryCodes regenerates recovery codes of the current user
+func (h *Handler) PostMembersMeMfaRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Code == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Code is required")
+		return
+	}
+
+	// Regenerate codes
+	codes, err := h.Service.RegenerateRecoveryCodes(r.Context(), userID, req.Code, utils.ClientIP(r), r.UserAgent())
+	if err != nil {
+		if errors.Is(err, service.ErrMFANotEnabled) {
+			utils.RespondError(w, http.StatusBadRequest, "Two-factor authentication is not enabled")
+			return
+		}
+		if errors.Is(err, service.ErrInvalidMFACode) {
+			utils.RespondError(w, http.StatusBadRequest, "Invalid two-factor code")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to regenerate recovery codes")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	respondRecoveryCodes(w, codes)
+}
+
+// respondRecoveryCodes writes plaintext recovery codes, which are only returned once
+func respondRecoveryCodes(w http.ResponseWriter, codes []string) {
+	response := struct {
+		RecoveryCodes []string `json:"recoveryCodes"`
+	}{
+		RecoveryCodes: codes,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
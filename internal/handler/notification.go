@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetMembersMeNotifications retrieves the current user's notifications
+func (h *Handler) GetMembersMeNotifications(w http.ResponseWriter, r *http.Request, params v1.GetMembersMeNotificationsParams) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Get query parameters
+	unreadOnly := false
+	if params.Unread != nil {
+		unreadOnly = *params.Unread
+	}
+
+	limit := 20
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	// Get notifications
+	notifications, total, unread, err := h.Service.GetMemberNotifications(r.Context(), userID, unreadOnly, limit, offset)
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to get notifications")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	apiNotifications := make([]v1.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		apiNotifications = append(apiNotifications, notificationToAPIResponse(notification))
+	}
+
+	response := struct {
+		Notifications []v1.Notification `json:"notifications"`
+		Total         int               `json:"total"`
+		UnreadCount   int               `json:"unreadCount"`
+		Limit         int               `json:"limit"`
+		Offset        int               `json:"offset"`
+	}{
+		Notifications: apiNotifications,
+		Total:         total,
+		UnreadCount:   unread,
+		Limit:         limit,
+		Offset:        offset,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostMembersMeNotificationsReadAll marks all of the current user's notifications as read
+func (h *Handler) PostMembersMeNotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	marked, err := h.Service.MarkAllNotificationsRead(r.Context(), userID)
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to mark notifications read")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	response := struct {
+		Marked int64 `json:"marked"`
+	}{
+		Marked: marked,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostMembersMeNotificationsIdNotificationRead marks one of the current user's notifications as read
+func (h *Handler) PostMembersMeNotificationsIdNotificationRead(w http.ResponseWriter, r *http.Request, idNotification openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	err := h.Service.MarkNotificationRead(r.Context(), idNotification, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotificationNotFound) {
+			utils.RespondError(w, http.StatusNotFound, "Notification not found")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to mark notification read")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// notificationToAPIResponse converts models.Notification to v1.Notification
+func notificationToAPIResponse(notification *models.Notification) v1.Notification {
+	response := v1.Notification{
+		Id:        notification.ID,
+		Type:      notification.Type,
+		IdBoard:   notification.IDBoard,
+		IdCard:    notification.IDCard,
+		IdActor:   notification.IDActor,
+		Read:      notification.ReadAt != nil,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+
+	if len(notification.Data) > 0 {
+		var data map[string]interface{}
+		if err := json.Unmarshal(notification.Data, &data); err == nil {
+			response.Data = &data
+		}
+	}
+
+	return response
+}
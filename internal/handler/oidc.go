@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// oidcStateCookie binds a pending OIDC login to the browser that started it
+const oidcStateCookie = "oidc_state"
+
+// GetAuthOidcProviderStart redirects to the login page of an OIDC provider
+func (h *Handler) GetAuthOidcProviderStart(w http.ResponseWriter, r *http.Request, provider string) {
+	authURL, state, err := h.Service.StartOIDCLogin(r.Context(), provider)
+	if err != nil {
+		if errors.Is(err, service.ErrUnknownOIDCProvider) {
+			utils.RespondError(w, http.StatusNotFound, "Sign-in provider not found")
+			return
+		}
+		if errors.Is(err, service.ErrOIDCLoginFailed) {
+			utils.RespondError(w, http.StatusBadGateway, "Sign-in provider is unavailable")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to start OIDC login")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Only sent to the callback, which lives next to this endpoint
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     strings.TrimSuffix(r.URL.Path, "/start") + "/callback",
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// GetAuthOidcProviderCallback completes an OIDC login with the code and state returned by the provider
+func (h *Handler) GetAuthOidcProviderCallback(w http.ResponseWriter, r *http.Request, provider string, params v1.GetAuthOidcProviderCallbackParams) {
+	// Login cancelled or rejected at the provider
+	if params.Error != nil && *params.Error != "" {
+		utils.RespondError(w, http.StatusUnauthorized, "Sign-in was not completed: "+*params.Error)
+		return
+	}
+
+	// Validate required fields
+	if params.Code == nil || *params.Code == "" {
+		utils.RespondError(w, http.StatusBadRequest, "Code is required")
+		return
+	}
+	if params.State == nil || *params.State == "" {
+		utils.RespondError(w, http.StatusBadRequest, "State is required")
+		return
+	}
+
+	var stateCookie string
+	if cookie, err := r.Cookie(oidcStateCookie); err == nil {
+		stateCookie = cookie.Value
+	}
+	// The state is single use, drop the cookie whatever the outcome
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Path:     r.URL.Path,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	authResp, err := h.Service.CompleteOIDCLogin(r.Context(), service.OIDCCallbackRequest{
+		Provider:    provider,
+		Code:        *params.Code,
+		State:       *params.State,
+		StateCookie: stateCookie,
+		IPAddress:   utils.ClientIP(r),
+		UserAgent:   r.UserAgent(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnknownOIDCProvider):
+			utils.RespondError(w, http.StatusNotFound, "Sign-in provider not found")
+		case errors.Is(err, service.ErrInvalidOIDCState):
+			utils.RespondError(w, http.StatusBadRequest, "Invalid or expired sign-in state, please start again")
+		case errors.Is(err, service.ErrOIDCLoginFailed):
+			utils.RespondError(w, http.StatusUnauthorized, "Sign-in with the provider failed")
+		case errors.Is(err, service.ErrOIDCEmailNotVerified):
+			utils.RespondError(w, http.StatusForbidden, "The provider didn't confirm your email address")
+		case errors.Is(err, service.ErrOIDCSignupDisabled):
+			utils.RespondError(w, http.StatusForbidden, "No account is linked to this sign-in")
+		default:
+			utils.Logger().WithError(err).Error("Failed to complete OIDC login")
+			utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		}
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, h.loginResponse(authResp))
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetMembersMePreferences retrieves the current user's preferences
+func (h *Handler) GetMembersMePreferences(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	preferences, err := h.Service.GetMemberPreferences(r.Context(), userID)
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to get preferences")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, preferencesToAPIResponse(preferences))
+}
+
+// PatchMembersMePreferences changes some of the current user's preferences
+func (h *Handler) PatchMembersMePreferences(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	var req v1.MemberPreferences
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	updateReq := service.UpdatePreferencesRequest{
+		Locale:           req.Locale,
+		Timezone:         req.Timezone,
+		DefaultBoardSort: req.DefaultBoardSort,
+		EmailDigest:      req.EmailDigest,
+	}
+	if req.Notifications != nil {
+		updateReq.Notifications = &service.UpdateNotificationPreferencesRequest{
+			BoardMembers: req.Notifications.BoardMembers,
+			CardChanges:  req.Notifications.CardChanges,
+			Mentions:     req.Notifications.Mentions,
+		}
+	}
+
+	preferences, err := h.Service.UpdateMemberPreferences(r.Context(), userID, updateReq)
+	if err != nil {
+		var invalid *service.InvalidPreferenceError
+		if errors.As(err, &invalid) {
+			utils.RespondErrorWithDetails(w, http.StatusBadRequest, "Invalid preference "+invalid.Field+": "+invalid.Reason,
+				map[string]string{"field": invalid.Field})
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to update preferences")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, preferencesToAPIResponse(preferences))
+}
+
+// preferencesToAPIResponse converts models.MemberPreferences to v1.MemberPreferences
+func preferencesToAPIResponse(preferences *models.MemberPreferences) v1.MemberPreferences {
+	return v1.MemberPreferences{
+		Locale:           &preferences.Locale,
+		Timezone:         &preferences.Timezone,
+		DefaultBoardSort: &preferences.DefaultBoardSort,
+		EmailDigest:      &preferences.EmailDigest,
+		Notifications: &v1.NotificationPreferences{
+			BoardMembers: &preferences.Notifications.BoardMembers,
+			CardChanges:  &preferences.Notifications.CardChanges,
+			Mentions:     &preferences.Notifications.Mentions,
+		},
+	}
+}
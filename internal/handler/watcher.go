@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// PostCardsIdCardWatch starts watching a card
+func (h *Handler) PostCardsIdCardWatch(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+	h.respondWatching(w, h.Service.SetCardWatching(r.Context(), idCard, userID, true), true)
+}
+
+// DeleteCardsIdCardWatch stops watching a card
+func (h *Handler) DeleteCardsIdCardWatch(w http.ResponseWriter, r *http.Request, idCard openapi_types.UUID) {
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+	h.respondWatching(w, h.Service.SetCardWatching(r.Context(), idCard, userID, false), false)
+}
+
+// PostListsIdListWatch starts watching all cards of a list
+func (h *Handler) PostListsIdListWatch(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+	h.respondWatching(w, h.Service.SetListWatching(r.Context(), idList, userID, true), true)
+}
+
+// DeleteListsIdListWatch stops watching a list
+func (h *Handler) DeleteListsIdListWatch(w http.ResponseWriter, r *http.Request, idList openapi_types.UUID) {
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+	h.respondWatching(w, h.Service.SetListWatching(r.Context(), idList, userID, false), false)
+}
+
+// PostBoardsIdBoardWatch starts watching all cards of a board
+func (h *Handler) PostBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+	h.respondWatching(w, h.Service.SetBoardWatching(r.Context(), idBoard, userID, true), true)
+}
+
+// DeleteBoardsIdBoardWatch stops watching a board
+func (h *Handler) DeleteBoardsIdBoardWatch(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+	h.respondWatching(w, h.Service.SetBoardWatching(r.Context(), idBoard, userID, false), false)
+}
+
+// respondWatching writes the result of a watch or unwatch request
+func (h *Handler) respondWatching(w http.ResponseWriter, err error, watching bool) {
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotBoardMember):
+			utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+		case errors.Is(err, service.ErrCardNotFound):
+			utils.RespondError(w, http.StatusNotFound, "Card not found")
+		case errors.Is(err, service.ErrListNotFound):
+			utils.RespondError(w, http.StatusNotFound, "List not found")
+		case errors.Is(err, service.ErrBoardNotFound):
+			utils.RespondError(w, http.StatusNotFound, "Board not found")
+		default:
+			utils.Logger().WithError(err).Error("Failed to update watcher")
+			utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		}
+		return
+	}
+
+	message := "Watching started"
+	if !watching {
+		message = "Watching stopped"
+	}
+	response := struct {
+		Message  string `json:"message"`
+		Watching bool   `json:"watching"`
+	}{
+		Message:  message,
+		Watching: watching,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
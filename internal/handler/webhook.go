@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/middleware"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/service"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// GetBoardsIdBoardWebhooks retrieves all webhooks of a board
+func (h *Handler) GetBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Get webhooks
+	webhooks, err := h.Service.GetBoardWebhooks(r.Context(), idBoard, userID)
+	if err != nil {
+		if respondWebhookError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to get board webhooks")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	apiWebhooks := make([]v1.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		apiWebhooks = append(apiWebhooks, webhookToAPIResponse(webhook))
+	}
+
+	response := struct {
+		Webhooks []v1.Webhook `json:"webhooks"`
+	}{
+		Webhooks: apiWebhooks,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostBoardsIdBoardWebhooks creates a new webhook on a board
+func (h *Handler) PostBoardsIdBoardWebhooks(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate required fields
+	if req.Url == "" {
+		utils.RespondError(w, http.StatusBadRequest, "URL is required")
+		return
+	}
+	if len(req.Url) > 2048 {
+		utils.RespondError(w, http.StatusBadRequest, "URL must be at most 2048 characters")
+		return
+	}
+	if req.Secret != nil && (len(*req.Secret) < 16 || len(*req.Secret) > 255) {
+		utils.RespondError(w, http.StatusBadRequest, "Secret must be between 16 and 255 characters")
+		return
+	}
+
+	var events []string
+	if req.Events != nil {
+		events = *req.Events
+	}
+
+	// Create webhook
+	webhook, err := h.Service.CreateWebhook(r.Context(), service.CreateWebhookRequest{
+		URL:      req.Url,
+		Secret:   req.Secret,
+		Events:   events,
+		BoardID:  idBoard,
+		MemberID: userID,
+	})
+	if err != nil {
+		if respondWebhookError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to create webhook")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// The secret is only returned once
+	response := struct {
+		Webhook v1.Webhook `json:"webhook"`
+		Secret  string     `json:"secret"`
+	}{
+		Webhook: webhookToAPIResponse(webhook),
+		Secret:  webhook.Secret,
+	}
+
+	utils.RespondJSON(w, http.StatusCreated, response)
+}
+
+// PutBoardsIdBoardWebhooksIdWebhook updates a webhook
+func (h *Handler) PutBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Parse request
+	var req v1.UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate fields
+	if req.Url != nil && len(*req.Url) > 2048 {
+		utils.RespondError(w, http.StatusBadRequest, "URL must be at most 2048 characters")
+		return
+	}
+	if req.Secret != nil && (len(*req.Secret) < 16 || len(*req.Secret) > 255) {
+		utils.RespondError(w, http.StatusBadRequest, "Secret must be between 16 and 255 characters")
+		return
+	}
+
+	// Update webhook
+	webhook, err := h.Service.UpdateWebhook(r.Context(), idBoard, idWebhook, userID, service.UpdateWebhookRequest{
+		URL:    req.Url,
+		Secret: req.Secret,
+		Events: req.Events,
+		Active: req.Active,
+	})
+	if err != nil {
+		if respondWebhookError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to update webhook")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, webhookToAPIResponse(webhook))
+}
+
+// DeleteBoardsIdBoardWebhooksIdWebhook deletes a webhook
+func (h *Handler) DeleteBoardsIdBoardWebhooksIdWebhook(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Delete webhook
+	err := h.Service.DeleteWebhook(r.Context(), idBoard, idWebhook, userID)
+	if err != nil {
+		if respondWebhookError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to delete webhook")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetBoardsIdBoardWebhooksIdWebhookDeliveries retrieves delivery logs of a webhook
+func (h *Handler) GetBoardsIdBoardWebhooksIdWebhookDeliveries(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID, params v1.GetBoardsIdBoardWebhooksIdWebhookDeliveriesParams) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Get query parameters
+	limit := 20
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	// Get delivery logs
+	logs, total, err := h.Service.GetWebhookDeliveryLogs(r.Context(), idBoard, idWebhook, userID, limit, offset)
+	if err != nil {
+		if respondWebhookError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to get webhook delivery logs")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Convert to API response
+	apiLogs := make([]v1.WebhookDeliveryLog, 0, len(logs))
+	for _, log := range logs {
+		apiLogs = append(apiLogs, webhookDeliveryLogToAPIResponse(log))
+	}
+
+	response := struct {
+		Deliveries []v1.WebhookDeliveryLog `json:"deliveries"`
+		Total      int                     `json:"total"`
+		Limit      int                     `json:"limit"`
+		Offset     int                     `json:"offset"`
+	}{
+		Deliveries: apiLogs,
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	utils.RespondJSON(w, http.StatusOK, response)
+}
+
+// PostBoardsIdBoardWebhooksIdWebhookTest sends a test event to a webhook
+func (h *Handler) PostBoardsIdBoardWebhooksIdWebhookTest(w http.ResponseWriter, r *http.Request, idBoard openapi_types.UUID, idWebhook openapi_types.UUID) {
+	// Get authenticated user
+	userID := middleware.MustGetUserIDFromContext(r.Context())
+
+	// Send test event
+	log, err := h.Service.TestWebhook(r.Context(), idBoard, idWebhook, userID)
+	if err != nil {
+		if respondWebhookError(w, err) {
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to test webhook")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	utils.RespondJSON(w, http.StatusOK, webhookDeliveryLogToAPIResponse(log))
+}
+
+// respondWebhookError writes the response for errors shared by webhook endpoints and reports whether it did
+func respondWebhookError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrNotBoardMember):
+		utils.RespondError(w, http.StatusForbidden, "You are not a member of this board")
+	case errors.Is(err, service.ErrNotBoardOwner):
+		utils.RespondError(w, http.StatusForbidden, "Only board owners can manage webhooks")
+	case errors.Is(err, service.ErrWebhookNotFound):
+		utils.RespondError(w, http.StatusNotFound, "Webhook not found")
+	case errors.Is(err, service.ErrInvalidWebhookURL):
+		utils.RespondError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrInvalidWebhookEvent):
+		utils.RespondError(w, http.StatusBadRequest, "Unknown webhook event")
+	default:
+		return false
+	}
+	return true
+}
+
+// Helper function to convert internal Webhook model to API response
+func webhookToAPIResponse(webhook *models.Webhook) v1.Webhook {
+	id := openapi_types.UUID(webhook.ID)
+	idBoard := openapi_types.UUID(webhook.IDBoard)
+	createdBy := openapi_types.UUID(webhook.CreatedBy)
+	events := []string(webhook.Events)
+	if events == nil {
+		events = []string{}
+	}
+
+	return v1.Webhook{
+		Id:        &id,
+		IdBoard:   &idBoard,
+		Url:       &webhook.URL,
+		Events:    &events,
+		Active:    &webhook.Active,
+		CreatedBy: &createdBy,
+		CreatedAt: &webhook.CreatedAt,
+		UpdatedAt: &webhook.UpdatedAt,
+	}
+}
+
+// Helper function to convert internal WebhookDeliveryLog model to API response
+func webhookDeliveryLogToAPIResponse(log *models.WebhookDeliveryLog) v1.WebhookDeliveryLog {
+	id := openapi_types.UUID(log.ID)
+	idDelivery := openapi_types.UUID(log.IDDelivery)
+	idWebhook := openapi_types.UUID(log.IDWebhook)
+
+	return v1.WebhookDeliveryLog{
+		Id:             &id,
+		IdDelivery:     &idDelivery,
+		IdWebhook:      &idWebhook,
+		Event:          &log.Event,
+		DeliveryStatus: &log.DeliveryStatus,
+		Attempt:        &log.Attempt,
+		StatusCode:     log.StatusCode,
+		Error:          log.Error,
+		DurationMs:     &log.DurationMs,
+		CreatedAt:      &log.CreatedAt,
+	}
+}
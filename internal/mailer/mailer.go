@@ -0,0 +1,129 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// Supported mailer drivers
+const (
+	DriverLog  = "log"
+	DriverFile = "file"
+)
+
+type Config struct {
+	Driver string `validate:"required,oneof=log file" yaml:"driver"`
+	From   string `validate:"required" yaml:"from"`
+	Dir    string `validate:"required_if=Driver file" yaml:"dir"` // output directory of the file driver
+}
+
+// Message is a plain text email, optionally with an HTML alternative
+type Message struct {
+	To       string
+	Subject  string
+	Body     string
+	HTMLBody string // sent next to Body as multipart/alternative when set
+}
+
+// Mailer sends emails. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New creates the mailer selected by the config driver
+func New(cfg Config) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverLog:
+		return NewLogMailer(cfg.From), nil
+	case DriverFile:
+		return NewFileMailer(cfg.From, cfg.Dir)
+	}
+	return nil, fmt.Errorf("unknown mailer driver: %s", cfg.Driver)
+}
+
+// LogMailer writes emails to the application log instead of sending them.
+// Bodies carry reset and verification links, so only the recipient and subject are logged,
+// use the file driver to read them.
+type LogMailer struct {
+	from string
+}
+
+// NewLogMailer creates a new log mailer
+func NewLogMailer(from string) *LogMailer {
+	return &LogMailer{from: from}
+}
+
+// Send logs the message without its body
+func (m *LogMailer) Send(_ context.Context, msg Message) error {
+	utils.Logger().WithField("from", m.from).
+		WithField("to", msg.To).
+		WithField("subject", msg.Subject).
+		WithField("html", msg.HTMLBody != "").
+		Info("Email not sent, log mailer only records it")
+	return nil
+}
+
+// FileMailer writes every email as an .eml file to a directory, for offline development and tests
+type FileMailer struct {
+	from string
+	dir  string
+}
+
+// NewFileMailer creates a new file mailer, creating the output directory if needed
+func NewFileMailer(from, dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("can't create mail directory: %w", err)
+	}
+	return &FileMailer{from: from, dir: dir}, nil
+}
+
+// Send writes the message to <dir>/<timestamp>-<id>.eml
+func (m *FileMailer) Send(_ context.Context, msg Message) error {
+	now := time.Now().UTC()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	if msg.HTMLBody == "" {
+		b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+		b.WriteString(msg.Body)
+	} else {
+		parts := multipart.NewWriter(&b)
+		fmt.Fprintf(&b, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", parts.Boundary())
+		// Clients show the last part they support, so HTML goes last
+		for _, part := range []struct{ contentType, body string }{
+			{"text/plain; charset=utf-8", msg.Body},
+			{"text/html; charset=utf-8", msg.HTMLBody},
+		} {
+			w, err := parts.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+			if err != nil {
+				return fmt.Errorf("can't write email: %w", err)
+			}
+			if _, err := io.WriteString(w, part.body); err != nil {
+				return fmt.Errorf("can't write email: %w", err)
+			}
+		}
+		if err := parts.Close(); err != nil {
+			return fmt.Errorf("can't write email: %w", err)
+		}
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000000000"), uuid.New())
+	if err := os.WriteFile(filepath.Join(m.dir, name), []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("can't write email: %w", err)
+	}
+	return nil
+}
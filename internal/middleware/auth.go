@@ -2,9 +2,11 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/tasks-control/core-back-end/internal/models"
 	"github.com/tasks-control/core-back-end/internal/service"
@@ -19,6 +21,8 @@ const (
 	UserContextKey ContextKey = "user"
 	// UserIDContextKey is the key for storing user ID in request context
 	UserIDContextKey ContextKey = "user_id"
+	// AccessTokenContextKey is the key for storing the personal access token used to authenticate, if any
+	AccessTokenContextKey ContextKey = "access_token"
 )
 
 // AuthMiddleware creates a middleware that validates JWT tokens
@@ -33,7 +37,7 @@ func NewAuthMiddleware(svc *service.Service) *AuthMiddleware {
 	}
 }
 
-// Authenticate is a middleware that validates JWT tokens and loads user info
+// Authenticate is a middleware that validates JWT tokens or personal access tokens and loads user info
 // It skips authentication for public endpoints (those with security: [] in OpenAPI spec)
 func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -42,6 +46,11 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 			"/auth/register",
 			"/auth/login",
 			"/auth/refresh",
+			"/auth/password/forgot",
+			"/auth/password/reset",
+			"/auth/verify-email",
+			"/auth/verify-email/resend",
+			"/auth/mfa/verify",
 			"/alive",
 		}
 
@@ -53,6 +62,12 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 			}
 		}
 
+		// Single sign-on start and callback are public for every provider
+		if strings.HasPrefix(routePath(r), "/auth/oidc/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		// Extract token from Authorization header
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
@@ -69,6 +84,12 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 
 		token := parts[1]
 
+		// Personal access tokens are opaque and checked against the database
+		if strings.HasPrefix(token, models.PersonalAccessTokenPrefix) {
+			m.authenticateAccessToken(w, r, next, token)
+			return
+		}
+
 		// Validate token
 		claims, err := m.service.JWTManager.ValidateAccessToken(token)
 		if err != nil {
@@ -88,11 +109,17 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 			return
 		}
 
-		if user == nil {
+		if user == nil || user.DeletedAt != nil {
 			utils.RespondError(w, http.StatusUnauthorized, "User not found")
 			return
 		}
 
+		// Reject tokens issued before the member's credentials changed
+		if user.TokensValidAfter != nil && (claims.IssuedAt == nil || claims.IssuedAt.Before(*user.TokensValidAfter)) {
+			utils.RespondError(w, http.StatusUnauthorized, "Token has been revoked")
+			return
+		}
+
 		// Add user info to context
 		ctx := context.WithValue(r.Context(), UserContextKey, user)
 		ctx = context.WithValue(ctx, UserIDContextKey, claims.UserID)
@@ -102,6 +129,81 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 	})
 }
 
+// authenticateAccessToken authenticates a request made with a personal access token and enforces its scopes
+func (m *AuthMiddleware) authenticateAccessToken(w http.ResponseWriter, r *http.Request, next http.Handler, plaintext string) {
+	accessToken, user, err := m.service.AuthenticatePersonalAccessToken(r.Context(), plaintext)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidAccessToken) {
+			utils.RespondError(w, http.StatusUnauthorized, "Invalid token")
+			return
+		}
+		utils.Logger().WithError(err).Error("Failed to authenticate personal access token")
+		utils.RespondError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	// Check that the token grants the scope required by the route
+	scope, allowed := requiredTokenScope(r)
+	if !allowed {
+		utils.RespondError(w, http.StatusForbidden, "This endpoint is not available with a personal access token")
+		return
+	}
+	if !accessToken.HasScope(scope) {
+		utils.RespondError(w, http.StatusForbidden, "Token is missing required scope: "+scope)
+		return
+	}
+
+	// Add user info to context
+	ctx := context.WithValue(r.Context(), UserContextKey, user)
+	ctx = context.WithValue(ctx, UserIDContextKey, user.ID)
+	ctx = context.WithValue(ctx, AccessTokenContextKey, accessToken)
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// requiredTokenScope returns the personal access token scope needed for a route.
+// Members and boards resources need read scope for GET and write scope otherwise, joining and starring boards
+// under /members/boards count as boards resources.
+// Token management, two-factor settings, account deletion, personal data exports and unknown routes can't be used with a personal access token at all.
+func requiredTokenScope(r *http.Request) (string, bool) {
+	path := routePath(r)
+	read := r.Method == http.MethodGet || r.Method == http.MethodHead
+
+	switch {
+	case strings.HasPrefix(path, "/members/me/tokens"), strings.HasPrefix(path, "/members/me/mfa"):
+		return "", false
+	case path == "/members/me" && r.Method == http.MethodDelete, strings.HasPrefix(path, "/members/me/export"):
+		return "", false
+	case strings.HasPrefix(path, "/members/boards/"), strings.HasPrefix(path, "/boards"), strings.HasPrefix(path, "/lists"), strings.HasPrefix(path, "/cards"):
+		if read {
+			return models.ScopeBoardsRead, true
+		}
+		return models.ScopeBoardsWrite, true
+	case strings.HasPrefix(path, "/members"):
+		if read {
+			return models.ScopeMembersRead, true
+		}
+		return models.ScopeMembersWrite, true
+	}
+
+	return "", false
+}
+
+// routePath returns the request path relative to the router the middleware is mounted on
+func routePath(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+		return rctx.RoutePath
+	}
+	return r.URL.Path
+}
+
+// GetAccessTokenFromContext extracts the personal access token from the request context.
+// It reports false when the request was authenticated with a JWT.
+func GetAccessTokenFromContext(ctx context.Context) (*models.PersonalAccessToken, bool) {
+	token, ok := ctx.Value(AccessTokenContextKey).(*models.PersonalAccessToken)
+	return token, ok
+}
+
 // GetUserFromContext extracts the user from the request context
 func GetUserFromContext(ctx context.Context) (*models.Member, bool) {
 	user, ok := ctx.Value(UserContextKey).(*models.Member)
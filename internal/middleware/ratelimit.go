@@ -0,0 +1,257 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tasks-control/core-back-end/internal/config"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/repository"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+const rateLimitSweepInterval = time.Minute
+
+// RateLimitStore keeps token buckets by key and takes a token for a request
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, capacity int, refillPerSecond float64, now time.Time) (*models.RateLimitBucket, bool, error)
+}
+
+// RateLimiter limits requests per route group with a token bucket for each user, or IP address when anonymous.
+// IP groups limit by IP address before authentication, so requests with invalid credentials are limited too.
+type RateLimiter struct {
+	store    RateLimitStore
+	groups   []rateLimitGroup
+	ipGroups []rateLimitGroup
+}
+
+type rateLimitGroup struct {
+	config.RateLimitGroup
+	segments []string
+}
+
+// NewRateLimiter creates a rate limiter with the store selected in the config
+func NewRateLimiter(cfg config.RateLimitConfig, repo repository.RateLimitRepository) *RateLimiter {
+	groups := newRateLimitGroups(cfg.Groups)
+	ipGroups := newRateLimitGroups(cfg.IPGroups)
+
+	longestPeriod := time.Duration(0)
+	for _, group := range append(cfg.Groups, cfg.IPGroups...) {
+		longestPeriod = max(longestPeriod, time.Duration(group.Period)*time.Second)
+	}
+
+	var store RateLimitStore
+	if cfg.Store == config.RateLimitStorePostgres {
+		store = NewPostgresRateLimitStore(repo, longestPeriod)
+	} else {
+		store = NewMemoryRateLimitStore(longestPeriod)
+	}
+
+	return &RateLimiter{
+		store:    store,
+		groups:   groups,
+		ipGroups: ipGroups,
+	}
+}
+
+// newRateLimitGroups returns the groups with the most specific prefix first
+func newRateLimitGroups(configs []config.RateLimitGroup) []rateLimitGroup {
+	groups := make([]rateLimitGroup, 0, len(configs))
+	for _, group := range configs {
+		groups = append(groups, rateLimitGroup{
+			RateLimitGroup: group,
+			segments:       pathSegments(group.PathPrefix),
+		})
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i].segments) > len(groups[j].segments)
+	})
+	return groups
+}
+
+// LimitByIP is a middleware that rejects requests over the limit of their IP group with 429.
+// It must run before Authenticate, which rejects bad credentials before Limit is reached.
+func (l *RateLimiter) LimitByIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		group, ok := match(l.ipGroups, routePath(r))
+		if !ok || l.take(w, r, "ip-group:"+group.Name+":ip:"+utils.ClientIP(r), group) {
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// Limit is a middleware that rejects requests over the limit of their route group with 429.
+// It must run after Authenticate to limit authenticated users by user ID.
+func (l *RateLimiter) Limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		group, ok := match(l.groups, routePath(r))
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Limit users across IP addresses, anonymous clients by IP address
+		subject := "ip:" + utils.ClientIP(r)
+		if userID, ok := GetUserIDFromContext(r.Context()); ok {
+			subject = "user:" + userID.String()
+		}
+
+		if l.take(w, r, group.Name+":"+subject, group) {
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// take takes a token from the bucket of key and sets the rate limit headers.
+// It responds with 429 and returns false when the bucket is empty.
+func (l *RateLimiter) take(w http.ResponseWriter, r *http.Request, key string, group rateLimitGroup) bool {
+	refillPerSecond := float64(group.Requests) / float64(group.Period)
+	bucket, allowed, err := l.store.Take(r.Context(), key, group.Requests, refillPerSecond, time.Now())
+	if err != nil {
+		// Don't turn a store outage into an API outage
+		utils.Logger().WithError(err).Warn("Failed to check rate limit")
+		return true
+	}
+
+	// Seconds until the bucket is full again, or until the next token when limited
+	reset := (float64(group.Requests) - bucket.Tokens) / refillPerSecond
+	if !allowed {
+		reset = (1 - bucket.Tokens) / refillPerSecond
+	}
+	resetSeconds := strconv.Itoa(int(math.Ceil(reset)))
+
+	w.Header().Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", group.Requests, group.Period))
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(group.Requests))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(int(math.Floor(bucket.Tokens))))
+	w.Header().Set("RateLimit-Reset", resetSeconds)
+
+	if !allowed {
+		w.Header().Set("Retry-After", resetSeconds)
+		utils.RespondError(w, http.StatusTooManyRequests, "Rate limit exceeded")
+		return false
+	}
+	return true
+}
+
+// match returns the group with the longest prefix matching the path.
+// Prefix segments in braces, like {idBoard}, match any single segment.
+func match(groups []rateLimitGroup, path string) (rateLimitGroup, bool) {
+	segments := pathSegments(path)
+	for _, group := range groups {
+		if len(group.segments) > len(segments) {
+			continue
+		}
+		matched := true
+		for i, segment := range group.segments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				continue
+			}
+			if segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return group, true
+		}
+	}
+	return rateLimitGroup{}, false
+}
+
+func pathSegments(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// MemoryRateLimitStore keeps buckets in process memory, limits are per instance
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*models.RateLimitBucket
+	idleAfter time.Duration
+	lastSweep time.Time
+}
+
+// NewMemoryRateLimitStore creates an in-memory store. Buckets idle for idleAfter are full again and dropped.
+func NewMemoryRateLimitStore(idleAfter time.Duration) *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets:   make(map[string]*models.RateLimitBucket),
+		idleAfter: idleAfter,
+		lastSweep: time.Now(),
+	}
+}
+
+// Take takes a token from the bucket of the key
+func (s *MemoryRateLimitStore) Take(_ context.Context, key string, capacity int, refillPerSecond float64, now time.Time) (*models.RateLimitBucket, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop idle buckets so memory doesn't grow with every client ever seen
+	if now.Sub(s.lastSweep) >= rateLimitSweepInterval {
+		for k, bucket := range s.buckets {
+			if now.Sub(bucket.UpdatedAt) >= s.idleAfter {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &models.RateLimitBucket{Key: key, Tokens: float64(capacity), UpdatedAt: now}
+		s.buckets[key] = bucket
+	}
+
+	allowed := bucket.Take(now, capacity, refillPerSecond)
+	result := *bucket
+	return &result, allowed, nil
+}
+
+// PostgresRateLimitStore keeps buckets in the database so all instances share the limits
+type PostgresRateLimitStore struct {
+	repo      repository.RateLimitRepository
+	idleAfter time.Duration
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewPostgresRateLimitStore creates a database backed store. Buckets idle for idleAfter are deleted.
+func NewPostgresRateLimitStore(repo repository.RateLimitRepository, idleAfter time.Duration) *PostgresRateLimitStore {
+	return &PostgresRateLimitStore{
+		repo:      repo,
+		idleAfter: idleAfter,
+		lastSweep: time.Now(),
+	}
+}
+
+// Take takes a token from the bucket of the key
+func (s *PostgresRateLimitStore) Take(ctx context.Context, key string, capacity int, refillPerSecond float64, now time.Time) (*models.RateLimitBucket, bool, error) {
+	s.sweep(ctx, now)
+	return s.repo.TakeRateLimitToken(ctx, key, capacity, refillPerSecond, now)
+}
+
+// sweep deletes idle buckets at most once per interval on this instance
+func (s *PostgresRateLimitStore) sweep(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	if err := s.repo.DeleteIdleRateLimitBuckets(ctx, now.Add(-s.idleAfter)); err != nil {
+		utils.Logger().WithError(err).Warn("Failed to delete idle rate limit buckets")
+	}
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,10 +15,12 @@ type Board struct {
 	Description     *string   `db:"description" json:"description,omitempty"`
 	PasswordHash    string    `db:"password_hash" json:"-"`
 	IDMemberCreator uuid.UUID `db:"id_member_creator" json:"idMemberCreator"`
+	WIPLimitMode    string    `db:"wip_limit_mode" json:"wipLimitMode"`
 	CreatedAt       time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt       time.Time `db:"updated_at" json:"updatedAt"`
 	Starred         *bool     `db:"starred" json:"starred,omitempty"`          // Only populated in list queries
 	MemberCount     *int      `db:"member_count" json:"memberCount,omitempty"` // Only populated in list queries
+	Watching        *bool     `db:"-" json:"watching,omitempty"`               // Only populated with board details
 }
 
 // BoardMember represents the relationship between a board and a member
@@ -29,6 +32,28 @@ type BoardMember struct {
 	JoinedAt time.Time `db:"joined_at" json:"joinedAt"`
 }
 
+// BoardMemberWithRole represents a board member together with their role in the board
+type BoardMemberWithRole struct {
+	Member
+	Role     string    `db:"role" json:"role"`
+	JoinedAt time.Time `db:"joined_at" json:"joinedAt"`
+}
+
+// MemberBoardMembership represents a board a member belongs to, from the member's side
+type MemberBoardMembership struct {
+	IDBoard         uuid.UUID `db:"id_board" json:"idBoard"`
+	BoardName       string    `db:"board_name" json:"boardName"`
+	NameBoardUnique string    `db:"name_board_unique" json:"nameBoardUnique"`
+	Role            string    `db:"role" json:"role"`
+	JoinedAt        time.Time `db:"joined_at" json:"joinedAt"`
+}
+
+// BoardOwnershipTransfer hands a board over to another member, used when its owner deletes their account
+type BoardOwnershipTransfer struct {
+	IDBoard  uuid.UUID `json:"idBoard"`
+	IDMember uuid.UUID `json:"idMember"`
+}
+
 // StarredBoard represents a starred board relationship
 type StarredBoard struct {
 	ID        uuid.UUID `db:"id" json:"id"`
@@ -44,21 +69,55 @@ type List struct {
 	IDBoard   uuid.UUID `db:"id_board" json:"idBoard"`
 	Position  float64   `db:"position" json:"position"`
 	Archived  bool      `db:"archived" json:"archived"`
+	WIPLimit  *int      `db:"wip_limit" json:"wipLimit,omitempty"` // Maximum number of active cards, unlimited when nil
 	CreatedAt time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
 }
 
 // Card represents a task card within a list
 type Card struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	Title       string    `db:"title" json:"title"`
-	Description *string   `db:"description" json:"description,omitempty"`
-	IDList      uuid.UUID `db:"id_list" json:"idList"`
-	Position    float64   `db:"position" json:"position"`
-	Archived    bool      `db:"archived" json:"archived"`
-	CreatedBy   uuid.UUID `db:"created_by" json:"createdBy"`
-	CreatedAt   time.Time `db:"created_at" json:"createdAt"`
-	UpdatedAt   time.Time `db:"updated_at" json:"updatedAt"`
+	ID           uuid.UUID               `db:"id" json:"id"`
+	Title        string                  `db:"title" json:"title"`
+	Description  *string                 `db:"description" json:"description,omitempty"`
+	IDList       uuid.UUID               `db:"id_list" json:"idList"`
+	Position     float64                 `db:"position" json:"position"`
+	Archived     bool                    `db:"archived" json:"archived"`
+	CreatedBy    uuid.UUID               `db:"created_by" json:"createdBy"`
+	CreatedAt    time.Time               `db:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time               `db:"updated_at" json:"updatedAt"`
+	Watching     *bool                   `db:"-" json:"watching,omitempty"`     // Only populated for a single card
+	CustomFields []*CardCustomFieldValue `db:"-" json:"customFields,omitempty"` // Only populated for a single card and exports
+}
+
+// Watch target constants, watching a board or list covers all of its cards
+const (
+	WatchTargetBoard = "board"
+	WatchTargetList  = "list"
+	WatchTargetCard  = "card"
+)
+
+// WIP limit modes of a board. Soft limits can be exceeded on request, hard limits never.
+const (
+	WIPLimitModeSoft = "soft"
+	WIPLimitModeHard = "hard"
+)
+
+// WIPLimitCheck asks a card write to enforce the WIP limit of the lists cards arrive in
+type WIPLimitCheck struct {
+	IgnoreSoftLimit bool // Soft limits may be exceeded, hard ones are still enforced
+}
+
+// WIPLimitExceeded is returned by card writes that would put a list over its WIP limit
+type WIPLimitExceeded struct {
+	IDList   uuid.UUID
+	ListName string
+	Limit    int
+	Count    int // Active cards in the list before the write
+	Mode     string
+}
+
+func (e *WIPLimitExceeded) Error() string {
+	return fmt.Sprintf("list %s is at its WIP limit of %d cards", e.IDList, e.Limit)
 }
 
 // BoardRole constants
@@ -67,3 +126,54 @@ const (
 	BoardRoleModerator = "moderator"
 	BoardRoleMember    = "member"
 )
+
+// Label represents a colored label defined on a board
+type Label struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	IDBoard   uuid.UUID `db:"id_board" json:"idBoard"`
+	Name      *string   `db:"name" json:"name,omitempty"`
+	Color     string    `db:"color" json:"color"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+// CardLabel represents a label assigned to a card
+type CardLabel struct {
+	IDCard  uuid.UUID `db:"id_card" json:"idCard"`
+	IDLabel uuid.UUID `db:"id_label" json:"idLabel"`
+}
+
+// BoardImport holds a complete board with its content to be inserted at once
+type BoardImport struct {
+	Board      *Board
+	Lists      []*List
+	Labels     []*Label
+	Cards      []*Card
+	CardLabels []CardLabel
+}
+
+// BulkCardOperation describes a single operation applied to many cards at once
+type BulkCardOperation struct {
+	Operation string
+	CardIDs   []uuid.UUID
+	IDMember  uuid.UUID  // Cards are only changed on boards of this member
+	IDBoard   *uuid.UUID // Board all cards must be on for move and assignLabel
+	IDList    uuid.UUID  // Target list for move
+	IDLabel   uuid.UUID  // Label for assignLabel
+	UpdatedAt time.Time
+	WIPLimit  *WIPLimitCheck // Checked for lists receiving cards by move or unarchive
+}
+
+// CardChange is a card changed by a bulk or list-wide operation, as it is after the change
+type CardChange struct {
+	Card
+	IDListBefore uuid.UUID `db:"id_list_before"`
+}
+
+// Bulk card operation constants
+const (
+	BulkCardOperationArchive     = "archive"
+	BulkCardOperationUnarchive   = "unarchive"
+	BulkCardOperationMove        = "move"
+	BulkCardOperationDelete      = "delete"
+	BulkCardOperationAssignLabel = "assignLabel"
+)
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomField represents a field defined on a board that every card of the board can have a value for
+type CustomField struct {
+	ID        uuid.UUID            `db:"id" json:"id"`
+	IDBoard   uuid.UUID            `db:"id_board" json:"idBoard"`
+	Name      string               `db:"name" json:"name"`
+	Type      string               `db:"type" json:"type"`
+	Position  float64              `db:"position" json:"position"`
+	Options   []*CustomFieldOption `db:"-" json:"options,omitempty"` // Only dropdown fields have options
+	CreatedAt time.Time            `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time            `db:"updated_at" json:"updatedAt"`
+}
+
+// CustomFieldOption represents a choice of a dropdown field
+type CustomFieldOption struct {
+	ID            uuid.UUID `db:"id" json:"id"`
+	IDCustomField uuid.UUID `db:"id_custom_field" json:"idCustomField"`
+	Value         string    `db:"value" json:"value"`
+	Position      float64   `db:"position" json:"position"`
+}
+
+// CardCustomFieldValue represents the value of a custom field on a card.
+// Exactly one value is set, the one matching the field type.
+type CardCustomFieldValue struct {
+	IDCard        uuid.UUID  `db:"id_card" json:"idCard"`
+	IDCustomField uuid.UUID  `db:"id_custom_field" json:"idCustomField"`
+	Text          *string    `db:"value_text" json:"text,omitempty"`
+	Number        *float64   `db:"value_number" json:"number,omitempty"`
+	Date          *time.Time `db:"value_date" json:"date,omitempty"`
+	Checked       *bool      `db:"value_checked" json:"checked,omitempty"`
+	IDOption      *uuid.UUID `db:"id_option" json:"idOption,omitempty"`
+	UpdatedAt     time.Time  `db:"updated_at" json:"updatedAt"`
+}
+
+// CustomField type constants
+const (
+	CustomFieldText     = "text"
+	CustomFieldNumber   = "number"
+	CustomFieldDate     = "date"
+	CustomFieldCheckbox = "checkbox"
+	CustomFieldDropdown = "dropdown"
+)
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemberDataExport represents a personal data export generated in the background and its archive
+type MemberDataExport struct {
+	ID          uuid.UUID  `db:"id" json:"id"`
+	IDMember    uuid.UUID  `db:"id_member" json:"idMember"`
+	Format      string     `db:"format" json:"format"`
+	Status      string     `db:"status" json:"status"`
+	FilePath    *string    `db:"file_path" json:"-"`
+	SizeBytes   *int64     `db:"size_bytes" json:"sizeBytes,omitempty"`
+	Error       *string    `db:"error" json:"-"`
+	StartedAt   *time.Time `db:"started_at" json:"startedAt,omitempty"`
+	CompletedAt *time.Time `db:"completed_at" json:"completedAt,omitempty"`
+	ExpiresAt   *time.Time `db:"expires_at" json:"expiresAt,omitempty"` // Archive is deleted afterwards, nil until ready
+	CreatedAt   time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// MemberDataExport status constants
+const (
+	DataExportStatusPending    = "pending"
+	DataExportStatusProcessing = "processing"
+	DataExportStatusReady      = "ready"
+	DataExportStatusFailed     = "failed"
+)
+
+// MemberDataExport format constants
+const (
+	DataExportFormatJSON = "json"
+	DataExportFormatZIP  = "zip"
+)
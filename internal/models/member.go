@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // Member represents a user in the system
@@ -15,6 +16,16 @@ type Member struct {
 	PasswordHash string    `db:"password_hash" json:"-"`
 	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
+
+	// TokensValidAfter rejects access tokens issued before it, nil until credentials change.
+	// iat has second precision, so it is rounded up to the next second after the change.
+	TokensValidAfter *time.Time `db:"tokens_valid_after" json:"-"`
+	// EmailVerifiedAt is nil until the current email is verified
+	EmailVerifiedAt *time.Time `db:"email_verified_at" json:"emailVerifiedAt,omitempty"`
+	// DeletedAt is set when the account was deleted, the row is kept anonymised for content it created
+	DeletedAt *time.Time `db:"deleted_at" json:"-"`
+	// AvatarID names the stored files of the current avatar, nil without an avatar
+	AvatarID *uuid.UUID `db:"avatar_id" json:"-"`
 }
 
 // RefreshToken represents a JWT refresh token stored in the database
@@ -26,3 +37,167 @@ type RefreshToken struct {
 	CreatedAt time.Time `db:"created_at" json:"createdAt"`
 	Revoked   bool      `db:"revoked" json:"revoked"`
 }
+
+// PasswordResetToken represents a one-time token sent by email to reset a forgotten password
+type PasswordResetToken struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	IDMember  uuid.UUID  `db:"id_member" json:"idMember"`
+	TokenHash string     `db:"token_hash" json:"-"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expiresAt"`
+	UsedAt    *time.Time `db:"used_at" json:"usedAt,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// EmailVerificationToken represents a one-time token sent to confirm a member owns an email address
+type EmailVerificationToken struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	IDMember  uuid.UUID  `db:"id_member" json:"idMember"`
+	Email     string     `db:"email" json:"email"` // Address the token was sent to, it no longer verifies after an email change
+	TokenHash string     `db:"token_hash" json:"-"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expiresAt"`
+	UsedAt    *time.Time `db:"used_at" json:"usedAt,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// MemberTOTP represents a member's TOTP second factor. It is pending until confirmed with a first valid code.
+type MemberTOTP struct {
+	IDMember     uuid.UUID  `db:"id_member" json:"idMember"`
+	Secret       string     `db:"secret" json:"-"` // Base32 shared secret, needed in plaintext to compute codes
+	ConfirmedAt  *time.Time `db:"confirmed_at" json:"confirmedAt,omitempty"`
+	LastUsedStep int64      `db:"last_used_step" json:"-"` // Time step of the last accepted code, older or equal steps are replays
+	CreatedAt    time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// Enabled reports whether the second factor is required at login
+func (t *MemberTOTP) Enabled() bool {
+	return t != nil && t.ConfirmedAt != nil
+}
+
+// RecoveryCode represents a single-use code that replaces a TOTP code when the authenticator is lost
+type RecoveryCode struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	IDMember  uuid.UUID  `db:"id_member" json:"idMember"`
+	CodeHash  string     `db:"code_hash" json:"-"`
+	UsedAt    *time.Time `db:"used_at" json:"usedAt,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// MFAChallenge represents a login that passed the password check and waits for the second factor
+type MFAChallenge struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	IDMember  uuid.UUID  `db:"id_member" json:"idMember"`
+	TokenHash string     `db:"token_hash" json:"-"`
+	Attempts  int        `db:"attempts" json:"attempts"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expiresAt"`
+	UsedAt    *time.Time `db:"used_at" json:"usedAt,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// MemberIdentity links a member to an account at an external OIDC provider
+type MemberIdentity struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	IDMember  uuid.UUID `db:"id_member" json:"idMember"`
+	Provider  string    `db:"provider" json:"provider"`
+	Subject   string    `db:"subject" json:"subject"` // Stable user ID at the provider, the sub claim
+	Email     *string   `db:"email" json:"email,omitempty"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+// OIDCLoginState represents a login started at an OIDC provider, waiting for the callback
+type OIDCLoginState struct {
+	ID           uuid.UUID `db:"id" json:"id"`
+	Provider     string    `db:"provider" json:"provider"`
+	StateHash    string    `db:"state_hash" json:"-"`
+	Nonce        string    `db:"nonce" json:"-"`
+	CodeVerifier string    `db:"code_verifier" json:"-"` // PKCE verifier, only the challenge was sent to the provider
+	ExpiresAt    time.Time `db:"expires_at" json:"expiresAt"`
+	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
+}
+
+// PersonalAccessToken represents a long-lived API token created by a member for scripts and integrations
+type PersonalAccessToken struct {
+	ID          uuid.UUID      `db:"id" json:"id"`
+	IDMember    uuid.UUID      `db:"id_member" json:"idMember"`
+	Name        string         `db:"name" json:"name"`
+	TokenHash   string         `db:"token_hash" json:"-"`
+	TokenPrefix string         `db:"token_prefix" json:"tokenPrefix"` // First characters of the token, safe to display
+	Scopes      pq.StringArray `db:"scopes" json:"scopes"`
+	ExpiresAt   *time.Time     `db:"expires_at" json:"expiresAt,omitempty"` // Nil means the token never expires
+	LastUsedAt  *time.Time     `db:"last_used_at" json:"lastUsedAt,omitempty"`
+	CreatedAt   time.Time      `db:"created_at" json:"createdAt"`
+}
+
+// HasScope reports whether the token grants the scope. A write scope implies read access to the same resource.
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	for _, granted := range t.Scopes {
+		if granted == scope {
+			return true
+		}
+		if scope == ScopeBoardsRead && granted == ScopeBoardsWrite {
+			return true
+		}
+		if scope == ScopeMembersRead && granted == ScopeMembersWrite {
+			return true
+		}
+	}
+	return false
+}
+
+// PersonalAccessTokenPrefix starts every personal access token so it can be told apart from a JWT
+const PersonalAccessTokenPrefix = "tcp_"
+
+// Personal access token scope constants
+const (
+	ScopeBoardsRead   = "boards:read"
+	ScopeBoardsWrite  = "boards:write"
+	ScopeMembersRead  = "members:read"
+	ScopeMembersWrite = "members:write"
+)
+
+// SecurityEvent represents an entry of a member's account security history
+type SecurityEvent struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	IDMember  uuid.UUID `db:"id_member" json:"idMember"`
+	EventType string    `db:"event_type" json:"eventType"`
+	IPAddress *string   `db:"ip_address" json:"ipAddress,omitempty"`
+	UserAgent *string   `db:"user_agent" json:"userAgent,omitempty"`
+	Metadata  []byte    `db:"metadata" json:"metadata"` // JSON object with event specific details
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+// SecurityEvent type constants
+const (
+	SecurityEventEmailChanged    = "email_changed"
+	SecurityEventPasswordChanged = "password_changed"
+	SecurityEventPasswordReset   = "password_reset"
+	SecurityEventMFAEnabled      = "mfa_enabled"
+	SecurityEventMFADisabled     = "mfa_disabled"
+	SecurityEventRecoveryCodes   = "recovery_codes_regenerated"
+	SecurityEventRecoveryUsed    = "recovery_code_used"
+	SecurityEventIdentityLinked  = "identity_linked"
+)
+
+// AuthFailure counts recent failed password attempts for an account or IP address and its lockout
+type AuthFailure struct {
+	Scope         string     `db:"scope" json:"scope"`
+	Key           string     `db:"key" json:"key"`
+	Failures      int        `db:"failures" json:"failures"`
+	LastFailureAt time.Time  `db:"last_failure_at" json:"lastFailureAt"`
+	LockedUntil   *time.Time `db:"locked_until" json:"lockedUntil,omitempty"`
+}
+
+// LockoutPolicy decides when failed attempts lock an account or IP address and for how long
+type LockoutPolicy struct {
+	MaxFailures  int
+	BaseDuration time.Duration // doubled for every failure past MaxFailures
+	MaxDuration  time.Duration
+}
+
+// LockDuration returns the lockout after the given number of failures
+func (p LockoutPolicy) LockDuration(failures int) time.Duration {
+	duration := p.BaseDuration
+	for i := p.MaxFailures; i < failures && duration < p.MaxDuration; i++ {
+		duration *= 2
+	}
+	return min(duration, p.MaxDuration)
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification represents an entry of a member's in-app notification inbox
+type Notification struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	IDMember  uuid.UUID  `db:"id_member" json:"idMember"` // Recipient
+	Type      string     `db:"type" json:"type"`
+	IDBoard   *uuid.UUID `db:"id_board" json:"idBoard,omitempty"`
+	IDCard    *uuid.UUID `db:"id_card" json:"idCard,omitempty"`
+	IDActor   *uuid.UUID `db:"id_actor" json:"idActor,omitempty"` // Member who caused the notification
+	Data      []byte     `db:"data" json:"-"`                     // JSON object with type specific details such as the card title
+	ReadAt    *time.Time `db:"read_at" json:"readAt,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// Notification type constants
+const (
+	NotificationBoardMemberJoined  = "board.member_joined"
+	NotificationBoardMemberLeft    = "board.member_left"
+	NotificationBoardMemberRemoved = "board.member_removed"
+	NotificationCardCreated        = "card.created"
+	NotificationCardUpdated        = "card.updated"
+	NotificationCardMoved          = "card.moved"
+	NotificationCardArchived       = "card.archived"
+	NotificationCardUnarchived     = "card.unarchived"
+	NotificationCardDeleted        = "card.deleted"
+	NotificationCardMentioned      = "card.mentioned"
+)
+
+// DigestRecipient is a member with unread notifications no email digest covered yet
+type DigestRecipient struct {
+	IDMember     uuid.UUID  `db:"id_member"`
+	Email        string     `db:"email"`
+	Username     string     `db:"username"`
+	LastDigestAt *time.Time `db:"last_digest_at"` // Nil before the first digest
+}
+
+// DigestNotification is a notification with the names an email digest shows
+type DigestNotification struct {
+	Notification
+	BoardName     *string `db:"board_name"`
+	ActorUsername *string `db:"actor_username"`
+}
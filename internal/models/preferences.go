@@ -0,0 +1,48 @@
+package models
+
+// MemberPreferences represents the settings of a member, stored as a JSON document.
+// Fields missing from the stored document keep their defaults, so new settings need no migration.
+type MemberPreferences struct {
+	Locale           string                  `json:"locale"`   // BCP 47 language tag
+	Timezone         string                  `json:"timezone"` // IANA time zone name
+	DefaultBoardSort string                  `json:"defaultBoardSort"`
+	EmailDigest      string                  `json:"emailDigest"` // how often unread notifications are emailed
+	Notifications    NotificationPreferences `json:"notifications"`
+}
+
+// NotificationPreferences selects which events create notifications for a member
+type NotificationPreferences struct {
+	BoardMembers bool `json:"boardMembers"` // members joining or leaving a board
+	CardChanges  bool `json:"cardChanges"`  // cards created, moved, updated or archived
+	Mentions     bool `json:"mentions"`     // @username in a card title or description
+}
+
+// DefaultMemberPreferences returns the settings of a member who never changed any
+func DefaultMemberPreferences() *MemberPreferences {
+	return &MemberPreferences{
+		Locale:           "en",
+		Timezone:         "UTC",
+		DefaultBoardSort: BoardSortUpdated,
+		EmailDigest:      EmailDigestDaily,
+		Notifications: NotificationPreferences{
+			BoardMembers: true,
+			CardChanges:  true,
+			Mentions:     true,
+		},
+	}
+}
+
+// Board list sort constants
+const (
+	BoardSortUpdated = "updated"
+	BoardSortName    = "name"
+	BoardSortCreated = "created"
+	BoardSortJoined  = "joined"
+)
+
+// Email digest frequency constants
+const (
+	EmailDigestOff    = "off"
+	EmailDigestDaily  = "daily"
+	EmailDigestWeekly = "weekly"
+)
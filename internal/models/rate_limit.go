@@ -0,0 +1,28 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimitBucket is the state of a token bucket. Tokens refill continuously up to the bucket capacity
+// and every request takes one.
+type RateLimitBucket struct {
+	Key       string    `db:"key" json:"key"`
+	Tokens    float64   `db:"tokens" json:"tokens"`
+	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+// Take refills the bucket for the time elapsed since the last update and takes a token if one is available
+func (b *RateLimitBucket) Take(now time.Time, capacity int, refillPerSecond float64) bool {
+	if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(float64(capacity), b.Tokens+elapsed*refillPerSecond)
+		b.UpdatedAt = now
+	}
+
+	if b.Tokens < 1 {
+		return false
+	}
+	b.Tokens--
+	return true
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Webhook represents an outgoing webhook subscription of a board
+type Webhook struct {
+	ID        uuid.UUID      `db:"id" json:"id"`
+	IDBoard   uuid.UUID      `db:"id_board" json:"idBoard"`
+	URL       string         `db:"url" json:"url"`
+	Secret    string         `db:"secret" json:"-"`
+	Events    pq.StringArray `db:"events" json:"events"` // Empty means all events
+	Active    bool           `db:"active" json:"active"`
+	CreatedBy uuid.UUID      `db:"created_by" json:"createdBy"`
+	CreatedAt time.Time      `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time      `db:"updated_at" json:"updatedAt"`
+}
+
+// WebhookDelivery represents a queued webhook event waiting to be delivered
+type WebhookDelivery struct {
+	ID            uuid.UUID  `db:"id" json:"id"`
+	IDWebhook     uuid.UUID  `db:"id_webhook" json:"idWebhook"`
+	Event         string     `db:"event" json:"event"`
+	Payload       []byte     `db:"payload" json:"-"`
+	Status        string     `db:"status" json:"status"`
+	Attempts      int        `db:"attempts" json:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at" json:"nextAttemptAt"`
+	LastError     *string    `db:"last_error" json:"lastError,omitempty"`
+	DeliveredAt   *time.Time `db:"delivered_at" json:"deliveredAt,omitempty"`
+	CreatedAt     time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time  `db:"updated_at" json:"updatedAt"`
+}
+
+// WebhookDeliveryLog represents a single delivery attempt
+type WebhookDeliveryLog struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	IDDelivery     uuid.UUID `db:"id_delivery" json:"idDelivery"`
+	IDWebhook      uuid.UUID `db:"id_webhook" json:"idWebhook"`
+	Event          string    `db:"event" json:"event"`                    // Only populated in list queries
+	DeliveryStatus string    `db:"delivery_status" json:"deliveryStatus"` // Only populated in list queries
+	Attempt        int       `db:"attempt" json:"attempt"`
+	StatusCode     *int      `db:"status_code" json:"statusCode,omitempty"`
+	Error          *string   `db:"error" json:"error,omitempty"`
+	DurationMs     int       `db:"duration_ms" json:"durationMs"`
+	CreatedAt      time.Time `db:"created_at" json:"createdAt"`
+}
+
+// WebhookDelivery status constants
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryDead      = "dead"
+)
+
+// Webhook event constants
+const (
+	WebhookEventCardCreated       = "card.created"
+	WebhookEventCardUpdated       = "card.updated"
+	WebhookEventCardMoved         = "card.moved"
+	WebhookEventCardArchived      = "card.archived"
+	WebhookEventCardUnarchived    = "card.unarchived"
+	WebhookEventCardDeleted       = "card.deleted"
+	WebhookEventCardsBulk         = "cards.bulk"
+	WebhookEventListCardsMoved    = "list.cards_moved"
+	WebhookEventListCardsArchived = "list.cards_archived"
+	WebhookEventTest              = "webhook.test"
+)
@@ -0,0 +1,369 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown OIDC provider")
+	ErrInvalidIDToken  = errors.New("invalid ID token")
+)
+
+const (
+	httpTimeout          = 10 * time.Second
+	metadataCacheTTL     = time.Hour
+	keysMinRefreshPeriod = time.Minute
+	maxResponseBytes     = 1 << 20
+	codeVerifierBytes    = 32
+)
+
+type Config struct {
+	StateTTL  int              `validate:"omitempty,min=60" yaml:"stateTTL"` // seconds to complete a login at the provider
+	Providers []ProviderConfig `validate:"dive" yaml:"providers"`
+}
+
+type ProviderConfig struct {
+	Name            string   `validate:"required,alphanum" yaml:"name"` // used in /auth/oidc/{provider}/...
+	Issuer          string   `validate:"required,url" yaml:"issuer"`
+	ClientID        string   `validate:"required" yaml:"clientId"`
+	ClientSecretEnv string   `yaml:"clientSecretEnv"`                     // empty for public clients relying on PKCE only
+	RedirectURL     string   `validate:"required,url" yaml:"redirectUrl"` // registered at the provider, forwards code and state to the callback
+	Scopes          []string `yaml:"scopes"`                              // openid, email and profile by default
+	AllowSignup     bool     `yaml:"allowSignup"`                         // create accounts for emails without one
+}
+
+// IDTokenClaims are the ID token claims used to find or create the member
+type IDTokenClaims struct {
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	jwt.RegisteredClaims
+}
+
+// Client holds the configured providers
+type Client struct {
+	providers map[string]*Provider
+}
+
+// New creates a client for the configured providers. Provider metadata is discovered on first use.
+func New(cfg Config) (*Client, error) {
+	client := &Client{providers: make(map[string]*Provider, len(cfg.Providers))}
+	httpClient := &http.Client{Timeout: httpTimeout}
+
+	for _, providerCfg := range cfg.Providers {
+		if _, ok := client.providers[providerCfg.Name]; ok {
+			return nil, fmt.Errorf("duplicated OIDC provider %q", providerCfg.Name)
+		}
+
+		var secret string
+		if providerCfg.ClientSecretEnv != "" {
+			secret = os.Getenv(providerCfg.ClientSecretEnv)
+			if secret == "" {
+				return nil, fmt.Errorf("OIDC client secret not found in environment variable: %s", providerCfg.ClientSecretEnv)
+			}
+		}
+		if len(providerCfg.Scopes) == 0 {
+			providerCfg.Scopes = []string{"openid", "email", "profile"}
+		}
+
+		client.providers[providerCfg.Name] = &Provider{
+			cfg:          providerCfg,
+			clientSecret: secret,
+			httpClient:   httpClient,
+		}
+	}
+
+	return client, nil
+}
+
+// Provider returns a configured provider by name
+func (c *Client) Provider(name string) (*Provider, error) {
+	provider, ok := c.providers[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return provider, nil
+}
+
+// Provider is an OpenID Connect identity provider using the authorization code flow with PKCE
+type Provider struct {
+	cfg          ProviderConfig
+	clientSecret string
+	httpClient   *http.Client
+
+	mu                sync.Mutex
+	metadata          *providerMetadata
+	metadataFetchedAt time.Time
+	keys              map[string]crypto.PublicKey
+	keysFetchedAt     time.Time
+}
+
+// providerMetadata is the part of the discovery document we use
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Name returns the provider name from the config
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// AllowSignup reports whether unknown users get an account
+func (p *Provider) AllowSignup() bool {
+	return p.cfg.AllowSignup
+}
+
+// AuthCodeURL returns the provider URL starting a login
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.cfg.ClientID)
+	params.Set("redirect_uri", p.cfg.RedirectURL)
+	params.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", CodeChallenge(codeVerifier))
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return metadata.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// Exchange redeems an authorization code and returns the raw ID token
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	if p.clientSecret == "" {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("can't create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.clientSecret))
+	}
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.doJSON(req, &token)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned %d: %s %s", status, token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return "", errors.New("token response has no id_token")
+	}
+
+	return token.IDToken, nil
+}
+
+// VerifyIDToken checks the signature, issuer, audience, expiry and nonce of an ID token
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*IDTokenClaims, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &IDTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.publicKey(ctx, metadata.JWKSURI, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "EdDSA"}),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+// discover fetches and caches the provider discovery document.
+// The lock isn't held during the request, so a slow provider doesn't block logins using the cache.
+func (p *Provider) discover(ctx context.Context) (*providerMetadata, error) {
+	p.mu.Lock()
+	cached, fetchedAt := p.metadata, p.metadataFetchedAt
+	p.mu.Unlock()
+
+	if cached != nil && time.Since(fetchedAt) < metadataCacheTTL {
+		return cached, nil
+	}
+
+	issuer := strings.TrimSuffix(p.cfg.Issuer, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't create discovery request: %w", err)
+	}
+
+	var metadata providerMetadata
+	status, err := p.doJSON(req, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("discovery failed: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("discovery returned %d", status)
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery issuer %q doesn't match configured issuer %q", metadata.Issuer, p.cfg.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing endpoints")
+	}
+
+	p.mu.Lock()
+	p.metadata = &metadata
+	p.metadataFetchedAt = time.Now()
+	p.mu.Unlock()
+	return &metadata, nil
+}
+
+// publicKey returns the provider signing key by kid, refetching the key set for unknown kids after rotation
+func (p *Provider) publicKey(ctx context.Context, jwksURI, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	key, ok := p.lookupKey(kid)
+	recentlyFetched := time.Since(p.keysFetchedAt) < keysMinRefreshPeriod
+	p.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+	if recentlyFetched {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't create JWKS request: %w", err)
+	}
+	var set utils.JWKS
+	status, err := p.doJSON(req, &set)
+	if err != nil {
+		return nil, fmt.Errorf("JWKS request failed: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned %d", status)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		publicKey, err := jwk.PublicKey()
+		if err != nil {
+			// Skip key types we don't support, the provider may publish several
+			continue
+		}
+		keys[jwk.KeyID] = publicKey
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey finds a cached key, the caller holds p.mu. Tokens without kid are accepted when the provider has a single key.
+func (p *Provider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) doJSON(req *http.Request, target interface{}) (int, error) {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if err := json.Unmarshal(body, target); err != nil && resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("can't decode response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// GenerateCodeVerifier returns a random PKCE code verifier (RFC 7636)
+func GenerateCodeVerifier() (string, error) {
+	buf := make([]byte, codeVerifierBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallenge returns the S256 PKCE challenge of a verifier
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// CountAuthAttempt counts an attempt of an account or IP address as failed before it is verified,
+// so concurrent attempts can't all pass the limit. Reaching the policy limit locks the key.
+// Nothing is counted while the key is locked, the returned bool reports whether the attempt was counted.
+// The count starts over when the previous failure is older than resetBefore.
+func (r *repository) CountAuthAttempt(ctx context.Context, scope, key string, attemptAt, resetBefore time.Time, policy models.LockoutPolicy) (*models.AuthFailure, bool, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO auth_failures (scope, key, failures, last_failure_at)
+		VALUES ($1, $2, 0, $3)
+		ON CONFLICT (scope, key) DO NOTHING
+	`, scope, key, attemptAt)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create auth failure: %w", err)
+	}
+
+	// Lock the row, concurrent attempts of the key are counted one after the other
+	var failure models.AuthFailure
+	err = tx.GetContext(ctx, &failure, `
+		SELECT scope, key, failures, last_failure_at, locked_until
+		FROM auth_failures
+		WHERE scope = $1 AND key = $2
+		FOR UPDATE
+	`, scope, key)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get auth failure: %w", err)
+	}
+
+	if failure.LockedUntil != nil && failure.LockedUntil.After(attemptAt) {
+		return &failure, false, nil
+	}
+
+	if failure.LastFailureAt.Before(resetBefore) {
+		failure.Failures = 0
+		failure.LockedUntil = nil
+	}
+	failure.Failures++
+	failure.LastFailureAt = attemptAt
+	if failure.Failures >= policy.MaxFailures {
+		lockedUntil := attemptAt.Add(policy.LockDuration(failure.Failures))
+		failure.LockedUntil = &lockedUntil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE auth_failures SET failures = $3, last_failure_at = $4, locked_until = $5
+		WHERE scope = $1 AND key = $2
+	`, scope, key, failure.Failures, failure.LastFailureAt, failure.LockedUntil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to record auth failure: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return &failure, true, nil
+}
+
+// ReleaseAuthAttempt takes back an attempt counted by CountAuthAttempt that turned out successful.
+// The lock is lifted when the remaining failures are below maxFailures.
+func (r *repository) ReleaseAuthAttempt(ctx context.Context, scope, key string, maxFailures int) error {
+	query := `
+		UPDATE auth_failures
+		SET failures = GREATEST(failures - 1, 0),
+			locked_until = CASE WHEN failures - 1 < $3 THEN NULL ELSE locked_until END
+		WHERE scope = $1 AND key = $2
+	`
+	_, err := r.conn.ExecContext(ctx, query, scope, key, maxFailures)
+	if err != nil {
+		return fmt.Errorf("failed to release auth attempt: %w", err)
+	}
+	return nil
+}
+
+// ClearAuthFailure forgets the failed attempts of an account or IP address
+func (r *repository) ClearAuthFailure(ctx context.Context, scope, key string) error {
+	query := `DELETE FROM auth_failures WHERE scope = $1 AND key = $2`
+	_, err := r.conn.ExecContext(ctx, query, scope, key)
+	if err != nil {
+		return fmt.Errorf("failed to clear auth failure: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredAuthFailures deletes up to limit failure counts without failures since resetBefore
+// whose lockout ended before now, and returns how many were deleted
+func (r *repository) DeleteExpiredAuthFailures(ctx context.Context, resetBefore, now time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM auth_failures
+		WHERE (scope, key) IN (
+			SELECT scope, key
+			FROM auth_failures
+			WHERE last_failure_at < $1 AND (locked_until IS NULL OR locked_until < $2)
+			LIMIT $3
+		)
+	`
+	result, err := r.conn.ExecContext(ctx, query, resetBefore, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired auth failures: %w", err)
+	}
+	return result.RowsAffected()
+}
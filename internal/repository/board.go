@@ -21,8 +21,8 @@ func (r *repository) CreateBoard(ctx context.Context, board *models.Board) error
 
 	// Insert board
 	query := `
-		INSERT INTO boards (id, name, name_board_unique, description, password_hash, id_member_creator, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO boards (id, name, name_board_unique, description, password_hash, id_member_creator, wip_limit_mode, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 	_, err = tx.ExecContext(ctx, query,
 		board.ID,
@@ -31,6 +31,7 @@ func (r *repository) CreateBoard(ctx context.Context, board *models.Board) error
 		board.Description,
 		board.PasswordHash,
 		board.IDMemberCreator,
+		board.WIPLimitMode,
 		board.CreatedAt,
 		board.UpdatedAt,
 	)
@@ -61,7 +62,7 @@ func (r *repository) CreateBoard(ctx context.Context, board *models.Board) error
 func (r *repository) GetBoardByID(ctx context.Context, boardID uuid.UUID) (*models.Board, error) {
 	var board models.Board
 	query := `
-		SELECT id, name, name_board_unique, description, password_hash, id_member_creator, created_at, updated_at
+		SELECT id, name, name_board_unique, description, password_hash, id_member_creator, wip_limit_mode, created_at, updated_at
 		FROM boards
 		WHERE id = $1
 	`
@@ -79,7 +80,7 @@ func (r *repository) GetBoardByID(ctx context.Context, boardID uuid.UUID) (*mode
 func (r *repository) GetBoardByUniqueName(ctx context.Context, uniqueName string) (*models.Board, error) {
 	var board models.Board
 	query := `
-		SELECT id, name, name_board_unique, description, password_hash, id_member_creator, created_at, updated_at
+		SELECT id, name, name_board_unique, description, password_hash, id_member_creator, wip_limit_mode, created_at, updated_at
 		FROM boards
 		WHERE name_board_unique = $1
 	`
@@ -93,8 +94,16 @@ func (r *repository) GetBoardByUniqueName(ctx context.Context, uniqueName string
 	return &board, nil
 }
 
-// GetBoardsByMemberID retrieves all boards a member belongs to with pagination
-func (r *repository) GetBoardsByMemberID(ctx context.Context, memberID uuid.UUID, starredOnly bool, limit, offset int) ([]*models.Board, int, error) {
+// boardSortOrders maps the board list sort preferences to their ORDER BY clause
+var boardSortOrders = map[string]string{
+	models.BoardSortUpdated: "b.updated_at DESC",
+	models.BoardSortName:    "LOWER(b.name) ASC, b.created_at ASC",
+	models.BoardSortCreated: "b.created_at DESC",
+	models.BoardSortJoined:  "bm.joined_at DESC",
+}
+
+// GetBoardsByMemberID retrieves all boards a member belongs to with pagination, in the sort order
+func (r *repository) GetBoardsByMemberID(ctx context.Context, memberID uuid.UUID, starredOnly bool, sort string, limit, offset int) ([]*models.Board, int, error) {
 	boards := []*models.Board{}
 
 	// Build query based on filters
@@ -106,6 +115,7 @@ func (r *repository) GetBoardsByMemberID(ctx context.Context, memberID uuid.UUID
 			b.description, 
 			b.password_hash,
 			b.id_member_creator, 
+			b.wip_limit_mode,
 			b.created_at, 
 			b.updated_at,
 			EXISTS(SELECT 1 FROM starred_boards sb WHERE sb.id_board = b.id AND sb.id_member = $1) as starred,
@@ -126,7 +136,12 @@ func (r *repository) GetBoardsByMemberID(ctx context.Context, memberID uuid.UUID
 		whereClause += " AND EXISTS(SELECT 1 FROM starred_boards sb WHERE sb.id_board = b.id AND sb.id_member = $1)"
 	}
 
-	query += whereClause + " ORDER BY b.updated_at DESC LIMIT $2 OFFSET $3"
+	orderBy, ok := boardSortOrders[sort]
+	if !ok {
+		orderBy = boardSortOrders[models.BoardSortUpdated]
+	}
+
+	query += whereClause + " ORDER BY " + orderBy + " LIMIT $2 OFFSET $3"
 	countQuery += whereClause
 
 	// Get total count
@@ -145,11 +160,38 @@ func (r *repository) GetBoardsByMemberID(ctx context.Context, memberID uuid.UUID
 	return boards, total, nil
 }
 
+// GetOwnedBoards retrieves the boards a member owns with their member count
+func (r *repository) GetOwnedBoards(ctx context.Context, memberID uuid.UUID) ([]*models.Board, error) {
+	boards := []*models.Board{}
+	query := `
+		SELECT
+			b.id,
+			b.name,
+			b.name_board_unique,
+			b.description,
+			b.password_hash,
+			b.id_member_creator,
+			b.wip_limit_mode,
+			b.created_at,
+			b.updated_at,
+			(SELECT COUNT(*) FROM board_members WHERE id_board = b.id) as member_count
+		FROM boards b
+		INNER JOIN board_members bm ON b.id = bm.id_board
+		WHERE bm.id_member = $1 AND bm.role = $2
+		ORDER BY b.name
+	`
+	err := r.conn.SelectContext(ctx, &boards, query, memberID, models.BoardRoleOwner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owned boards: %w", err)
+	}
+	return boards, nil
+}
+
 // UpdateBoard updates an existing board
 func (r *repository) UpdateBoard(ctx context.Context, board *models.Board) error {
 	query := `
 		UPDATE boards
-		SET name = $2, name_board_unique = $3, description = $4, password_hash = $5, updated_at = $6
+		SET name = $2, name_board_unique = $3, description = $4, password_hash = $5, wip_limit_mode = $6, updated_at = $7
 		WHERE id = $1
 	`
 	result, err := r.conn.ExecContext(ctx, query,
@@ -158,6 +200,7 @@ func (r *repository) UpdateBoard(ctx context.Context, board *models.Board) error
 		board.NameBoardUnique,
 		board.Description,
 		board.PasswordHash,
+		board.WIPLimitMode,
 		board.UpdatedAt,
 	)
 	if err != nil {
@@ -257,7 +300,7 @@ func (r *repository) RemoveBoardMember(ctx context.Context, boardID, memberID uu
 func (r *repository) GetBoardMembers(ctx context.Context, boardID uuid.UUID) ([]*models.Member, error) {
 	members := []*models.Member{}
 	query := `
-		SELECT m.id, m.email, m.username, m.full_name, m.password_hash, m.created_at, m.updated_at
+		SELECT m.id, m.email, m.username, m.full_name, m.password_hash, m.created_at, m.updated_at, m.avatar_id
 		FROM members m
 		INNER JOIN board_members bm ON m.id = bm.id_member
 		WHERE bm.id_board = $1
@@ -309,7 +352,7 @@ func (r *repository) IsStarred(ctx context.Context, boardID, memberID uuid.UUID)
 func (r *repository) GetBoardLists(ctx context.Context, boardID uuid.UUID) ([]*models.List, error) {
 	lists := []*models.List{}
 	query := `
-		SELECT id, name, id_board, position, archived, created_at, updated_at
+		SELECT id, name, id_board, position, archived, wip_limit, created_at, updated_at
 		FROM lists
 		WHERE id_board = $1 AND archived = false
 		ORDER BY position ASC
@@ -320,3 +363,159 @@ func (r *repository) GetBoardLists(ctx context.Context, boardID uuid.UUID) ([]*m
 	}
 	return lists, nil
 }
+
+// GetAllBoardLists retrieves all lists for a board, including archived ones
+func (r *repository) GetAllBoardLists(ctx context.Context, boardID uuid.UUID) ([]*models.List, error) {
+	lists := []*models.List{}
+	query := `
+		SELECT id, name, id_board, position, archived, wip_limit, created_at, updated_at
+		FROM lists
+		WHERE id_board = $1
+		ORDER BY position ASC
+	`
+	err := r.conn.SelectContext(ctx, &lists, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all board lists: %w", err)
+	}
+	return lists, nil
+}
+
+// GetBoardMembersWithRoles retrieves all members of a board along with their roles
+func (r *repository) GetBoardMembersWithRoles(ctx context.Context, boardID uuid.UUID) ([]*models.BoardMemberWithRole, error) {
+	members := []*models.BoardMemberWithRole{}
+	query := `
+		SELECT m.id, m.email, m.username, m.full_name, m.password_hash, m.created_at, m.updated_at, m.avatar_id, bm.role, bm.joined_at
+		FROM members m
+		INNER JOIN board_members bm ON m.id = bm.id_member
+		WHERE bm.id_board = $1
+		ORDER BY bm.joined_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &members, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board members with roles: %w", err)
+	}
+	return members, nil
+}
+
+// ImportBoard inserts a board with its creator as owner, lists, labels and cards in one transaction
+func (r *repository) ImportBoard(ctx context.Context, data *models.BoardImport) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	board := data.Board
+
+	// Insert board
+	query := `
+		INSERT INTO boards (id, name, name_board_unique, description, password_hash, id_member_creator, wip_limit_mode, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		board.ID,
+		board.Name,
+		board.NameBoardUnique,
+		board.Description,
+		board.PasswordHash,
+		board.IDMemberCreator,
+		board.WIPLimitMode,
+		board.CreatedAt,
+		board.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create board: %w", err)
+	}
+
+	// Add creator as owner in board_members
+	boardMemberQuery := `
+		INSERT INTO board_members (id, id_board, id_member, role, joined_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err = tx.ExecContext(ctx, boardMemberQuery,
+		uuid.New(),
+		board.ID,
+		board.IDMemberCreator,
+		models.BoardRoleOwner,
+		board.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add creator as board member: %w", err)
+	}
+
+	// Insert lists
+	listQuery := `
+		INSERT INTO lists (id, name, id_board, position, archived, wip_limit, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	for _, list := range data.Lists {
+		_, err = tx.ExecContext(ctx, listQuery,
+			list.ID,
+			list.Name,
+			list.IDBoard,
+			list.Position,
+			list.Archived,
+			list.WIPLimit,
+			list.CreatedAt,
+			list.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create list: %w", err)
+		}
+	}
+
+	// Insert labels
+	labelQuery := `
+		INSERT INTO labels (id, id_board, name, color, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	for _, label := range data.Labels {
+		_, err = tx.ExecContext(ctx, labelQuery,
+			label.ID,
+			label.IDBoard,
+			label.Name,
+			label.Color,
+			label.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create label: %w", err)
+		}
+	}
+
+	// Insert cards
+	cardQuery := `
+		INSERT INTO cards (id, title, description, id_list, position, archived, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	for _, card := range data.Cards {
+		_, err = tx.ExecContext(ctx, cardQuery,
+			card.ID,
+			card.Title,
+			card.Description,
+			card.IDList,
+			card.Position,
+			card.Archived,
+			card.CreatedBy,
+			card.CreatedAt,
+			card.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create card: %w", err)
+		}
+	}
+
+	// Assign labels to cards
+	cardLabelQuery := `
+		INSERT INTO card_labels (id_card, id_label, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id_card, id_label) DO NOTHING
+	`
+	for _, cardLabel := range data.CardLabels {
+		_, err = tx.ExecContext(ctx, cardLabelQuery, cardLabel.IDCard, cardLabel.IDLabel, board.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to assign card label: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -7,16 +7,32 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/tasks-control/core-back-end/internal/models"
 )
 
-// CreateCard inserts a new card into the database
-func (r *repository) CreateCard(ctx context.Context, card *models.Card) error {
+// CreateCard inserts a new card into the database, checking the WIP limit of its list when wipLimit is set
+func (r *repository) CreateCard(ctx context.Context, card *models.Card, wipLimit *models.WIPLimitCheck) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	arriving := 1
+	if card.Archived {
+		arriving = 0
+	}
+	if err := lockListForCards(ctx, tx, card.IDList, arriving, nil, wipLimit); err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO cards (id, title, description, id_list, position, archived, created_by, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
-	_, err := r.conn.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		card.ID,
 		card.Title,
 		card.Description,
@@ -30,7 +46,8 @@ func (r *repository) CreateCard(ctx context.Context, card *models.Card) error {
 	if err != nil {
 		return fmt.Errorf("failed to create card: %w", err)
 	}
-	return nil
+
+	return tx.Commit()
 }
 
 // GetCardByID retrieves a card by ID
@@ -51,14 +68,27 @@ func (r *repository) GetCardByID(ctx context.Context, cardID uuid.UUID) (*models
 	return &card, nil
 }
 
-// UpdateCard updates an existing card
-func (r *repository) UpdateCard(ctx context.Context, card *models.Card) error {
+// UpdateCard updates an existing card. When wipLimit is set the card is entering its list as an active card
+// and is checked against the list's WIP limit.
+func (r *repository) UpdateCard(ctx context.Context, card *models.Card, wipLimit *models.WIPLimitCheck) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if wipLimit != nil {
+		if err := lockListForCards(ctx, tx, card.IDList, 1, []uuid.UUID{card.ID}, wipLimit); err != nil {
+			return err
+		}
+	}
+
 	query := `
 		UPDATE cards
 		SET title = $2, description = $3, id_list = $4, position = $5, archived = $6, updated_at = $7
 		WHERE id = $1
 	`
-	result, err := r.conn.ExecContext(ctx, query,
+	result, err := tx.ExecContext(ctx, query,
 		card.ID,
 		card.Title,
 		card.Description,
@@ -80,7 +110,7 @@ func (r *repository) UpdateCard(ctx context.Context, card *models.Card) error {
 		return sql.ErrNoRows
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 // DeleteCard deletes a card
@@ -174,3 +204,219 @@ func (r *repository) GetBoardIDByListID(ctx context.Context, listID uuid.UUID) (
 	}
 	return boardID, nil
 }
+
+// GetBoardIDsByCardIDs retrieves the board ID for each of the given cards.
+// Cards that do not exist are absent from the returned map.
+func (r *repository) GetBoardIDsByCardIDs(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	rows := []struct {
+		CardID  uuid.UUID `db:"id"`
+		BoardID uuid.UUID `db:"id_board"`
+	}{}
+	query := `
+		SELECT c.id, l.id_board
+		FROM cards c
+		INNER JOIN lists l ON c.id_list = l.id
+		WHERE c.id = ANY($1)
+	`
+	err := r.conn.SelectContext(ctx, &rows, query, pq.Array(cardIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board IDs by cards: %w", err)
+	}
+
+	boardIDs := make(map[uuid.UUID]uuid.UUID, len(rows))
+	for _, row := range rows {
+		boardIDs[row.CardID] = row.BoardID
+	}
+	return boardIDs, nil
+}
+
+// changedCardColumns are the card columns returned by statements changing many cards, see models.CardChange
+const changedCardColumns = `cards.id, cards.title, cards.description, cards.id_list, cards.position, cards.archived,
+	cards.created_by, cards.created_at, cards.updated_at`
+
+// bulkCardScope repeats the checks of a bulk operation in its statements, so cards moved to another board
+// or boards the member left meanwhile are skipped. $1 are the card IDs, $2 the member and $3 the required board or NULL.
+const bulkCardScope = `
+	cards.id = ANY($1) AND EXISTS (
+		SELECT 1
+		FROM lists l
+		INNER JOIN board_members bm ON bm.id_board = l.id_board AND bm.id_member = $2
+		WHERE l.id = cards.id_list AND ($3::uuid IS NULL OR l.id_board = $3)
+	)`
+
+// ApplyBulkCardOperation applies a single operation to all given cards in one transaction
+// and returns the cards it was applied to
+func (r *repository) ApplyBulkCardOperation(ctx context.Context, op *models.BulkCardOperation) ([]*models.CardChange, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	cardIDs := pq.Array(op.CardIDs)
+	applied := []*models.CardChange{}
+
+	switch op.Operation {
+	case models.BulkCardOperationArchive, models.BulkCardOperationUnarchive:
+		if op.Operation == models.BulkCardOperationUnarchive {
+			if err = lockUnarchiveLists(ctx, tx, op); err != nil {
+				return nil, err
+			}
+		}
+
+		query := `UPDATE cards SET archived = $4, updated_at = $5 WHERE` + bulkCardScope +
+			` RETURNING ` + changedCardColumns + `, cards.id_list AS id_list_before`
+		archived := op.Operation == models.BulkCardOperationArchive
+		err = tx.SelectContext(ctx, &applied, query, cardIDs, op.IDMember, op.IDBoard, archived, op.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update cards archived state: %w", err)
+		}
+
+	case models.BulkCardOperationMove:
+		// Only active cards count against the WIP limit, archived ones stay archived in the target list
+		var arriving int
+		countQuery := `SELECT COUNT(*) FROM cards WHERE` + bulkCardScope + ` AND cards.archived = false`
+		if err = tx.GetContext(ctx, &arriving, countQuery, cardIDs, op.IDMember, op.IDBoard); err != nil {
+			return nil, fmt.Errorf("failed to count moved cards: %w", err)
+		}
+
+		// Lock target list so concurrent appends don't compute the same positions
+		if err = lockListForCards(ctx, tx, op.IDList, arriving, op.CardIDs, op.WIPLimit); err != nil {
+			return nil, err
+		}
+
+		// Append cards after the last position in the target list, keeping request order.
+		// The before row still holds the list of the card prior to the update.
+		query := `
+			UPDATE cards
+			SET id_list = $4, position = base.max_position + 65536.0 * t.ord, updated_at = $5
+			FROM unnest($1::uuid[]) WITH ORDINALITY AS t(id, ord),
+				cards before,
+				(
+					SELECT COALESCE(MAX(position), 0) AS max_position
+					FROM cards
+					WHERE id_list = $4 AND archived = false AND NOT (id = ANY($1))
+				) base
+			WHERE cards.id = t.id AND before.id = cards.id AND` + bulkCardScope + `
+			RETURNING ` + changedCardColumns + `, before.id_list AS id_list_before
+		`
+		err = tx.SelectContext(ctx, &applied, query, cardIDs, op.IDMember, op.IDBoard, op.IDList, op.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move cards: %w", err)
+		}
+
+	case models.BulkCardOperationDelete:
+		query := `DELETE FROM cards WHERE` + bulkCardScope +
+			` RETURNING ` + changedCardColumns + `, cards.id_list AS id_list_before`
+		if err = tx.SelectContext(ctx, &applied, query, cardIDs, op.IDMember, op.IDBoard); err != nil {
+			return nil, fmt.Errorf("failed to delete cards: %w", err)
+		}
+
+	case models.BulkCardOperationAssignLabel:
+		// Cards already having the label count as applied
+		query := `
+			WITH scoped AS (
+				SELECT ` + changedCardColumns + `, cards.id_list AS id_list_before FROM cards WHERE` + bulkCardScope + `
+			), assigned AS (
+				INSERT INTO card_labels (id_card, id_label, created_at)
+				SELECT id, $4, $5 FROM scoped
+				ON CONFLICT (id_card, id_label) DO NOTHING
+			)
+			SELECT * FROM scoped
+		`
+		err = tx.SelectContext(ctx, &applied, query, cardIDs, op.IDMember, op.IDBoard, op.IDLabel, op.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign label to cards: %w", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported bulk card operation: %s", op.Operation)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return applied, nil
+}
+
+// lockUnarchiveLists locks every list holding cards about to be unarchived, in a fixed order to avoid deadlocks,
+// and checks their WIP limits
+func lockUnarchiveLists(ctx context.Context, tx *sqlx.Tx, op *models.BulkCardOperation) error {
+	rows := []struct {
+		IDList   uuid.UUID `db:"id_list"`
+		Arriving int       `db:"arriving"`
+	}{}
+	query := `
+		SELECT id_list, COUNT(*) AS arriving
+		FROM cards
+		WHERE` + bulkCardScope + `
+		GROUP BY id_list
+		ORDER BY id_list
+	`
+	if err := tx.SelectContext(ctx, &rows, query, pq.Array(op.CardIDs), op.IDMember, op.IDBoard); err != nil {
+		return fmt.Errorf("failed to get lists of unarchived cards: %w", err)
+	}
+
+	for _, row := range rows {
+		if err := lockListForCards(ctx, tx, row.IDList, row.Arriving, op.CardIDs, op.WIPLimit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IterateBoardCards calls fn for every card of a board, including archived ones, ordered by list
+// and card position. Rows are read one at a time so large boards are not loaded into memory.
+func (r *repository) IterateBoardCards(ctx context.Context, boardID uuid.UUID, fn func(card *models.Card, labelIDs []uuid.UUID) error) error {
+	query := `
+		SELECT
+			c.id,
+			c.title,
+			c.description,
+			c.id_list,
+			c.position,
+			c.archived,
+			c.created_by,
+			c.created_at,
+			c.updated_at,
+			ARRAY(SELECT cl.id_label::text FROM card_labels cl WHERE cl.id_card = c.id ORDER BY cl.created_at) AS label_ids
+		FROM cards c
+		INNER JOIN lists l ON c.id_list = l.id
+		WHERE l.id_board = $1
+		ORDER BY l.position ASC, c.position ASC
+	`
+	rows, err := r.conn.QueryxContext(ctx, query, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to query board cards: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row struct {
+			models.Card
+			LabelIDs pq.StringArray `db:"label_ids"`
+		}
+		if err := rows.StructScan(&row); err != nil {
+			return fmt.Errorf("failed to scan board card: %w", err)
+		}
+
+		labelIDs := make([]uuid.UUID, 0, len(row.LabelIDs))
+		for _, rawID := range row.LabelIDs {
+			labelID, err := uuid.Parse(rawID)
+			if err != nil {
+				return fmt.Errorf("failed to parse label ID: %w", err)
+			}
+			labelIDs = append(labelIDs, labelID)
+		}
+
+		if err := fn(&row.Card, labelIDs); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate board cards: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// ErrCustomFieldNameTaken is returned when another custom field of the board has the name.
+// The service checks names first, the unique index catches concurrent writes.
+var ErrCustomFieldNameTaken = errors.New("custom field name already taken")
+
+const cardCustomFieldValueColumns = `v.id_card, v.id_custom_field, v.value_text, v.value_number, v.value_date, v.value_checked, v.id_option, v.updated_at`
+
+// CreateCustomField inserts a custom field together with its dropdown options
+func (r *repository) CreateCustomField(ctx context.Context, field *models.CustomField) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO custom_fields (id, id_board, name, type, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		field.ID,
+		field.IDBoard,
+		field.Name,
+		field.Type,
+		field.Position,
+		field.CreatedAt,
+		field.UpdatedAt,
+	)
+	if isCustomFieldNameTaken(err) {
+		return ErrCustomFieldNameTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create custom field: %w", err)
+	}
+
+	for _, option := range field.Options {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO custom_field_options (id, id_custom_field, value, position)
+			VALUES ($1, $2, $3, $4)
+		`, option.ID, field.ID, option.Value, option.Position)
+		if err != nil {
+			return fmt.Errorf("failed to create custom field option: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetCustomFieldByID retrieves a custom field with its options by ID
+func (r *repository) GetCustomFieldByID(ctx context.Context, fieldID uuid.UUID) (*models.CustomField, error) {
+	var field models.CustomField
+	query := `
+		SELECT id, id_board, name, type, position, created_at, updated_at
+		FROM custom_fields
+		WHERE id = $1
+	`
+	err := r.conn.GetContext(ctx, &field, query, fieldID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get custom field: %w", err)
+	}
+
+	options := []*models.CustomFieldOption{}
+	query = `
+		SELECT id, id_custom_field, value, position
+		FROM custom_field_options
+		WHERE id_custom_field = $1
+		ORDER BY position ASC
+	`
+	err = r.conn.SelectContext(ctx, &options, query, fieldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field options: %w", err)
+	}
+	field.Options = options
+
+	return &field, nil
+}
+
+// GetBoardCustomFields retrieves all custom fields of a board with their options, ordered by position
+func (r *repository) GetBoardCustomFields(ctx context.Context, boardID uuid.UUID) ([]*models.CustomField, error) {
+	fields := []*models.CustomField{}
+	query := `
+		SELECT id, id_board, name, type, position, created_at, updated_at
+		FROM custom_fields
+		WHERE id_board = $1
+		ORDER BY position ASC
+	`
+	err := r.conn.SelectContext(ctx, &fields, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board custom fields: %w", err)
+	}
+
+	options := []*models.CustomFieldOption{}
+	query = `
+		SELECT o.id, o.id_custom_field, o.value, o.position
+		FROM custom_field_options o
+		INNER JOIN custom_fields f ON o.id_custom_field = f.id
+		WHERE f.id_board = $1
+		ORDER BY o.position ASC
+	`
+	err = r.conn.SelectContext(ctx, &options, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field options: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*models.CustomField, len(fields))
+	for _, field := range fields {
+		byID[field.ID] = field
+	}
+	for _, option := range options {
+		if field, ok := byID[option.IDCustomField]; ok {
+			field.Options = append(field.Options, option)
+		}
+	}
+
+	return fields, nil
+}
+
+// UpdateCustomField updates a custom field and replaces its options.
+// Options missing from field.Options are deleted, which clears the card values that selected them.
+func (r *repository) UpdateCustomField(ctx context.Context, field *models.CustomField) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE custom_fields
+		SET name = $2, position = $3, updated_at = $4
+		WHERE id = $1
+	`
+	result, err := tx.ExecContext(ctx, query, field.ID, field.Name, field.Position, field.UpdatedAt)
+	if isCustomFieldNameTaken(err) {
+		return ErrCustomFieldNameTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update custom field: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	optionIDs := make([]uuid.UUID, 0, len(field.Options))
+	for _, option := range field.Options {
+		optionIDs = append(optionIDs, option.ID)
+	}
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM custom_field_options
+		WHERE id_custom_field = $1 AND NOT (id = ANY($2))
+	`, field.ID, pq.Array(optionIDs))
+	if err != nil {
+		return fmt.Errorf("failed to delete custom field options: %w", err)
+	}
+
+	for _, option := range field.Options {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO custom_field_options (id, id_custom_field, value, position)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (id) DO UPDATE SET value = EXCLUDED.value, position = EXCLUDED.position
+		`, option.ID, field.ID, option.Value, option.Position)
+		if err != nil {
+			return fmt.Errorf("failed to save custom field option: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteCustomField deletes a custom field (cascade will delete its options and card values)
+func (r *repository) DeleteCustomField(ctx context.Context, fieldID uuid.UUID) error {
+	query := `DELETE FROM custom_fields WHERE id = $1`
+	result, err := r.conn.ExecContext(ctx, query, fieldID)
+	if err != nil {
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// SetCardCustomFieldValue inserts or replaces the value of a custom field on a card
+func (r *repository) SetCardCustomFieldValue(ctx context.Context, value *models.CardCustomFieldValue) error {
+	query := `
+		INSERT INTO card_custom_field_values (id_card, id_custom_field, value_text, value_number, value_date, value_checked, id_option, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id_card, id_custom_field) DO UPDATE
+		SET value_text = EXCLUDED.value_text,
+			value_number = EXCLUDED.value_number,
+			value_date = EXCLUDED.value_date,
+			value_checked = EXCLUDED.value_checked,
+			id_option = EXCLUDED.id_option,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		value.IDCard,
+		value.IDCustomField,
+		value.Text,
+		value.Number,
+		value.Date,
+		value.Checked,
+		value.IDOption,
+		value.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set card custom field value: %w", err)
+	}
+	return nil
+}
+
+// DeleteCardCustomFieldValue clears the value of a custom field on a card
+func (r *repository) DeleteCardCustomFieldValue(ctx context.Context, cardID, fieldID uuid.UUID) error {
+	query := `DELETE FROM card_custom_field_values WHERE id_card = $1 AND id_custom_field = $2`
+	_, err := r.conn.ExecContext(ctx, query, cardID, fieldID)
+	if err != nil {
+		return fmt.Errorf("failed to delete card custom field value: %w", err)
+	}
+	return nil
+}
+
+// GetCardCustomFieldValues retrieves the custom field values of a card in field order
+func (r *repository) GetCardCustomFieldValues(ctx context.Context, cardID uuid.UUID) ([]*models.CardCustomFieldValue, error) {
+	values := []*models.CardCustomFieldValue{}
+	query := `
+		SELECT ` + cardCustomFieldValueColumns + `
+		FROM card_custom_field_values v
+		INNER JOIN custom_fields f ON v.id_custom_field = f.id
+		WHERE v.id_card = $1
+		ORDER BY f.position ASC
+	`
+	err := r.conn.SelectContext(ctx, &values, query, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card custom field values: %w", err)
+	}
+	return values, nil
+}
+
+// GetBoardCustomFieldValues retrieves the custom field values of all cards of a board in field order
+func (r *repository) GetBoardCustomFieldValues(ctx context.Context, boardID uuid.UUID) ([]*models.CardCustomFieldValue, error) {
+	values := []*models.CardCustomFieldValue{}
+	query := `
+		SELECT ` + cardCustomFieldValueColumns + `
+		FROM card_custom_field_values v
+		INNER JOIN custom_fields f ON v.id_custom_field = f.id
+		WHERE f.id_board = $1
+		ORDER BY f.position ASC
+	`
+	err := r.conn.SelectContext(ctx, &values, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board custom field values: %w", err)
+	}
+	return values, nil
+}
+
+// isCustomFieldNameTaken reports whether err violates the unique name index of custom fields
+func isCustomFieldNameTaken(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "idx_custom_fields_board_name"
+}
@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+const dataExportColumns = `id, id_member, format, status, file_path, size_bytes, error, started_at, completed_at, expires_at, created_at`
+
+// CreateMemberDataExport queues a personal data export
+func (r *repository) CreateMemberDataExport(ctx context.Context, export *models.MemberDataExport) error {
+	query := `
+		INSERT INTO member_data_exports (id, id_member, format, status, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		export.ID,
+		export.IDMember,
+		export.Format,
+		export.Status,
+		export.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create member data export: %w", err)
+	}
+	return nil
+}
+
+// GetMemberDataExport retrieves an unexpired export of a member
+func (r *repository) GetMemberDataExport(ctx context.Context, exportID, memberID uuid.UUID) (*models.MemberDataExport, error) {
+	var export models.MemberDataExport
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM member_data_exports
+		WHERE id = $1 AND id_member = $2 AND (expires_at IS NULL OR expires_at > NOW())
+	`
+	err := r.conn.GetContext(ctx, &export, query, exportID, memberID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member data export: %w", err)
+	}
+	return &export, nil
+}
+
+// GetActiveMemberDataExport retrieves a queued or running export of a member in the format
+func (r *repository) GetActiveMemberDataExport(ctx context.Context, memberID uuid.UUID, format string) (*models.MemberDataExport, error) {
+	var export models.MemberDataExport
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM member_data_exports
+		WHERE id_member = $1 AND format = $2 AND status IN ('pending', 'processing')
+			AND (expires_at IS NULL OR expires_at > NOW())
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	err := r.conn.GetContext(ctx, &export, query, memberID, format)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active member data export: %w", err)
+	}
+	return &export, nil
+}
+
+// ClaimMemberDataExport marks the oldest pending export as processing and returns it.
+// Exports left processing since before staleBefore are claimed again, their worker is assumed dead.
+func (r *repository) ClaimMemberDataExport(ctx context.Context, startedAt, staleBefore time.Time) (*models.MemberDataExport, error) {
+	var export models.MemberDataExport
+	query := `
+		UPDATE member_data_exports
+		SET status = 'processing', started_at = $1
+		WHERE id = (
+			SELECT id
+			FROM member_data_exports
+			WHERE (status = 'pending' OR (status = 'processing' AND started_at < $2))
+				AND (expires_at IS NULL OR expires_at > NOW())
+			ORDER BY created_at ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + dataExportColumns
+	err := r.conn.GetContext(ctx, &export, query, startedAt, staleBefore)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim member data export: %w", err)
+	}
+	return &export, nil
+}
+
+// CompleteMemberDataExport stores the result of a processed export
+func (r *repository) CompleteMemberDataExport(ctx context.Context, export *models.MemberDataExport) error {
+	query := `
+		UPDATE member_data_exports
+		SET status = $2, file_path = $3, size_bytes = $4, error = $5, completed_at = $6, expires_at = $7
+		WHERE id = $1
+	`
+	result, err := r.conn.ExecContext(ctx, query,
+		export.ID,
+		export.Status,
+		export.FilePath,
+		export.SizeBytes,
+		export.Error,
+		export.CompletedAt,
+		export.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to complete member data export: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetExpiredMemberDataExports retrieves exports whose archive should be removed
+func (r *repository) GetExpiredMemberDataExports(ctx context.Context, now time.Time, limit int) ([]*models.MemberDataExport, error) {
+	exports := []*models.MemberDataExport{}
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM member_data_exports
+		WHERE expires_at <= $1
+		ORDER BY expires_at ASC
+		LIMIT $2
+	`
+	err := r.conn.SelectContext(ctx, &exports, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired member data exports: %w", err)
+	}
+	return exports, nil
+}
+
+// DeleteMemberDataExport removes an export row
+func (r *repository) DeleteMemberDataExport(ctx context.Context, exportID uuid.UUID) error {
+	_, err := r.conn.ExecContext(ctx, `DELETE FROM member_data_exports WHERE id = $1`, exportID)
+	if err != nil {
+		return fmt.Errorf("failed to delete member data export: %w", err)
+	}
+	return nil
+}
+
+// CountMemberCards returns how many cards a member created
+func (r *repository) CountMemberCards(ctx context.Context, memberID uuid.UUID) (int, error) {
+	var count int
+	err := r.conn.GetContext(ctx, &count, `SELECT COUNT(*) FROM cards WHERE created_by = $1`, memberID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count member cards: %w", err)
+	}
+	return count, nil
+}
+
+// IterateMemberCards calls fn for every card a member created, including archived ones, oldest first.
+// Rows are read one at a time so members with many cards are not loaded into memory.
+func (r *repository) IterateMemberCards(ctx context.Context, memberID uuid.UUID, fn func(card *models.Card) error) error {
+	query := `
+		SELECT id, title, description, id_list, position, archived, created_by, created_at, updated_at
+		FROM cards
+		WHERE created_by = $1
+		ORDER BY created_at ASC
+	`
+	rows, err := r.conn.QueryxContext(ctx, query, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to query member cards: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var card models.Card
+		if err := rows.StructScan(&card); err != nil {
+			return fmt.Errorf("failed to scan member card: %w", err)
+		}
+		if err := fn(&card); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetMemberBoardMemberships retrieves the boards a member belongs to with their role
+func (r *repository) GetMemberBoardMemberships(ctx context.Context, memberID uuid.UUID) ([]*models.MemberBoardMembership, error) {
+	memberships := []*models.MemberBoardMembership{}
+	query := `
+		SELECT bm.id_board, b.name as board_name, b.name_board_unique, bm.role, bm.joined_at
+		FROM board_members bm
+		INNER JOIN boards b ON b.id = bm.id_board
+		WHERE bm.id_member = $1
+		ORDER BY bm.joined_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &memberships, query, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member board memberships: %w", err)
+	}
+	return memberships, nil
+}
+
+// GetMemberStarredBoards retrieves the boards a member starred
+func (r *repository) GetMemberStarredBoards(ctx context.Context, memberID uuid.UUID) ([]*models.StarredBoard, error) {
+	starred := []*models.StarredBoard{}
+	query := `
+		SELECT id, id_board, id_member, starred_at
+		FROM starred_boards
+		WHERE id_member = $1
+		ORDER BY starred_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &starred, query, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get starred boards: %w", err)
+	}
+	return starred, nil
+}
+
+// GetMemberRefreshTokens retrieves the login sessions of a member, including revoked and expired ones
+func (r *repository) GetMemberRefreshTokens(ctx context.Context, memberID uuid.UUID) ([]*models.RefreshToken, error) {
+	tokens := []*models.RefreshToken{}
+	query := `
+		SELECT id, id_member, token_hash, expires_at, created_at, revoked
+		FROM refresh_tokens
+		WHERE id_member = $1
+		ORDER BY created_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &tokens, query, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// GetMemberSecurityEvents retrieves the security history of a member, oldest first
+func (r *repository) GetMemberSecurityEvents(ctx context.Context, memberID uuid.UUID) ([]*models.SecurityEvent, error) {
+	events := []*models.SecurityEvent{}
+	query := `
+		SELECT id, id_member, event_type, ip_address, user_agent, metadata, created_at
+		FROM member_security_events
+		WHERE id_member = $1
+		ORDER BY created_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &events, query, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get security events: %w", err)
+	}
+	return events, nil
+}
+
+// GetMemberIdentities retrieves the external sign-in accounts linked to a member
+func (r *repository) GetMemberIdentities(ctx context.Context, memberID uuid.UUID) ([]*models.MemberIdentity, error) {
+	identities := []*models.MemberIdentity{}
+	query := `
+		SELECT id, id_member, provider, subject, email, created_at
+		FROM member_identities
+		WHERE id_member = $1
+		ORDER BY created_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &identities, query, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member identities: %w", err)
+	}
+	return identities, nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// GetMemberIdentity retrieves the link of a provider account to a member
+func (r *repository) GetMemberIdentity(ctx context.Context, provider, subject string) (*models.MemberIdentity, error) {
+	var identity models.MemberIdentity
+	query := `
+		SELECT id, id_member, provider, subject, email, created_at
+		FROM member_identities
+		WHERE provider = $1 AND subject = $2
+	`
+	err := r.conn.GetContext(ctx, &identity, query, provider, subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member identity: %w", err)
+	}
+	return &identity, nil
+}
+
+// CreateMemberIdentity links a provider account to an existing member
+func (r *repository) CreateMemberIdentity(ctx context.Context, identity *models.MemberIdentity) error {
+	return insertMemberIdentity(ctx, r.conn, identity)
+}
+
+// CreateMemberWithIdentity inserts a member signed up through a provider together with the provider link
+func (r *repository) CreateMemberWithIdentity(ctx context.Context, member *models.Member, identity *models.MemberIdentity) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO members (id, email, username, full_name, password_hash, created_at, updated_at, email_verified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		member.ID,
+		member.Email,
+		member.Username,
+		member.FullName,
+		member.PasswordHash,
+		member.CreatedAt,
+		member.UpdatedAt,
+		member.EmailVerifiedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create member: %w", err)
+	}
+
+	if err := insertMemberIdentity(ctx, tx, identity); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CreateOIDCLoginState stores a login started at a provider
+func (r *repository) CreateOIDCLoginState(ctx context.Context, state *models.OIDCLoginState) error {
+	query := `
+		INSERT INTO oidc_login_states (id, provider, state_hash, nonce, code_verifier, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		state.ID,
+		state.Provider,
+		state.StateHash,
+		state.Nonce,
+		state.CodeVerifier,
+		state.ExpiresAt,
+		state.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create oidc login state: %w", err)
+	}
+	return nil
+}
+
+// ConsumeOIDCLoginState deletes and returns an unexpired login state, so a callback can only be completed once.
+// Expired states of any provider are removed along the way.
+func (r *repository) ConsumeOIDCLoginState(ctx context.Context, provider, stateHash string) (*models.OIDCLoginState, error) {
+	_, err := r.conn.ExecContext(ctx, `DELETE FROM oidc_login_states WHERE expires_at <= NOW()`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete expired oidc login states: %w", err)
+	}
+
+	var state models.OIDCLoginState
+	query := `
+		DELETE FROM oidc_login_states
+		WHERE provider = $1 AND state_hash = $2 AND expires_at > NOW()
+		RETURNING id, provider, state_hash, nonce, code_verifier, expires_at, created_at
+	`
+	err = r.conn.GetContext(ctx, &state, query, provider, stateHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume oidc login state: %w", err)
+	}
+	return &state, nil
+}
+
+func insertMemberIdentity(ctx context.Context, exec sqlx.ExecerContext, identity *models.MemberIdentity) error {
+	_, err := exec.ExecContext(ctx, `
+		INSERT INTO member_identities (id, id_member, provider, subject, email, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`,
+		identity.ID,
+		identity.IDMember,
+		identity.Provider,
+		identity.Subject,
+		identity.Email,
+		identity.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create member identity: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// CreateLabel inserts a new label into the database
+func (r *repository) CreateLabel(ctx context.Context, label *models.Label) error {
+	query := `
+		INSERT INTO labels (id, id_board, name, color, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		label.ID,
+		label.IDBoard,
+		label.Name,
+		label.Color,
+		label.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create label: %w", err)
+	}
+	return nil
+}
+
+// GetLabelByID retrieves a label by ID
+func (r *repository) GetLabelByID(ctx context.Context, labelID uuid.UUID) (*models.Label, error) {
+	var label models.Label
+	query := `
+		SELECT id, id_board, name, color, created_at
+		FROM labels
+		WHERE id = $1
+	`
+	err := r.conn.GetContext(ctx, &label, query, labelID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get label: %w", err)
+	}
+	return &label, nil
+}
+
+// GetBoardLabels retrieves all labels for a board
+func (r *repository) GetBoardLabels(ctx context.Context, boardID uuid.UUID) ([]*models.Label, error) {
+	labels := []*models.Label{}
+	query := `
+		SELECT id, id_board, name, color, created_at
+		FROM labels
+		WHERE id_board = $1
+		ORDER BY created_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &labels, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board labels: %w", err)
+	}
+	return labels, nil
+}
@@ -5,16 +5,22 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/tasks-control/core-back-end/internal/models"
 )
 
+// ErrListNotFound is returned when the list receiving cards was deleted meanwhile
+var ErrListNotFound = errors.New("list not found")
+
 // CreateList inserts a new list into the database
 func (r *repository) CreateList(ctx context.Context, list *models.List) error {
 	query := `
-		INSERT INTO lists (id, name, id_board, position, archived, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO lists (id, name, id_board, position, archived, wip_limit, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 	_, err := r.conn.ExecContext(ctx, query,
 		list.ID,
@@ -22,6 +28,7 @@ func (r *repository) CreateList(ctx context.Context, list *models.List) error {
 		list.IDBoard,
 		list.Position,
 		list.Archived,
+		list.WIPLimit,
 		list.CreatedAt,
 		list.UpdatedAt,
 	)
@@ -35,7 +42,7 @@ func (r *repository) CreateList(ctx context.Context, list *models.List) error {
 func (r *repository) GetListByID(ctx context.Context, listID uuid.UUID) (*models.List, error) {
 	var list models.List
 	query := `
-		SELECT id, name, id_board, position, archived, created_at, updated_at
+		SELECT id, name, id_board, position, archived, wip_limit, created_at, updated_at
 		FROM lists
 		WHERE id = $1
 	`
@@ -53,7 +60,7 @@ func (r *repository) GetListByID(ctx context.Context, listID uuid.UUID) (*models
 func (r *repository) UpdateList(ctx context.Context, list *models.List) error {
 	query := `
 		UPDATE lists
-		SET name = $2, position = $3, archived = $4, updated_at = $5
+		SET name = $2, position = $3, archived = $4, wip_limit = $5, updated_at = $6
 		WHERE id = $1
 	`
 	result, err := r.conn.ExecContext(ctx, query,
@@ -61,6 +68,7 @@ func (r *repository) UpdateList(ctx context.Context, list *models.List) error {
 		list.Name,
 		list.Position,
 		list.Archived,
+		list.WIPLimit,
 		list.UpdatedAt,
 	)
 	if err != nil {
@@ -149,3 +157,125 @@ func (r *repository) GetListCountInBoard(ctx context.Context, boardID uuid.UUID)
 	}
 	return count, nil
 }
+
+// ArchiveAllListCards archives every active card in a list and returns the archived cards
+func (r *repository) ArchiveAllListCards(ctx context.Context, listID uuid.UUID, updatedAt time.Time) ([]*models.CardChange, error) {
+	archived := []*models.CardChange{}
+	query := `
+		UPDATE cards
+		SET archived = true, updated_at = $2
+		WHERE id_list = $1 AND archived = false
+		RETURNING ` + changedCardColumns + `, cards.id_list AS id_list_before
+	`
+	err := r.conn.SelectContext(ctx, &archived, query, listID, updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive list cards: %w", err)
+	}
+	return archived, nil
+}
+
+// MoveAllListCards moves every active card of the source list to the end of the target list,
+// preserving their relative order, and returns the moved cards. The WIP limit of the
+// target list is checked when wipLimit is set.
+func (r *repository) MoveAllListCards(ctx context.Context, sourceListID, targetListID uuid.UUID, updatedAt time.Time, wipLimit *models.WIPLimitCheck) ([]*models.CardChange, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var arriving int
+	countQuery := `SELECT COUNT(*) FROM cards WHERE id_list = $1 AND archived = false`
+	if err = tx.GetContext(ctx, &arriving, countQuery, sourceListID); err != nil {
+		return nil, fmt.Errorf("failed to count moved cards: %w", err)
+	}
+
+	// Lock target list so concurrent appends don't compute the same positions
+	if err = lockListForCards(ctx, tx, targetListID, arriving, nil, wipLimit); err != nil {
+		return nil, err
+	}
+
+	moved := []*models.CardChange{}
+	query := `
+		UPDATE cards
+		SET id_list = $2, position = base.max_position + 65536.0 * ordered.rn, updated_at = $3
+		FROM (
+				SELECT id, ROW_NUMBER() OVER (ORDER BY position ASC) AS rn
+				FROM cards
+				WHERE id_list = $1 AND archived = false
+			) ordered,
+			(
+				SELECT COALESCE(MAX(position), 0) AS max_position
+				FROM cards
+				WHERE id_list = $2 AND archived = false
+			) base
+		WHERE cards.id = ordered.id
+		RETURNING ` + changedCardColumns + `, $1::uuid AS id_list_before
+	`
+	err = tx.SelectContext(ctx, &moved, query, sourceListID, targetListID, updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move list cards: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return moved, nil
+}
+
+// lockListForCards locks a list so concurrent writes adding cards to it are serialized. With a WIP limit check it
+// returns a WIPLimitExceeded error when the arriving active cards don't fit. Arriving cards already stored in the
+// list are passed in exclude so they are not counted twice.
+func lockListForCards(ctx context.Context, tx *sqlx.Tx, listID uuid.UUID, arriving int, exclude []uuid.UUID, wipLimit *models.WIPLimitCheck) error {
+	var list struct {
+		Name     string `db:"name"`
+		WIPLimit *int   `db:"wip_limit"`
+		Mode     string `db:"wip_limit_mode"`
+	}
+	query := `
+		SELECT l.name, l.wip_limit, b.wip_limit_mode
+		FROM lists l
+		INNER JOIN boards b ON b.id = l.id_board
+		WHERE l.id = $1
+		FOR UPDATE OF l
+	`
+	err := tx.GetContext(ctx, &list, query, listID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrListNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock list: %w", err)
+	}
+
+	if wipLimit == nil || list.WIPLimit == nil || arriving == 0 {
+		return nil
+	}
+	if wipLimit.IgnoreSoftLimit && list.Mode != models.WIPLimitModeHard {
+		return nil
+	}
+
+	if exclude == nil {
+		exclude = []uuid.UUID{}
+	}
+	var count int
+	countQuery := `
+		SELECT COUNT(*)
+		FROM cards
+		WHERE id_list = $1 AND archived = false AND NOT (id = ANY($2))
+	`
+	if err := tx.GetContext(ctx, &count, countQuery, listID, pq.Array(exclude)); err != nil {
+		return fmt.Errorf("failed to get card count: %w", err)
+	}
+
+	if count+arriving > *list.WIPLimit {
+		return &models.WIPLimitExceeded{
+			IDList:   listID,
+			ListName: list.Name,
+			Limit:    *list.WIPLimit,
+			Count:    count,
+			Mode:     list.Mode,
+		}
+	}
+	return nil
+}
@@ -4,16 +4,22 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tasks-control/core-back-end/internal/models"
 )
 
+// ErrBoardHasOtherMembers is returned by DeleteMember when a board listed for deletion gained members
+var ErrBoardHasOtherMembers = errors.New("board has other members")
+
 // CreateMember inserts a new member into the database
 func (r *repository) CreateMember(ctx context.Context, member *models.Member) error {
 	query := `
-		INSERT INTO members (id, email, username, full_name, password_hash, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO members (id, email, username, full_name, password_hash, created_at, updated_at, email_verified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 	_, err := r.conn.ExecContext(ctx, query,
 		member.ID,
@@ -23,6 +29,7 @@ func (r *repository) CreateMember(ctx context.Context, member *models.Member) er
 		member.PasswordHash,
 		member.CreatedAt,
 		member.UpdatedAt,
+		member.EmailVerifiedAt,
 	)
 	return err
 }
@@ -31,7 +38,7 @@ func (r *repository) CreateMember(ctx context.Context, member *models.Member) er
 func (r *repository) GetMemberByEmail(ctx context.Context, email string) (*models.Member, error) {
 	var member models.Member
 	query := `
-		SELECT id, email, username, full_name, password_hash, created_at, updated_at
+		SELECT id, email, username, full_name, password_hash, created_at, updated_at, tokens_valid_after, email_verified_at, deleted_at, avatar_id
 		FROM members
 		WHERE email = $1
 	`
@@ -49,7 +56,7 @@ func (r *repository) GetMemberByEmail(ctx context.Context, email string) (*model
 func (r *repository) GetMemberByID(ctx context.Context, id uuid.UUID) (*models.Member, error) {
 	var member models.Member
 	query := `
-		SELECT id, email, username, full_name, password_hash, created_at, updated_at
+		SELECT id, email, username, full_name, password_hash, created_at, updated_at, tokens_valid_after, email_verified_at, deleted_at, avatar_id
 		FROM members
 		WHERE id = $1
 	`
@@ -67,7 +74,7 @@ func (r *repository) GetMemberByID(ctx context.Context, id uuid.UUID) (*models.M
 func (r *repository) GetMemberByUsername(ctx context.Context, username string) (*models.Member, error) {
 	var member models.Member
 	query := `
-		SELECT id, email, username, full_name, password_hash, created_at, updated_at
+		SELECT id, email, username, full_name, password_hash, created_at, updated_at, tokens_valid_after, email_verified_at, deleted_at, avatar_id
 		FROM members
 		WHERE username = $1
 	`
@@ -81,11 +88,49 @@ func (r *repository) GetMemberByUsername(ctx context.Context, username string) (
 	return &member, nil
 }
 
+// SearchMembers finds members sharing a board with memberID whose username, full name or a word of the full name
+// starts with the query, and the member whose email equals the query regardless of boards. Deleted members are skipped.
+func (r *repository) SearchMembers(ctx context.Context, memberID uuid.UUID, query string, limit int) ([]*models.Member, error) {
+	members := []*models.Member{}
+	sqlQuery := `
+		SELECT m.id, m.email, m.username, m.full_name, m.password_hash, m.created_at, m.updated_at,
+			m.tokens_valid_after, m.email_verified_at, m.deleted_at, m.avatar_id
+		FROM members m
+		WHERE m.deleted_at IS NULL
+			AND (
+				LOWER(m.email) = LOWER($2)
+				OR (
+					(m.username ILIKE $3 OR m.full_name ILIKE $3 OR m.full_name ILIKE '% ' || $3)
+					AND EXISTS (
+						SELECT 1
+						FROM board_members own
+						INNER JOIN board_members other ON other.id_board = own.id_board
+						WHERE own.id_member = $1 AND other.id_member = m.id
+					)
+				)
+			)
+		ORDER BY LOWER(m.email) = LOWER($2) DESC, m.username ASC
+		LIMIT $4
+	`
+	err := r.conn.SelectContext(ctx, &members, sqlQuery, memberID, query, likePrefix(query), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search members: %w", err)
+	}
+	return members, nil
+}
+
+// likePrefix builds a LIKE pattern matching values starting with s, wildcards in s match literally
+func likePrefix(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(s) + "%"
+}
+
 // UpdateMember updates an existing member
 func (r *repository) UpdateMember(ctx context.Context, member *models.Member) error {
 	query := `
 		UPDATE members
-		SET email = $2, username = $3, full_name = $4, password_hash = $5, updated_at = $6
+		SET email = $2, username = $3, full_name = $4, password_hash = $5, updated_at = $6, tokens_valid_after = $7,
+			email_verified_at = $8
 		WHERE id = $1
 	`
 	_, err := r.conn.ExecContext(ctx, query,
@@ -95,6 +140,157 @@ func (r *repository) UpdateMember(ctx context.Context, member *models.Member) er
 		member.FullName,
 		member.PasswordHash,
 		member.UpdatedAt,
+		member.TokensValidAfter,
+		member.EmailVerifiedAt,
+	)
+	return err
+}
+
+// UpdateMemberAvatar replaces the avatar of a member, nil removes it
+func (r *repository) UpdateMemberAvatar(ctx context.Context, memberID uuid.UUID, avatarID *uuid.UUID, updatedAt time.Time) error {
+	query := `
+		UPDATE members
+		SET avatar_id = $2, updated_at = $3
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	result, err := r.conn.ExecContext(ctx, query, memberID, avatarID, updatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update member avatar: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// CreateSecurityEvent inserts an entry of a member's security history
+func (r *repository) CreateSecurityEvent(ctx context.Context, event *models.SecurityEvent) error {
+	query := `
+		INSERT INTO member_security_events (id, id_member, event_type, ip_address, user_agent, metadata, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		event.ID,
+		event.IDMember,
+		event.EventType,
+		event.IPAddress,
+		event.UserAgent,
+		event.Metadata,
+		event.CreatedAt,
 	)
 	return err
 }
+
+// DeleteMember anonymises a member in one transaction. Board ownership is handed over first, boards listed
+// for deletion are removed, then the member leaves all boards and loses credentials and personal data.
+// The member row stays as a tombstone so cards they created on shared boards are kept.
+// It returns sql.ErrNoRows when a transfer target isn't a member of the board and
+// ErrBoardHasOtherMembers when a board listed for deletion is no longer solo.
+func (r *repository) DeleteMember(ctx context.Context, memberID uuid.UUID, transfers []models.BoardOwnershipTransfer, deleteBoardIDs []uuid.UUID, deletedAt time.Time) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, transfer := range transfers {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE board_members SET role = $3
+			WHERE id_board = $1 AND id_member = $2
+		`, transfer.IDBoard, transfer.IDMember, models.BoardRoleOwner)
+		if err != nil {
+			return fmt.Errorf("failed to transfer board ownership: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE boards SET id_member_creator = $2, updated_at = $3
+			WHERE id = $1 AND id_member_creator = $4
+		`, transfer.IDBoard, transfer.IDMember, deletedAt, memberID)
+		if err != nil {
+			return fmt.Errorf("failed to update board creator: %w", err)
+		}
+	}
+
+	for _, boardID := range deleteBoardIDs {
+		// Lock the board so nobody joins between the member check and the delete
+		_, err = tx.ExecContext(ctx, `SELECT id FROM boards WHERE id = $1 FOR UPDATE`, boardID)
+		if err != nil {
+			return fmt.Errorf("failed to lock board: %w", err)
+		}
+		var otherMembers int
+		err = tx.GetContext(ctx, &otherMembers, `
+			SELECT COUNT(*) FROM board_members WHERE id_board = $1 AND id_member <> $2
+		`, boardID, memberID)
+		if err != nil {
+			return fmt.Errorf("failed to count board members: %w", err)
+		}
+		if otherMembers > 0 {
+			return ErrBoardHasOtherMembers
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM boards WHERE id = $1`, boardID)
+		if err != nil {
+			return fmt.Errorf("failed to delete board: %w", err)
+		}
+	}
+
+	// Remove memberships, credentials and personal data
+	cleanup := []string{
+		`DELETE FROM board_members WHERE id_member = $1`,
+		`DELETE FROM starred_boards WHERE id_member = $1`,
+		`DELETE FROM watchers WHERE id_member = $1`,
+		`UPDATE refresh_tokens SET revoked = TRUE WHERE id_member = $1`,
+		`DELETE FROM personal_access_tokens WHERE id_member = $1`,
+		`DELETE FROM password_reset_tokens WHERE id_member = $1`,
+		`DELETE FROM email_verification_tokens WHERE id_member = $1`,
+		`DELETE FROM member_recovery_codes WHERE id_member = $1`,
+		`DELETE FROM member_totp WHERE id_member = $1`,
+		`DELETE FROM mfa_challenges WHERE id_member = $1`,
+		`DELETE FROM member_identities WHERE id_member = $1`,
+		`DELETE FROM member_security_events WHERE id_member = $1`,
+		`DELETE FROM member_preferences WHERE id_member = $1`,
+		`DELETE FROM notifications WHERE id_member = $1`,
+		`DELETE FROM member_email_digests WHERE id_member = $1`,
+		// Webhooks stay on shared boards for their owners to review, but stop delivering
+		`UPDATE webhooks SET active = FALSE WHERE created_by = $1`,
+		// Archives on disk are removed by the export worker once expired
+		`UPDATE member_data_exports SET expires_at = NOW() WHERE id_member = $1`,
+	}
+	for _, query := range cleanup {
+		if _, err := tx.ExecContext(ctx, query, memberID); err != nil {
+			return fmt.Errorf("failed to remove member data: %w", err)
+		}
+	}
+
+	// Replace identifying fields, the unique email and username are derived from the ID
+	tombstone := "deleted-" + strings.ReplaceAll(memberID.String(), "-", "")
+	result, err := tx.ExecContext(ctx, `
+		UPDATE members
+		SET email = $2, username = $3, full_name = NULL, password_hash = '', email_verified_at = NULL,
+			avatar_id = NULL, tokens_valid_after = $4, deleted_at = $4, updated_at = $4
+		WHERE id = $1 AND deleted_at IS NULL
+	`, memberID, tombstone+"@deleted.invalid", tombstone, deletedAt)
+	if err != nil {
+		return fmt.Errorf("failed to anonymise member: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return tx.Commit()
+}
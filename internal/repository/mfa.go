@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// GetMemberTOTP retrieves the TOTP second factor of a member, confirmed or pending
+func (r *repository) GetMemberTOTP(ctx context.Context, memberID uuid.UUID) (*models.MemberTOTP, error) {
+	var totp models.MemberTOTP
+	query := `
+		SELECT id_member, secret, confirmed_at, last_used_step, created_at
+		FROM member_totp
+		WHERE id_member = $1
+	`
+	err := r.conn.GetContext(ctx, &totp, query, memberID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member totp: %w", err)
+	}
+	return &totp, nil
+}
+
+// SavePendingTOTP stores a new unconfirmed TOTP secret, replacing a previous pending one.
+// It returns sql.ErrNoRows when the member already has a confirmed second factor.
+func (r *repository) SavePendingTOTP(ctx context.Context, totp *models.MemberTOTP) error {
+	query := `
+		INSERT INTO member_totp (id_member, secret, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id_member) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, created_at = EXCLUDED.created_at
+		WHERE member_totp.confirmed_at IS NULL
+	`
+	result, err := r.conn.ExecContext(ctx, query, totp.IDMember, totp.Secret, totp.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save member totp: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ConfirmMemberTOTP enables a pending TOTP factor and replaces the member's recovery codes
+func (r *repository) ConfirmMemberTOTP(ctx context.Context, memberID uuid.UUID, step int64, confirmedAt time.Time, codeHashes []string) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE member_totp
+		SET confirmed_at = $2, last_used_step = $3
+		WHERE id_member = $1 AND confirmed_at IS NULL
+	`, memberID, confirmedAt, step)
+	if err != nil {
+		return fmt.Errorf("failed to confirm member totp: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, memberID, codeHashes, confirmedAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseTOTPStep records the time step of an accepted code.
+// It returns sql.ErrNoRows when the step was already used, so a code can't be replayed.
+func (r *repository) UseTOTPStep(ctx context.Context, memberID uuid.UUID, step int64) error {
+	query := `UPDATE member_totp SET last_used_step = $2 WHERE id_member = $1 AND last_used_step < $2`
+	result, err := r.conn.ExecContext(ctx, query, memberID, step)
+	if err != nil {
+		return fmt.Errorf("failed to update totp step: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// DeleteMemberTOTP removes the member's TOTP factor and recovery codes
+func (r *repository) DeleteMemberTOTP(ctx context.Context, memberID uuid.UUID) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM member_recovery_codes WHERE id_member = $1`, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM member_totp WHERE id_member = $1`, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to delete member totp: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// ReplaceRecoveryCodes invalidates all recovery codes of the member and stores new ones
+func (r *repository) ReplaceRecoveryCodes(ctx context.Context, memberID uuid.UUID, codeHashes []string, createdAt time.Time) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := replaceRecoveryCodes(ctx, tx, memberID, codeHashes, createdAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseRecoveryCode marks an unused recovery code of the member as used and reports whether one matched
+func (r *repository) UseRecoveryCode(ctx context.Context, memberID uuid.UUID, codeHash string, usedAt time.Time) (bool, error) {
+	query := `
+		UPDATE member_recovery_codes
+		SET used_at = $3
+		WHERE id = (
+			SELECT id FROM member_recovery_codes
+			WHERE id_member = $1 AND code_hash = $2 AND used_at IS NULL
+			LIMIT 1
+			FOR UPDATE
+		)
+	`
+	result, err := r.conn.ExecContext(ctx, query, memberID, codeHash, usedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// CountUnusedRecoveryCodes returns how many recovery codes the member has left
+func (r *repository) CountUnusedRecoveryCodes(ctx context.Context, memberID uuid.UUID) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM member_recovery_codes WHERE id_member = $1 AND used_at IS NULL`
+	err := r.conn.GetContext(ctx, &count, query, memberID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+	return count, nil
+}
+
+// CreateMFAChallenge inserts a pending second factor login
+func (r *repository) CreateMFAChallenge(ctx context.Context, challenge *models.MFAChallenge) error {
+	query := `
+		INSERT INTO mfa_challenges (id, id_member, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		challenge.ID,
+		challenge.IDMember,
+		challenge.TokenHash,
+		challenge.ExpiresAt,
+		challenge.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+	return nil
+}
+
+// GetMFAChallengeByHash retrieves an unused, unexpired challenge by token hash
+func (r *repository) GetMFAChallengeByHash(ctx context.Context, tokenHash string) (*models.MFAChallenge, error) {
+	var challenge models.MFAChallenge
+	query := `
+		SELECT id, id_member, token_hash, attempts, expires_at, used_at, created_at
+		FROM mfa_challenges
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+	`
+	err := r.conn.GetContext(ctx, &challenge, query, tokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mfa challenge: %w", err)
+	}
+	return &challenge, nil
+}
+
+// IncrementMFAChallengeAttempts records a failed code and returns the new number of attempts
+func (r *repository) IncrementMFAChallengeAttempts(ctx context.Context, challengeID uuid.UUID) (int, error) {
+	var attempts int
+	query := `UPDATE mfa_challenges SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts`
+	err := r.conn.GetContext(ctx, &attempts, query, challengeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update mfa challenge: %w", err)
+	}
+	return attempts, nil
+}
+
+// ConsumeMFAChallenge marks a challenge as used.
+// It returns sql.ErrNoRows when the challenge was already used.
+func (r *repository) ConsumeMFAChallenge(ctx context.Context, challengeID uuid.UUID, usedAt time.Time) error {
+	query := `UPDATE mfa_challenges SET used_at = $2 WHERE id = $1 AND used_at IS NULL`
+	result, err := r.conn.ExecContext(ctx, query, challengeID, usedAt)
+	if err != nil {
+		return fmt.Errorf("failed to consume mfa challenge: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sqlx.Tx, memberID uuid.UUID, codeHashes []string, createdAt time.Time) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM member_recovery_codes WHERE id_member = $1`, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	for _, codeHash := range codeHashes {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO member_recovery_codes (id, id_member, code_hash, created_at)
+			VALUES ($1, $2, $3, $4)
+		`, uuid.New(), memberID, codeHash, createdAt)
+		if err != nil {
+			return fmt.Errorf("failed to create recovery code: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+const notificationColumns = `id, id_member, type, id_board, id_card, id_actor, data, read_at, created_at`
+
+// CreateNotifications inserts notifications for one or more recipients
+func (r *repository) CreateNotifications(ctx context.Context, notifications []*models.Notification) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO notifications (id, id_member, type, id_board, id_card, id_actor, data, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	for _, notification := range notifications {
+		_, err = tx.ExecContext(ctx, query,
+			notification.ID,
+			notification.IDMember,
+			notification.Type,
+			notification.IDBoard,
+			notification.IDCard,
+			notification.IDActor,
+			notification.Data,
+			notification.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetMemberNotifications retrieves the notifications of a member with pagination, newest first.
+// It also returns the total matching the filter and the member's unread count.
+func (r *repository) GetMemberNotifications(ctx context.Context, memberID uuid.UUID, unreadOnly bool, limit, offset int) ([]*models.Notification, int, int, error) {
+	notifications := []*models.Notification{}
+
+	var counts struct {
+		Total  int `db:"total"`
+		Unread int `db:"unread"`
+	}
+	countQuery := `
+		SELECT COUNT(*) AS total, COUNT(*) FILTER (WHERE read_at IS NULL) AS unread
+		FROM notifications
+		WHERE id_member = $1
+	`
+	err := r.conn.GetContext(ctx, &counts, countQuery, memberID)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	whereClause := " WHERE id_member = $1"
+	total := counts.Total
+	if unreadOnly {
+		whereClause += " AND read_at IS NULL"
+		total = counts.Unread
+	}
+
+	query := `SELECT ` + notificationColumns + ` FROM notifications` + whereClause + ` ORDER BY created_at DESC LIMIT $2 OFFSET $3`
+	err = r.conn.SelectContext(ctx, &notifications, query, memberID, limit, offset)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	return notifications, total, counts.Unread, nil
+}
+
+// MarkNotificationRead marks a notification of a member as read, already read notifications keep their time
+func (r *repository) MarkNotificationRead(ctx context.Context, notificationID, memberID uuid.UUID, readAt time.Time) error {
+	query := `
+		UPDATE notifications
+		SET read_at = COALESCE(read_at, $3)
+		WHERE id = $1 AND id_member = $2
+	`
+	result, err := r.conn.ExecContext(ctx, query, notificationID, memberID, readAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification read: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// MarkAllNotificationsRead marks every unread notification of a member as read and returns how many were unread
+func (r *repository) MarkAllNotificationsRead(ctx context.Context, memberID uuid.UUID, readAt time.Time) (int64, error) {
+	result, err := r.conn.ExecContext(ctx, `UPDATE notifications SET read_at = $2 WHERE id_member = $1 AND read_at IS NULL`, memberID, readAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// DeleteOldNotifications removes up to limit notifications, read ones created before readBefore
+// and unread ones created before unreadBefore, and returns how many were removed
+func (r *repository) DeleteOldNotifications(ctx context.Context, readBefore, unreadBefore time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM notifications
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+			WHERE (read_at IS NOT NULL AND created_at < $1) OR created_at < $2
+			LIMIT $3
+		)
+	`
+	result, err := r.conn.ExecContext(ctx, query, readBefore, unreadBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old notifications: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// GetDigestRecipients retrieves up to limit members ordered by ID after afterMemberID who have unread
+// notifications created after their last email digest. Deleted members and unverified email addresses are excluded.
+func (r *repository) GetDigestRecipients(ctx context.Context, afterMemberID uuid.UUID, limit int) ([]*models.DigestRecipient, error) {
+	recipients := []*models.DigestRecipient{}
+
+	query := `
+		SELECT m.id AS id_member, m.email, m.username, d.last_digest_at
+		FROM members m
+		LEFT JOIN member_email_digests d ON d.id_member = m.id
+		WHERE m.id > $1 AND m.deleted_at IS NULL AND m.email_verified_at IS NOT NULL
+			AND EXISTS (
+				SELECT 1
+				FROM notifications n
+				WHERE n.id_member = m.id AND n.read_at IS NULL
+					AND (d.last_digest_at IS NULL OR n.created_at > d.last_digest_at)
+			)
+		ORDER BY m.id
+		LIMIT $2
+	`
+	err := r.conn.SelectContext(ctx, &recipients, query, afterMemberID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get digest recipients: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// GetDigestNotifications retrieves up to limit unread notifications of a member created after since and up to until,
+// oldest first, with board and actor names. It also returns how many match in total.
+func (r *repository) GetDigestNotifications(ctx context.Context, memberID uuid.UUID, since *time.Time, until time.Time, limit int) ([]*models.DigestNotification, int, error) {
+	notifications := []*models.DigestNotification{}
+
+	whereClause := `
+		WHERE n.id_member = $1 AND n.read_at IS NULL AND n.created_at <= $2
+			AND ($3::timestamptz IS NULL OR n.created_at > $3)
+	`
+
+	var total int
+	err := r.conn.GetContext(ctx, &total, `SELECT COUNT(*) FROM notifications n`+whereClause, memberID, until, since)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count digest notifications: %w", err)
+	}
+
+	query := `
+		SELECT n.id, n.id_member, n.type, n.id_board, n.id_card, n.id_actor, n.data, n.read_at, n.created_at,
+			b.name AS board_name, a.username AS actor_username
+		FROM notifications n
+		LEFT JOIN boards b ON b.id = n.id_board
+		LEFT JOIN members a ON a.id = n.id_actor
+	` + whereClause + `
+		ORDER BY n.created_at
+		LIMIT $4
+	`
+	err = r.conn.SelectContext(ctx, &notifications, query, memberID, until, since, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get digest notifications: %w", err)
+	}
+
+	return notifications, total, nil
+}
+
+// SetLastDigestAt records up to when a member's notifications were handled by an email digest
+func (r *repository) SetLastDigestAt(ctx context.Context, memberID uuid.UUID, lastDigestAt time.Time) error {
+	query := `
+		INSERT INTO member_email_digests (id_member, last_digest_at)
+		VALUES ($1, $2)
+		ON CONFLICT (id_member) DO UPDATE SET last_digest_at = EXCLUDED.last_digest_at
+	`
+	_, err := r.conn.ExecContext(ctx, query, memberID, lastDigestAt)
+	if err != nil {
+		return fmt.Errorf("failed to set last digest time: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetMemberPreferences retrieves the stored preferences document of a member, nil if the member never changed any
+func (r *repository) GetMemberPreferences(ctx context.Context, memberID uuid.UUID) ([]byte, error) {
+	var preferences []byte
+	err := r.conn.GetContext(ctx, &preferences, `SELECT preferences FROM member_preferences WHERE id_member = $1`, memberID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member preferences: %w", err)
+	}
+	return preferences, nil
+}
+
+// MergeMemberPreferences merges changed preferences into the stored document of a member in one statement,
+// so concurrent updates of different preferences don't overwrite each other. The notifications object is merged
+// key by key as well. It returns the merged document.
+func (r *repository) MergeMemberPreferences(ctx context.Context, memberID uuid.UUID, changes []byte, updatedAt time.Time) ([]byte, error) {
+	var preferences []byte
+	query := `
+		INSERT INTO member_preferences (id_member, preferences, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id_member) DO UPDATE
+		SET preferences = member_preferences.preferences || EXCLUDED.preferences || CASE
+				WHEN EXCLUDED.preferences->'notifications' IS NULL THEN '{}'::jsonb
+				ELSE jsonb_build_object('notifications',
+					COALESCE(member_preferences.preferences->'notifications', '{}'::jsonb) || (EXCLUDED.preferences->'notifications'))
+			END,
+			updated_at = EXCLUDED.updated_at
+		RETURNING preferences
+	`
+	err := r.conn.GetContext(ctx, &preferences, query, memberID, changes, updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save member preferences: %w", err)
+	}
+	return preferences, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// TakeRateLimitToken takes a token from the bucket of a key, creating a full bucket on first use.
+// The bucket row is locked for the update so concurrent instances share one limit.
+func (r *repository) TakeRateLimitToken(ctx context.Context, key string, capacity int, refillPerSecond float64, now time.Time) (*models.RateLimitBucket, bool, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO NOTHING
+	`, key, capacity, now)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create rate limit bucket: %w", err)
+	}
+
+	var bucket models.RateLimitBucket
+	err = tx.GetContext(ctx, &bucket, `
+		SELECT key, tokens, updated_at
+		FROM rate_limit_buckets
+		WHERE key = $1
+		FOR UPDATE
+	`, key)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get rate limit bucket: %w", err)
+	}
+
+	allowed := bucket.Take(now, capacity, refillPerSecond)
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3 WHERE key = $1
+	`, bucket.Key, bucket.Tokens, bucket.UpdatedAt)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to update rate limit bucket: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &bucket, allowed, nil
+}
+
+// DeleteIdleRateLimitBuckets removes buckets not used since the given time, they would be full again
+func (r *repository) DeleteIdleRateLimitBuckets(ctx context.Context, before time.Time) error {
+	query := `DELETE FROM rate_limit_buckets WHERE updated_at < $1`
+	_, err := r.conn.ExecContext(ctx, query, before)
+	if err != nil {
+		return fmt.Errorf("failed to delete idle rate limit buckets: %w", err)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -25,9 +26,20 @@ type Config struct {
 type Repository interface {
 	MemberRepository
 	TokenRepository
+	MFARepository
+	IdentityRepository
+	AuthFailureRepository
+	RateLimitRepository
+	DataExportRepository
+	PreferencesRepository
+	NotificationRepository
 	BoardRepository
 	ListRepository
 	CardRepository
+	LabelRepository
+	CustomFieldRepository
+	WatcherRepository
+	WebhookRepository
 }
 
 type MemberRepository interface {
@@ -35,7 +47,11 @@ type MemberRepository interface {
 	GetMemberByEmail(ctx context.Context, email string) (*models.Member, error)
 	GetMemberByID(ctx context.Context, id uuid.UUID) (*models.Member, error)
 	GetMemberByUsername(ctx context.Context, username string) (*models.Member, error)
+	SearchMembers(ctx context.Context, memberID uuid.UUID, query string, limit int) ([]*models.Member, error)
 	UpdateMember(ctx context.Context, member *models.Member) error
+	UpdateMemberAvatar(ctx context.Context, memberID uuid.UUID, avatarID *uuid.UUID, updatedAt time.Time) error
+	DeleteMember(ctx context.Context, memberID uuid.UUID, transfers []models.BoardOwnershipTransfer, deleteBoardIDs []uuid.UUID, deletedAt time.Time) error
+	CreateSecurityEvent(ctx context.Context, event *models.SecurityEvent) error
 }
 
 type TokenRepository interface {
@@ -44,13 +60,92 @@ type TokenRepository interface {
 	RevokeRefreshToken(ctx context.Context, tokenHash string) error
 	RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error
 	DeleteExpiredTokens(ctx context.Context) error
+	CreatePersonalAccessToken(ctx context.Context, token *models.PersonalAccessToken) error
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error)
+	GetMemberPersonalAccessTokens(ctx context.Context, memberID uuid.UUID) ([]*models.PersonalAccessToken, error)
+	DeletePersonalAccessToken(ctx context.Context, tokenID, memberID uuid.UUID) error
+	TouchPersonalAccessToken(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error
+	CreatePasswordResetToken(ctx context.Context, token *models.PasswordResetToken) error
+	ResetMemberPassword(ctx context.Context, tokenHash, passwordHash string, resetAt time.Time) (uuid.UUID, error)
+	CreateEmailVerificationToken(ctx context.Context, token *models.EmailVerificationToken) error
+	GetLastEmailVerificationToken(ctx context.Context, memberID uuid.UUID) (*models.EmailVerificationToken, error)
+	VerifyMemberEmail(ctx context.Context, tokenHash string, verifiedAt time.Time) (uuid.UUID, error)
+}
+
+type MFARepository interface {
+	GetMemberTOTP(ctx context.Context, memberID uuid.UUID) (*models.MemberTOTP, error)
+	SavePendingTOTP(ctx context.Context, totp *models.MemberTOTP) error
+	ConfirmMemberTOTP(ctx context.Context, memberID uuid.UUID, step int64, confirmedAt time.Time, codeHashes []string) error
+	UseTOTPStep(ctx context.Context, memberID uuid.UUID, step int64) error
+	DeleteMemberTOTP(ctx context.Context, memberID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, memberID uuid.UUID, codeHashes []string, createdAt time.Time) error
+	UseRecoveryCode(ctx context.Context, memberID uuid.UUID, codeHash string, usedAt time.Time) (bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, memberID uuid.UUID) (int, error)
+	CreateMFAChallenge(ctx context.Context, challenge *models.MFAChallenge) error
+	GetMFAChallengeByHash(ctx context.Context, tokenHash string) (*models.MFAChallenge, error)
+	IncrementMFAChallengeAttempts(ctx context.Context, challengeID uuid.UUID) (int, error)
+	ConsumeMFAChallenge(ctx context.Context, challengeID uuid.UUID, usedAt time.Time) error
+}
+
+type IdentityRepository interface {
+	GetMemberIdentity(ctx context.Context, provider, subject string) (*models.MemberIdentity, error)
+	CreateMemberIdentity(ctx context.Context, identity *models.MemberIdentity) error
+	CreateMemberWithIdentity(ctx context.Context, member *models.Member, identity *models.MemberIdentity) error
+	CreateOIDCLoginState(ctx context.Context, state *models.OIDCLoginState) error
+	ConsumeOIDCLoginState(ctx context.Context, provider, stateHash string) (*models.OIDCLoginState, error)
+}
+
+type AuthFailureRepository interface {
+	CountAuthAttempt(ctx context.Context, scope, key string, attemptAt, resetBefore time.Time, policy models.LockoutPolicy) (*models.AuthFailure, bool, error)
+	ReleaseAuthAttempt(ctx context.Context, scope, key string, maxFailures int) error
+	ClearAuthFailure(ctx context.Context, scope, key string) error
+	DeleteExpiredAuthFailures(ctx context.Context, resetBefore, now time.Time, limit int) (int64, error)
+}
+
+type RateLimitRepository interface {
+	TakeRateLimitToken(ctx context.Context, key string, capacity int, refillPerSecond float64, now time.Time) (*models.RateLimitBucket, bool, error)
+	DeleteIdleRateLimitBuckets(ctx context.Context, before time.Time) error
+}
+
+type DataExportRepository interface {
+	CreateMemberDataExport(ctx context.Context, export *models.MemberDataExport) error
+	GetMemberDataExport(ctx context.Context, exportID, memberID uuid.UUID) (*models.MemberDataExport, error)
+	GetActiveMemberDataExport(ctx context.Context, memberID uuid.UUID, format string) (*models.MemberDataExport, error)
+	ClaimMemberDataExport(ctx context.Context, startedAt, staleBefore time.Time) (*models.MemberDataExport, error)
+	CompleteMemberDataExport(ctx context.Context, export *models.MemberDataExport) error
+	GetExpiredMemberDataExports(ctx context.Context, now time.Time, limit int) ([]*models.MemberDataExport, error)
+	DeleteMemberDataExport(ctx context.Context, exportID uuid.UUID) error
+	CountMemberCards(ctx context.Context, memberID uuid.UUID) (int, error)
+	IterateMemberCards(ctx context.Context, memberID uuid.UUID, fn func(card *models.Card) error) error
+	GetMemberBoardMemberships(ctx context.Context, memberID uuid.UUID) ([]*models.MemberBoardMembership, error)
+	GetMemberStarredBoards(ctx context.Context, memberID uuid.UUID) ([]*models.StarredBoard, error)
+	GetMemberRefreshTokens(ctx context.Context, memberID uuid.UUID) ([]*models.RefreshToken, error)
+	GetMemberSecurityEvents(ctx context.Context, memberID uuid.UUID) ([]*models.SecurityEvent, error)
+	GetMemberIdentities(ctx context.Context, memberID uuid.UUID) ([]*models.MemberIdentity, error)
+}
+
+type PreferencesRepository interface {
+	GetMemberPreferences(ctx context.Context, memberID uuid.UUID) ([]byte, error)
+	MergeMemberPreferences(ctx context.Context, memberID uuid.UUID, changes []byte, updatedAt time.Time) ([]byte, error)
+}
+
+type NotificationRepository interface {
+	CreateNotifications(ctx context.Context, notifications []*models.Notification) error
+	GetMemberNotifications(ctx context.Context, memberID uuid.UUID, unreadOnly bool, limit, offset int) ([]*models.Notification, int, int, error)
+	MarkNotificationRead(ctx context.Context, notificationID, memberID uuid.UUID, readAt time.Time) error
+	MarkAllNotificationsRead(ctx context.Context, memberID uuid.UUID, readAt time.Time) (int64, error)
+	DeleteOldNotifications(ctx context.Context, readBefore, unreadBefore time.Time, limit int) (int64, error)
+	GetDigestRecipients(ctx context.Context, afterMemberID uuid.UUID, limit int) ([]*models.DigestRecipient, error)
+	GetDigestNotifications(ctx context.Context, memberID uuid.UUID, since *time.Time, until time.Time, limit int) ([]*models.DigestNotification, int, error)
+	SetLastDigestAt(ctx context.Context, memberID uuid.UUID, lastDigestAt time.Time) error
 }
 
 type BoardRepository interface {
 	CreateBoard(ctx context.Context, board *models.Board) error
 	GetBoardByID(ctx context.Context, boardID uuid.UUID) (*models.Board, error)
 	GetBoardByUniqueName(ctx context.Context, uniqueName string) (*models.Board, error)
-	GetBoardsByMemberID(ctx context.Context, memberID uuid.UUID, starredOnly bool, limit, offset int) ([]*models.Board, int, error)
+	GetBoardsByMemberID(ctx context.Context, memberID uuid.UUID, starredOnly bool, sort string, limit, offset int) ([]*models.Board, int, error)
+	GetOwnedBoards(ctx context.Context, memberID uuid.UUID) ([]*models.Board, error)
 	UpdateBoard(ctx context.Context, board *models.Board) error
 	DeleteBoard(ctx context.Context, boardID uuid.UUID) error
 	GetBoardMember(ctx context.Context, boardID, memberID uuid.UUID) (*models.BoardMember, error)
@@ -61,6 +156,9 @@ type BoardRepository interface {
 	UnstarBoard(ctx context.Context, boardID, memberID uuid.UUID) error
 	IsStarred(ctx context.Context, boardID, memberID uuid.UUID) (bool, error)
 	GetBoardLists(ctx context.Context, boardID uuid.UUID) ([]*models.List, error)
+	GetAllBoardLists(ctx context.Context, boardID uuid.UUID) ([]*models.List, error)
+	GetBoardMembersWithRoles(ctx context.Context, boardID uuid.UUID) ([]*models.BoardMemberWithRole, error)
+	ImportBoard(ctx context.Context, data *models.BoardImport) error
 }
 
 type ListRepository interface {
@@ -71,17 +169,61 @@ type ListRepository interface {
 	GetListCards(ctx context.Context, listID uuid.UUID) ([]*models.Card, error)
 	GetMaxListPosition(ctx context.Context, boardID uuid.UUID) (float64, error)
 	GetListCountInBoard(ctx context.Context, boardID uuid.UUID) (int, error)
+	ArchiveAllListCards(ctx context.Context, listID uuid.UUID, updatedAt time.Time) ([]*models.CardChange, error)
+	MoveAllListCards(ctx context.Context, sourceListID, targetListID uuid.UUID, updatedAt time.Time, wipLimit *models.WIPLimitCheck) ([]*models.CardChange, error)
 }
 
 type CardRepository interface {
-	CreateCard(ctx context.Context, card *models.Card) error
+	CreateCard(ctx context.Context, card *models.Card, wipLimit *models.WIPLimitCheck) error
 	GetCardByID(ctx context.Context, cardID uuid.UUID) (*models.Card, error)
-	UpdateCard(ctx context.Context, card *models.Card) error
+	UpdateCard(ctx context.Context, card *models.Card, wipLimit *models.WIPLimitCheck) error
 	DeleteCard(ctx context.Context, cardID uuid.UUID) error
 	GetMaxCardPosition(ctx context.Context, listID uuid.UUID) (float64, error)
 	GetCardCountInList(ctx context.Context, listID uuid.UUID) (int, error)
 	GetBoardIDByCardID(ctx context.Context, cardID uuid.UUID) (uuid.UUID, error)
 	GetBoardIDByListID(ctx context.Context, listID uuid.UUID) (uuid.UUID, error)
+	GetBoardIDsByCardIDs(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+	ApplyBulkCardOperation(ctx context.Context, op *models.BulkCardOperation) ([]*models.CardChange, error)
+	IterateBoardCards(ctx context.Context, boardID uuid.UUID, fn func(card *models.Card, labelIDs []uuid.UUID) error) error
+}
+
+type LabelRepository interface {
+	CreateLabel(ctx context.Context, label *models.Label) error
+	GetLabelByID(ctx context.Context, labelID uuid.UUID) (*models.Label, error)
+	GetBoardLabels(ctx context.Context, boardID uuid.UUID) ([]*models.Label, error)
+}
+
+type CustomFieldRepository interface {
+	CreateCustomField(ctx context.Context, field *models.CustomField) error
+	GetCustomFieldByID(ctx context.Context, fieldID uuid.UUID) (*models.CustomField, error)
+	GetBoardCustomFields(ctx context.Context, boardID uuid.UUID) ([]*models.CustomField, error)
+	UpdateCustomField(ctx context.Context, field *models.CustomField) error
+	DeleteCustomField(ctx context.Context, fieldID uuid.UUID) error
+	SetCardCustomFieldValue(ctx context.Context, value *models.CardCustomFieldValue) error
+	DeleteCardCustomFieldValue(ctx context.Context, cardID, fieldID uuid.UUID) error
+	GetCardCustomFieldValues(ctx context.Context, cardID uuid.UUID) ([]*models.CardCustomFieldValue, error)
+	GetBoardCustomFieldValues(ctx context.Context, boardID uuid.UUID) ([]*models.CardCustomFieldValue, error)
+}
+
+type WatcherRepository interface {
+	AddWatcher(ctx context.Context, memberID uuid.UUID, targetType string, targetID uuid.UUID) error
+	RemoveWatcher(ctx context.Context, memberID uuid.UUID, targetType string, targetID uuid.UUID) error
+	IsWatching(ctx context.Context, memberID uuid.UUID, targetType string, targetID uuid.UUID) (bool, error)
+	GetCardWatcherIDs(ctx context.Context, boardID, listID, cardID, creatorID uuid.UUID) ([]uuid.UUID, error)
+	GetCardsWatcherIDs(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *models.Webhook) error
+	GetWebhookByID(ctx context.Context, webhookID uuid.UUID) (*models.Webhook, error)
+	GetBoardWebhooks(ctx context.Context, boardID uuid.UUID) ([]*models.Webhook, error)
+	GetActiveWebhooksForEvent(ctx context.Context, boardID uuid.UUID, event string) ([]*models.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *models.Webhook) error
+	DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error
+	ClaimWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]*models.WebhookDelivery, error)
+	RecordWebhookAttempt(ctx context.Context, delivery *models.WebhookDelivery, log *models.WebhookDeliveryLog) error
+	GetWebhookDeliveryLogs(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]*models.WebhookDeliveryLog, int, error)
 }
 
 type repository struct {
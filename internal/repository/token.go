@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tasks-control/core-back-end/internal/models"
@@ -55,15 +56,39 @@ func (r *repository) RevokeRefreshToken(ctx context.Context, tokenHash string) e
 	return err
 }
 
-// RevokeAllUserTokens revokes all refresh tokens for a user
+// RevokeAllUserTokens revokes all refresh tokens, deletes all personal access tokens and invalidates unused
+// password reset tokens for a user. A reset link sent to a previous email address can't be used afterwards.
 func (r *repository) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
-	query := `
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
 		UPDATE refresh_tokens
 		SET revoked = true
 		WHERE id_member = $1 AND revoked = false
-	`
-	_, err := r.conn.ExecContext(ctx, query, userID)
-	return err
+	`, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM personal_access_tokens WHERE id_member = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE password_reset_tokens
+		SET used_at = NOW()
+		WHERE id_member = $1 AND used_at IS NULL
+	`, userID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // DeleteExpiredTokens removes expired tokens from the database
@@ -75,3 +100,291 @@ func (r *repository) DeleteExpiredTokens(ctx context.Context) error {
 	_, err := r.conn.ExecContext(ctx, query)
 	return err
 }
+
+// CreatePersonalAccessToken inserts a new personal access token into the database
+func (r *repository) CreatePersonalAccessToken(ctx context.Context, token *models.PersonalAccessToken) error {
+	query := `
+		INSERT INTO personal_access_tokens (id, id_member, name, token_hash, token_prefix, scopes, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		token.ID,
+		token.IDMember,
+		token.Name,
+		token.TokenHash,
+		token.TokenPrefix,
+		token.Scopes,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	return err
+}
+
+// GetPersonalAccessTokenByHash retrieves a non-expired personal access token by its hash
+func (r *repository) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error) {
+	var token models.PersonalAccessToken
+	query := `
+		SELECT id, id_member, name, token_hash, token_prefix, scopes, expires_at, last_used_at, created_at
+		FROM personal_access_tokens
+		WHERE token_hash = $1 AND (expires_at IS NULL OR expires_at > NOW())
+	`
+	err := r.conn.GetContext(ctx, &token, query, tokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetMemberPersonalAccessTokens retrieves all personal access tokens of a member, including expired ones
+func (r *repository) GetMemberPersonalAccessTokens(ctx context.Context, memberID uuid.UUID) ([]*models.PersonalAccessToken, error) {
+	tokens := []*models.PersonalAccessToken{}
+	query := `
+		SELECT id, id_member, name, token_hash, token_prefix, scopes, expires_at, last_used_at, created_at
+		FROM personal_access_tokens
+		WHERE id_member = $1
+		ORDER BY created_at DESC
+	`
+	err := r.conn.SelectContext(ctx, &tokens, query, memberID)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// DeletePersonalAccessToken deletes a personal access token owned by the member
+func (r *repository) DeletePersonalAccessToken(ctx context.Context, tokenID, memberID uuid.UUID) error {
+	query := `DELETE FROM personal_access_tokens WHERE id = $1 AND id_member = $2`
+	result, err := r.conn.ExecContext(ctx, query, tokenID, memberID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// TouchPersonalAccessToken sets the last used timestamp of a personal access token
+func (r *repository) TouchPersonalAccessToken(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET last_used_at = $2
+		WHERE id = $1
+	`
+	_, err := r.conn.ExecContext(ctx, query, tokenID, usedAt)
+	return err
+}
+
+// CreatePasswordResetToken invalidates unused reset tokens of the member and inserts a new one
+func (r *repository) CreatePasswordResetToken(ctx context.Context, token *models.PasswordResetToken) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Only the most recently issued token can be used
+	_, err = tx.ExecContext(ctx, `
+		UPDATE password_reset_tokens
+		SET used_at = $2
+		WHERE id_member = $1 AND used_at IS NULL
+	`, token.IDMember, token.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO password_reset_tokens (id, id_member, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`,
+		token.ID,
+		token.IDMember,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ResetMemberPassword consumes a valid reset token, sets the new password hash and revokes all sessions
+// of the member in one transaction. It returns uuid.Nil when the token is unknown, used or expired.
+func (r *repository) ResetMemberPassword(ctx context.Context, tokenHash, passwordHash string, resetAt time.Time) (uuid.UUID, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer tx.Rollback()
+
+	// Mark token as used, the condition makes it single-use under concurrency
+	var memberID uuid.UUID
+	err = tx.GetContext(ctx, &memberID, `
+		UPDATE password_reset_tokens
+		SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING id_member
+	`, tokenHash, resetAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Update password and reject access tokens issued before the reset
+	_, err = tx.ExecContext(ctx, `
+		UPDATE members
+		SET password_hash = $2, tokens_valid_after = $3, updated_at = $4
+		WHERE id = $1
+	`, memberID, passwordHash, resetAt.Truncate(time.Second).Add(time.Second), resetAt)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Revoke refresh tokens
+	_, err = tx.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET revoked = true
+		WHERE id_member = $1 AND revoked = false
+	`, memberID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Delete personal access tokens, they would outlive the reset otherwise
+	_, err = tx.ExecContext(ctx, `DELETE FROM personal_access_tokens WHERE id_member = $1`, memberID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Invalidate any other unused reset link
+	_, err = tx.ExecContext(ctx, `
+		UPDATE password_reset_tokens
+		SET used_at = $2
+		WHERE id_member = $1 AND used_at IS NULL
+	`, memberID, resetAt)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return memberID, nil
+}
+
+// CreateEmailVerificationToken invalidates unused verification tokens of the member and inserts a new one
+func (r *repository) CreateEmailVerificationToken(ctx context.Context, token *models.EmailVerificationToken) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Only the most recently sent link can be used
+	_, err = tx.ExecContext(ctx, `
+		UPDATE email_verification_tokens
+		SET used_at = $2
+		WHERE id_member = $1 AND used_at IS NULL
+	`, token.IDMember, token.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO email_verification_tokens (id, id_member, email, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`,
+		token.ID,
+		token.IDMember,
+		token.Email,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetLastEmailVerificationToken retrieves the most recently created verification token of a member
+func (r *repository) GetLastEmailVerificationToken(ctx context.Context, memberID uuid.UUID) (*models.EmailVerificationToken, error) {
+	var token models.EmailVerificationToken
+	query := `
+		SELECT id, id_member, email, token_hash, expires_at, used_at, created_at
+		FROM email_verification_tokens
+		WHERE id_member = $1
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	err := r.conn.GetContext(ctx, &token, query, memberID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// VerifyMemberEmail consumes a valid verification token and marks the email it was sent to as verified.
+// It returns uuid.Nil when the token is unknown, used, expired or the member changed email since.
+func (r *repository) VerifyMemberEmail(ctx context.Context, tokenHash string, verifiedAt time.Time) (uuid.UUID, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer tx.Rollback()
+
+	// Mark token as used
+	var token models.EmailVerificationToken
+	err = tx.GetContext(ctx, &token, `
+		UPDATE email_verification_tokens
+		SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING id, id_member, email, token_hash, expires_at, used_at, created_at
+	`, tokenHash, verifiedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Verify the email only if it is still the member's address
+	result, err := tx.ExecContext(ctx, `
+		UPDATE members
+		SET email_verified_at = COALESCE(email_verified_at, $3)
+		WHERE id = $1 AND email = $2
+	`, token.IDMember, token.Email, verifiedAt)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if rowsAffected == 0 {
+		return uuid.Nil, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return token.IDMember, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// watcherTargetColumns maps watch targets to the watchers column referencing them
+var watcherTargetColumns = map[string]string{
+	models.WatchTargetBoard: "id_board",
+	models.WatchTargetList:  "id_list",
+	models.WatchTargetCard:  "id_card",
+}
+
+func watcherTargetColumn(targetType string) (string, error) {
+	column, ok := watcherTargetColumns[targetType]
+	if !ok {
+		return "", fmt.Errorf("unknown watch target: %s", targetType)
+	}
+	return column, nil
+}
+
+// AddWatcher lets a member watch a board, list or card, watching twice is a no-op
+func (r *repository) AddWatcher(ctx context.Context, memberID uuid.UUID, targetType string, targetID uuid.UUID) error {
+	column, err := watcherTargetColumn(targetType)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO watchers (id, id_member, ` + column + `, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+	`
+	_, err = r.conn.ExecContext(ctx, query, uuid.New(), memberID, targetID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to add watcher: %w", err)
+	}
+	return nil
+}
+
+// RemoveWatcher stops a member watching a board, list or card
+func (r *repository) RemoveWatcher(ctx context.Context, memberID uuid.UUID, targetType string, targetID uuid.UUID) error {
+	column, err := watcherTargetColumn(targetType)
+	if err != nil {
+		return err
+	}
+
+	query := `DELETE FROM watchers WHERE id_member = $1 AND ` + column + ` = $2`
+	_, err = r.conn.ExecContext(ctx, query, memberID, targetID)
+	if err != nil {
+		return fmt.Errorf("failed to remove watcher: %w", err)
+	}
+	return nil
+}
+
+// IsWatching checks if a member watches a board, list or card itself
+func (r *repository) IsWatching(ctx context.Context, memberID uuid.UUID, targetType string, targetID uuid.UUID) (bool, error) {
+	column, err := watcherTargetColumn(targetType)
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM watchers WHERE id_member = $1 AND ` + column + ` = $2)`
+	err = r.conn.GetContext(ctx, &exists, query, memberID, targetID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check watcher: %w", err)
+	}
+	return exists, nil
+}
+
+// GetCardWatcherIDs retrieves the creator of a card and the members watching it directly or through its list or board.
+// Only current members of the board are returned, watches of members who left apply again if they rejoin.
+func (r *repository) GetCardWatcherIDs(ctx context.Context, boardID, listID, cardID, creatorID uuid.UUID) ([]uuid.UUID, error) {
+	memberIDs := []uuid.UUID{}
+	query := `
+		SELECT bm.id_member
+		FROM board_members bm
+		INNER JOIN members m ON m.id = bm.id_member AND m.deleted_at IS NULL
+		WHERE bm.id_board = $1
+			AND (
+				bm.id_member = $4
+				OR EXISTS (
+					SELECT 1
+					FROM watchers w
+					WHERE w.id_member = bm.id_member AND (w.id_board = $1 OR w.id_list = $2 OR w.id_card = $3)
+				)
+			)
+	`
+	err := r.conn.SelectContext(ctx, &memberIDs, query, boardID, listID, cardID, creatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card watchers: %w", err)
+	}
+	return memberIDs, nil
+}
+
+// GetCardsWatcherIDs retrieves the watchers of many cards in one query, following the rules of GetCardWatcherIDs.
+// Cards without watchers are absent from the returned map.
+func (r *repository) GetCardsWatcherIDs(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	rows := []struct {
+		CardID   uuid.UUID `db:"id_card"`
+		MemberID uuid.UUID `db:"id_member"`
+	}{}
+	query := `
+		SELECT c.id AS id_card, bm.id_member
+		FROM cards c
+		INNER JOIN lists l ON l.id = c.id_list
+		INNER JOIN board_members bm ON bm.id_board = l.id_board
+		INNER JOIN members m ON m.id = bm.id_member AND m.deleted_at IS NULL
+		WHERE c.id = ANY($1)
+			AND (
+				bm.id_member = c.created_by
+				OR EXISTS (
+					SELECT 1
+					FROM watchers w
+					WHERE w.id_member = bm.id_member AND (w.id_board = l.id_board OR w.id_list = c.id_list OR w.id_card = c.id)
+				)
+			)
+	`
+	err := r.conn.SelectContext(ctx, &rows, query, pq.Array(cardIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cards watchers: %w", err)
+	}
+
+	watchers := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		watchers[row.CardID] = append(watchers[row.CardID], row.MemberID)
+	}
+	return watchers, nil
+}
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// CreateWebhook inserts a new webhook into the database
+func (r *repository) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	query := `
+		INSERT INTO webhooks (id, id_board, url, secret, events, active, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.conn.ExecContext(ctx, query,
+		webhook.ID,
+		webhook.IDBoard,
+		webhook.URL,
+		webhook.Secret,
+		webhook.Events,
+		webhook.Active,
+		webhook.CreatedBy,
+		webhook.CreatedAt,
+		webhook.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return nil
+}
+
+// GetWebhookByID retrieves a webhook by ID
+func (r *repository) GetWebhookByID(ctx context.Context, webhookID uuid.UUID) (*models.Webhook, error) {
+	var webhook models.Webhook
+	query := `
+		SELECT id, id_board, url, secret, events, active, created_by, created_at, updated_at
+		FROM webhooks
+		WHERE id = $1
+	`
+	err := r.conn.GetContext(ctx, &webhook, query, webhookID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return &webhook, nil
+}
+
+// GetBoardWebhooks retrieves all webhooks of a board
+func (r *repository) GetBoardWebhooks(ctx context.Context, boardID uuid.UUID) ([]*models.Webhook, error) {
+	webhooks := []*models.Webhook{}
+	query := `
+		SELECT id, id_board, url, secret, events, active, created_by, created_at, updated_at
+		FROM webhooks
+		WHERE id_board = $1
+		ORDER BY created_at ASC
+	`
+	err := r.conn.SelectContext(ctx, &webhooks, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+// GetActiveWebhooksForEvent retrieves active webhooks of a board subscribed to an event.
+// A webhook with an empty event filter is subscribed to every event.
+func (r *repository) GetActiveWebhooksForEvent(ctx context.Context, boardID uuid.UUID, event string) ([]*models.Webhook, error) {
+	webhooks := []*models.Webhook{}
+	query := `
+		SELECT id, id_board, url, secret, events, active, created_by, created_at, updated_at
+		FROM webhooks
+		WHERE id_board = $1 AND active = true AND (cardinality(events) = 0 OR $2 = ANY(events))
+	`
+	err := r.conn.SelectContext(ctx, &webhooks, query, boardID, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks for event: %w", err)
+	}
+	return webhooks, nil
+}
+
+// UpdateWebhook updates an existing webhook
+func (r *repository) UpdateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET url = $2, secret = $3, events = $4, active = $5, updated_at = $6
+		WHERE id = $1
+	`
+	result, err := r.conn.ExecContext(ctx, query,
+		webhook.ID,
+		webhook.URL,
+		webhook.Secret,
+		webhook.Events,
+		webhook.Active,
+		webhook.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes a webhook (cascade will delete its deliveries and logs)
+func (r *repository) DeleteWebhook(ctx context.Context, webhookID uuid.UUID) error {
+	query := `DELETE FROM webhooks WHERE id = $1`
+	result, err := r.conn.ExecContext(ctx, query, webhookID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CreateWebhookDeliveries enqueues deliveries in one transaction
+func (r *repository) CreateWebhookDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO webhook_deliveries (id, id_webhook, event, payload, status, attempts, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	for _, delivery := range deliveries {
+		_, err = tx.ExecContext(ctx, query,
+			delivery.ID,
+			delivery.IDWebhook,
+			delivery.Event,
+			delivery.Payload,
+			delivery.Status,
+			delivery.Attempts,
+			delivery.NextAttemptAt,
+			delivery.CreatedAt,
+			delivery.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create webhook delivery: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ClaimWebhookDeliveries picks due pending deliveries and leases them until leaseUntil,
+// so concurrent workers (or a crashed one) never send the same delivery twice at once
+func (r *repository) ClaimWebhookDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]*models.WebhookDelivery, error) {
+	deliveries := []*models.WebhookDelivery{}
+	query := `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, id_webhook, event, payload, status, attempts, next_attempt_at, last_error, delivered_at, created_at, updated_at
+	`
+	err := r.conn.SelectContext(ctx, &deliveries, query, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookAttempt stores the new delivery state together with the attempt log
+func (r *repository) RecordWebhookAttempt(ctx context.Context, delivery *models.WebhookDelivery, log *models.WebhookDeliveryLog) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, delivered_at = $6
+		WHERE id = $1
+	`
+	result, err := tx.ExecContext(ctx, query,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	// Webhook was deleted while the attempt was in flight
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logQuery := `
+		INSERT INTO webhook_delivery_logs (id, id_delivery, id_webhook, attempt, status_code, error, duration_ms, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err = tx.ExecContext(ctx, logQuery,
+		log.ID,
+		log.IDDelivery,
+		log.IDWebhook,
+		log.Attempt,
+		log.StatusCode,
+		log.Error,
+		log.DurationMs,
+		log.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery log: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetWebhookDeliveryLogs retrieves delivery attempts of a webhook, newest first, with pagination
+func (r *repository) GetWebhookDeliveryLogs(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]*models.WebhookDeliveryLog, int, error) {
+	logs := []*models.WebhookDeliveryLog{}
+
+	// Get total count
+	var total int
+	countQuery := `SELECT COUNT(*) FROM webhook_delivery_logs WHERE id_webhook = $1`
+	err := r.conn.GetContext(ctx, &total, countQuery, webhookID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook delivery logs: %w", err)
+	}
+
+	// Get logs
+	query := `
+		SELECT
+			wl.id,
+			wl.id_delivery,
+			wl.id_webhook,
+			wd.event,
+			wd.status AS delivery_status,
+			wl.attempt,
+			wl.status_code,
+			wl.error,
+			wl.duration_ms,
+			wl.created_at
+		FROM webhook_delivery_logs wl
+		INNER JOIN webhook_deliveries wd ON wl.id_delivery = wd.id
+		WHERE wl.id_webhook = $1
+		ORDER BY wl.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+	err = r.conn.SelectContext(ctx, &logs, query, webhookID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get webhook delivery logs: %w", err)
+	}
+
+	return logs, total, nil
+}
@@ -8,6 +8,7 @@ import (
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	v1 "github.com/tasks-control/core-back-end/api/v1"
+	"github.com/tasks-control/core-back-end/internal/config"
 	"github.com/tasks-control/core-back-end/internal/middleware"
 	"github.com/tasks-control/core-back-end/internal/service"
 	"github.com/tasks-control/core-back-end/pkg/utils"
@@ -15,6 +16,8 @@ import (
 
 type HTTPHandlers interface {
 	HealthHandlers
+	WellKnownHandlers
+	FileHandlers
 	v1.ServerInterface
 }
 
@@ -22,16 +25,28 @@ type HealthHandlers interface {
 	Readiness(w http.ResponseWriter, r *http.Request)
 }
 
+type WellKnownHandlers interface {
+	GetJWKS(w http.ResponseWriter, r *http.Request)
+}
+
+type FileHandlers interface {
+	GetFile(w http.ResponseWriter, r *http.Request)
+}
+
 type Server struct {
 	httpServer *http.Server
 	handlers   HTTPHandlers
 	service    *service.Service
+	rateLimit  config.RateLimitConfig
+	cors       config.CORSConfig
 }
 
-func NewServer(handlers HTTPHandlers, svc *service.Service) *Server {
+func NewServer(handlers HTTPHandlers, svc *service.Service, rateLimit config.RateLimitConfig, corsConfig config.CORSConfig) *Server {
 	return &Server{
-		handlers: handlers,
-		service:  svc,
+		handlers:  handlers,
+		service:   svc,
+		rateLimit: rateLimit,
+		cors:      corsConfig,
 	}
 }
 
@@ -40,22 +55,42 @@ func (s *Server) Run(port string) {
 
 	corsOpts := cors.Options{
 		AllowedOrigins: []string{"https://*", "http://*"},
-		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE"},
+		AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		ExposedHeaders: []string{"RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
 		MaxAge:         300,
 	}
+	// Only the configured frontend origins may send cookies, the OIDC callback needs the state cookie
+	credentialedOpts := corsOpts
+	credentialedOpts.AllowedOrigins = s.cors.CredentialedOrigins
+	credentialedOpts.AllowCredentials = true
 
 	r := chi.NewRouter()
 	r.Use(chimiddleware.NoCache)
 	r.Use(chimiddleware.SetHeader("Content-Type", "application/json"))
-	r.Use(cors.Handler(corsOpts))
+	r.Use(corsHandler(corsOpts, credentialedOpts))
 
 	// Create auth middleware
 	authMiddleware := middleware.NewAuthMiddleware(s.service)
 
+	// Public keys for verifying tokens, served outside the API prefix where clients expect them
+	r.Get("/.well-known/jwks.json", s.handlers.GetJWKS)
+	// Uploaded files such as avatars are public, image tags can't send a token
+	r.Get("/files/*", s.handlers.GetFile)
+
 	r.Route("/api/core-back-end/v1", func(router chi.Router) {
+		var rateLimiter *middleware.RateLimiter
+		if s.rateLimit.Enabled {
+			rateLimiter = middleware.NewRateLimiter(s.rateLimit, s.service.Repo)
+			// Limit by IP address first, authentication rejects invalid tokens before the user limits
+			router.Use(rateLimiter.LimitByIP)
+		}
 		// Apply authentication middleware to all routes
 		// Public endpoints will be skipped inside the middleware
 		router.Use(authMiddleware.Authenticate)
+		// Limit after authentication so users are limited by ID instead of IP address
+		if rateLimiter != nil {
+			router.Use(rateLimiter.Limit)
+		}
 		router.Mount("/", v1.Handler(s.handlers))
 	})
 
@@ -71,3 +106,25 @@ func (s *Server) Run(port string) {
 		log.WithError(err).Fatal("failed to start server")
 	}
 }
+
+// corsHandler applies the credentialed options to requests from their origins and the public options to all others
+func corsHandler(public, credentialed cors.Options) func(http.Handler) http.Handler {
+	publicCORS := cors.Handler(public)
+	credentialedCORS := cors.Handler(credentialed)
+	origins := make(map[string]bool, len(credentialed.AllowedOrigins))
+	for _, origin := range credentialed.AllowedOrigins {
+		origins[origin] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		publicNext := publicCORS(next)
+		credentialedNext := credentialedCORS(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if origins[r.Header.Get("Origin")] {
+				credentialedNext.ServeHTTP(w, r)
+				return
+			}
+			publicNext.ServeHTTP(w, r)
+		})
+	}
+}
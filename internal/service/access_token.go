@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var (
+	ErrAccessTokenNotFound = errors.New("personal access token not found")
+	ErrInvalidAccessToken  = errors.New("invalid personal access token")
+	ErrInvalidTokenScope   = errors.New("unknown personal access token scope")
+	ErrInvalidTokenExpiry  = errors.New("token expiry must be in the future")
+	ErrTooManyAccessTokens = errors.New("personal access token limit reached")
+)
+
+const (
+	accessTokenBytes        = 32
+	accessTokenTouchPeriod  = time.Minute
+	maxAccessTokensByMember = 50
+)
+
+// CreateAccessTokenRequest represents the data needed to create a personal access token
+type CreateAccessTokenRequest struct {
+	MemberID  uuid.UUID
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time // Nil means the token never expires
+}
+
+// CreatePersonalAccessToken creates a personal access token and returns it with the plaintext token.
+// Only the hash is stored, so the plaintext can't be retrieved later.
+func (s *Service) CreatePersonalAccessToken(ctx context.Context, req CreateAccessTokenRequest) (*models.PersonalAccessToken, string, error) {
+	// Validate scopes and expiry
+	if len(req.Scopes) == 0 {
+		return nil, "", ErrInvalidTokenScope
+	}
+	for _, scope := range req.Scopes {
+		if !isValidTokenScope(scope) {
+			return nil, "", ErrInvalidTokenScope
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, "", ErrInvalidTokenExpiry
+	}
+
+	// Check token limit
+	existing, err := s.Repo.GetMemberPersonalAccessTokens(ctx, req.MemberID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get personal access tokens: %w", err)
+	}
+	if len(existing) >= maxAccessTokensByMember {
+		return nil, "", ErrTooManyAccessTokens
+	}
+
+	// Generate token
+	secret, err := utils.GenerateSecret(accessTokenBytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate personal access token: %w", err)
+	}
+	plaintext := models.PersonalAccessTokenPrefix + secret
+
+	token := &models.PersonalAccessToken{
+		ID:          uuid.New(),
+		IDMember:    req.MemberID,
+		Name:        req.Name,
+		TokenHash:   utils.HashToken(plaintext),
+		TokenPrefix: plaintext[:len(models.PersonalAccessTokenPrefix)+8],
+		Scopes:      req.Scopes,
+		ExpiresAt:   req.ExpiresAt,
+		CreatedAt:   time.Now(),
+	}
+
+	err = s.Repo.CreatePersonalAccessToken(ctx, token)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	return token, plaintext, nil
+}
+
+// GetPersonalAccessTokens retrieves all personal access tokens of a member
+func (s *Service) GetPersonalAccessTokens(ctx context.Context, memberID uuid.UUID) ([]*models.PersonalAccessToken, error) {
+	tokens, err := s.Repo.GetMemberPersonalAccessTokens(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal access tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// DeletePersonalAccessToken revokes a personal access token of a member
+func (s *Service) DeletePersonalAccessToken(ctx context.Context, tokenID, memberID uuid.UUID) error {
+	err := s.Repo.DeletePersonalAccessToken(ctx, tokenID, memberID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAccessTokenNotFound
+		}
+		return fmt.Errorf("failed to delete personal access token: %w", err)
+	}
+	return nil
+}
+
+// AuthenticatePersonalAccessToken resolves a plaintext personal access token to the token and its member
+func (s *Service) AuthenticatePersonalAccessToken(ctx context.Context, plaintext string) (*models.PersonalAccessToken, *models.Member, error) {
+	token, err := s.Repo.GetPersonalAccessTokenByHash(ctx, utils.HashToken(plaintext))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get personal access token: %w", err)
+	}
+	if token == nil {
+		return nil, nil, ErrInvalidAccessToken
+	}
+
+	member, err := s.Repo.GetMemberByID(ctx, token.IDMember)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	if member == nil {
+		return nil, nil, ErrInvalidAccessToken
+	}
+
+	// Record usage at most once per period to avoid a write on every request
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= accessTokenTouchPeriod {
+		if err := s.Repo.TouchPersonalAccessToken(ctx, token.ID, now); err != nil {
+			utils.Logger().WithError(err).Warn("Failed to update personal access token last used time")
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return token, member, nil
+}
+
+func isValidTokenScope(scope string) bool {
+	switch scope {
+	case models.ScopeBoardsRead, models.ScopeBoardsWrite, models.ScopeMembersRead, models.ScopeMembersWrite:
+		return true
+	}
+	return false
+}
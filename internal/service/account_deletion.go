@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/repository"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrOwnedBoardsNotTransferred = errors.New("owned boards with other members must be transferred before deleting the account")
+	ErrInvalidOwnershipTransfer  = errors.New("invalid board ownership transfer")
+)
+
+// OwnedBoardsError lists the shared boards blocking an account deletion. It matches ErrOwnedBoardsNotTransferred.
+type OwnedBoardsError struct {
+	Boards []*models.Board
+}
+
+func (e *OwnedBoardsError) Error() string {
+	return fmt.Sprintf("%d owned boards must be transferred before deleting the account", len(e.Boards))
+}
+
+func (e *OwnedBoardsError) Is(target error) bool {
+	return target == ErrOwnedBoardsNotTransferred
+}
+
+// DeleteAccountRequest represents the data needed to delete a member account
+type DeleteAccountRequest struct {
+	MemberID          uuid.UUID
+	CurrentPassword   string
+	TransferOwnership []models.BoardOwnershipTransfer // New owners of shared boards the member owns
+}
+
+// DeleteAccount deletes a member account. Boards owned together with other members must be transferred,
+// boards without other members are deleted. The member row is anonymised instead of deleted,
+// so cards and boards on shared boards stay, and all tokens are revoked.
+func (s *Service) DeleteAccount(ctx context.Context, req DeleteAccountRequest) error {
+	// Get current member
+	member, err := s.Repo.GetMemberByID(ctx, req.MemberID)
+	if err != nil {
+		return fmt.Errorf("failed to get member: %w", err)
+	}
+	if member == nil || member.DeletedAt != nil {
+		return ErrUserNotFound
+	}
+
+	// Deletion can't be undone, confirm with the password
+	if req.CurrentPassword == "" {
+		return ErrCurrentPasswordRequired
+	}
+	err = bcrypt.CompareHashAndPassword([]byte(member.PasswordHash), []byte(req.CurrentPassword))
+	if err != nil {
+		return ErrInvalidCurrentPassword
+	}
+
+	transfers, deleteBoardIDs, err := s.planOwnedBoards(ctx, member.ID, req.TransferOwnership)
+	if err != nil {
+		return err
+	}
+
+	err = s.Repo.DeleteMember(ctx, member.ID, transfers, deleteBoardIDs, time.Now())
+	if errors.Is(err, repository.ErrBoardHasOtherMembers) {
+		// Someone joined a board planned for deletion, plan again so it's reported as blocking
+		transfers, deleteBoardIDs, err = s.planOwnedBoards(ctx, member.ID, req.TransferOwnership)
+		if err != nil {
+			return err
+		}
+		err = s.Repo.DeleteMember(ctx, member.ID, transfers, deleteBoardIDs, time.Now())
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidOwnershipTransfer
+		}
+		return fmt.Errorf("failed to delete member: %w", err)
+	}
+
+	if member.AvatarID != nil {
+		s.deleteAvatarFiles(ctx, *member.AvatarID)
+	}
+
+	// The address can be registered again, don't carry over its lockout
+	s.clearFailedAttempts(ctx, attemptKey{scope: attemptScopeLoginAccount, key: strings.ToLower(member.Email)})
+
+	utils.Logger().WithField("member", member.ID).
+		WithField("transferredBoards", len(transfers)).
+		WithField("deletedBoards", len(deleteBoardIDs)).
+		Info("Member account deleted")
+
+	return nil
+}
+
+// planOwnedBoards checks the requested transfers against the boards the member owns.
+// Boards without other members are returned for deletion, shared boards without a transfer block the deletion.
+func (s *Service) planOwnedBoards(ctx context.Context, memberID uuid.UUID, requested []models.BoardOwnershipTransfer) ([]models.BoardOwnershipTransfer, []uuid.UUID, error) {
+	ownedBoards, err := s.Repo.GetOwnedBoards(ctx, memberID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get owned boards: %w", err)
+	}
+
+	owned := make(map[uuid.UUID]*models.Board, len(ownedBoards))
+	for _, board := range ownedBoards {
+		owned[board.ID] = board
+	}
+
+	// Validate requested transfers
+	newOwners := make(map[uuid.UUID]uuid.UUID, len(requested))
+	for _, transfer := range requested {
+		if _, ok := owned[transfer.IDBoard]; !ok {
+			return nil, nil, ErrInvalidOwnershipTransfer
+		}
+		if _, ok := newOwners[transfer.IDBoard]; ok || transfer.IDMember == memberID {
+			return nil, nil, ErrInvalidOwnershipTransfer
+		}
+		boardMember, err := s.Repo.GetBoardMember(ctx, transfer.IDBoard, transfer.IDMember)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check board membership: %w", err)
+		}
+		if boardMember == nil {
+			return nil, nil, ErrInvalidOwnershipTransfer
+		}
+		newOwners[transfer.IDBoard] = transfer.IDMember
+	}
+
+	var transfers []models.BoardOwnershipTransfer
+	var deleteBoardIDs []uuid.UUID
+	var blocking []*models.Board
+	for _, board := range ownedBoards {
+		newOwner, ok := newOwners[board.ID]
+		switch {
+		case ok:
+			transfers = append(transfers, models.BoardOwnershipTransfer{IDBoard: board.ID, IDMember: newOwner})
+		case board.MemberCount != nil && *board.MemberCount <= 1:
+			deleteBoardIDs = append(deleteBoardIDs, board.ID)
+		default:
+			blocking = append(blocking, board)
+		}
+	}
+	if len(blocking) > 0 {
+		return nil, nil, &OwnedBoardsError{Boards: blocking}
+	}
+
+	return transfers, deleteBoardIDs, nil
+}
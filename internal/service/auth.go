@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/config"
 	"github.com/tasks-control/core-back-end/internal/models"
 	"github.com/tasks-control/core-back-end/pkg/utils"
 	"golang.org/x/crypto/bcrypt"
@@ -20,8 +21,9 @@ type RegisterRequest struct {
 
 // LoginRequest represents the data needed to login
 type LoginRequest struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	IPAddress string
 }
 
 // AuthResponse represents the response after successful authentication
@@ -30,6 +32,10 @@ type AuthResponse struct {
 	RefreshToken string
 	ExpiresIn    int
 	User         *models.Member
+
+	// MFAToken is set instead of the tokens when the member has to complete a second factor
+	MFAToken     string
+	MFAExpiresIn int
 }
 
 // Register creates a new user account
@@ -76,14 +82,26 @@ func (s *Service) Register(ctx context.Context, req RegisterRequest) (*models.Me
 		return nil, err
 	}
 
+	// Send verification link, the member can request another one if this fails
+	if err := s.sendEmailVerification(ctx, member); err != nil {
+		utils.Logger().WithError(err).Warn("Failed to send email verification")
+	}
+
 	return member, nil
 }
 
 // Login authenticates a user and returns tokens
 func (s *Service) Login(ctx context.Context, req LoginRequest) (*AuthResponse, error) {
-	// Get user by email
+	// Count the attempt as failed up front, rejected while the account or IP address is locked out
+	attemptKeys := s.loginAttemptKeys(req.Email, req.IPAddress)
+	if err := s.countAttempt(ctx, attemptKeys); err != nil {
+		return nil, err
+	}
+
+	// Get user by email, a failed lookup isn't a guess
 	member, err := s.Repo.GetMemberByEmail(ctx, req.Email)
 	if err != nil {
+		s.releaseAttempts(ctx, attemptKeys)
 		return nil, err
 	}
 	if member == nil {
@@ -95,7 +113,27 @@ func (s *Service) Login(ctx context.Context, req LoginRequest) (*AuthResponse, e
 	if err != nil {
 		return nil, ErrInvalidCredentials
 	}
+	s.attemptSucceeded(ctx, attemptKeys)
+
+	// Check email verification when required for login
+	if err := s.checkEmailVerified(member, config.RequireVerifiedEmailLogin); err != nil {
+		return nil, err
+	}
 
+	// Members with a second factor get a challenge instead of tokens
+	totp, err := s.Repo.GetMemberTOTP(ctx, member.ID)
+	if err != nil {
+		return nil, err
+	}
+	if totp.Enabled() {
+		return s.createMFAChallenge(ctx, member.ID)
+	}
+
+	return s.issueAuthTokens(ctx, member)
+}
+
+// issueAuthTokens generates an access and refresh token pair for an authenticated member
+func (s *Service) issueAuthTokens(ctx context.Context, member *models.Member) (*AuthResponse, error) {
 	// Generate tokens
 	accessTokenDuration := time.Duration(s.JWTConfig.AccessTokenDuration) * time.Second
 	refreshTokenDuration := time.Duration(s.JWTConfig.RefreshTokenDuration) * time.Second
@@ -166,6 +204,11 @@ func (s *Service) RefreshAccessToken(ctx context.Context, refreshToken string) (
 		return nil, ErrUserNotFound
 	}
 
+	// Refresh tokens stored before the member's tokens were invalidated can't be used
+	if member.TokensValidAfter != nil && storedToken.CreatedAt.Before(*member.TokensValidAfter) {
+		return nil, ErrInvalidRefreshToken
+	}
+
 	// Generate new access token
 	accessTokenDuration := time.Duration(s.JWTConfig.AccessTokenDuration) * time.Second
 	accessToken, err := s.JWTManager.GenerateAccessToken(
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // registers the decoder for image.Decode
+	_ "image/jpeg" // registers the decoder for image.Decode
+	"image/png"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var (
+	ErrInvalidAvatarImage = errors.New("avatar must be a PNG, JPEG or GIF image")
+	ErrAvatarTooLarge     = errors.New("avatar image is too large")
+)
+
+const (
+	MaxAvatarBytes  = 5 << 20
+	maxAvatarPixels = 4096 * 4096 // decoded size limit, a small file can still expand to a huge bitmap
+	avatarURLSize   = 256         // size returned as avatarUrl, the other sizes are stored next to it
+)
+
+// AvatarSizes are the square sizes in pixels every uploaded avatar is resized to
+var AvatarSizes = []int{32, 64, 128, 256}
+
+// UpdateMemberAvatar validates an uploaded image, stores it in every avatar size and makes it the member's avatar.
+// The previous avatar files are deleted.
+func (s *Service) UpdateMemberAvatar(ctx context.Context, memberID uuid.UUID, data []byte) (*models.Member, error) {
+	// Get current member
+	member, err := s.Repo.GetMemberByID(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	if member == nil || member.DeletedAt != nil {
+		return nil, ErrUserNotFound
+	}
+
+	if len(data) > MaxAvatarBytes {
+		return nil, ErrAvatarTooLarge
+	}
+
+	// Check the content, the declared content type can't be trusted
+	switch http.DetectContentType(data) {
+	case "image/png", "image/jpeg", "image/gif":
+	default:
+		return nil, ErrInvalidAvatarImage
+	}
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || imageConfig.Width <= 0 || imageConfig.Height <= 0 {
+		return nil, ErrInvalidAvatarImage
+	}
+	if imageConfig.Width*imageConfig.Height > maxAvatarPixels {
+		return nil, ErrAvatarTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidAvatarImage
+	}
+
+	// Store all sizes under a new ID
+	avatarID := uuid.New()
+	for _, size := range AvatarSizes {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, utils.SquareThumbnail(img, size)); err != nil {
+			s.deleteAvatarFiles(ctx, avatarID)
+			return nil, fmt.Errorf("failed to encode avatar: %w", err)
+		}
+		if err := s.Storage.Put(ctx, avatarKey(avatarID, size), buf.Bytes()); err != nil {
+			s.deleteAvatarFiles(ctx, avatarID)
+			return nil, fmt.Errorf("failed to store avatar: %w", err)
+		}
+	}
+
+	now := time.Now()
+	err = s.Repo.UpdateMemberAvatar(ctx, member.ID, &avatarID, now)
+	if err != nil {
+		s.deleteAvatarFiles(ctx, avatarID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update member avatar: %w", err)
+	}
+
+	if member.AvatarID != nil {
+		s.deleteAvatarFiles(ctx, *member.AvatarID)
+	}
+
+	member.AvatarID = &avatarID
+	member.UpdatedAt = now
+	return member, nil
+}
+
+// AvatarURL returns where the member's largest avatar is served, nil without an avatar
+func (s *Service) AvatarURL(member *models.Member) *string {
+	if member.AvatarID == nil {
+		return nil
+	}
+	url := s.Storage.URL(avatarKey(*member.AvatarID, avatarURLSize))
+	return &url
+}
+
+// deleteAvatarFiles removes all sizes of an avatar. Failures are logged, they only leave unused files behind.
+func (s *Service) deleteAvatarFiles(ctx context.Context, avatarID uuid.UUID) {
+	for _, size := range AvatarSizes {
+		if err := s.Storage.Delete(ctx, avatarKey(avatarID, size)); err != nil {
+			utils.Logger().WithError(err).WithField("avatar", avatarID).Warn("Failed to delete avatar file")
+		}
+	}
+}
+
+func avatarKey(avatarID uuid.UUID, size int) string {
+	return fmt.Sprintf("avatars/%s/%d.png", avatarID, size)
+}
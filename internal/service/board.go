@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/config"
 	"github.com/tasks-control/core-back-end/internal/models"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -22,6 +23,7 @@ var (
 	ErrAlreadyBoardMember     = errors.New("already a member of this board")
 	ErrCannotRemoveOwner      = errors.New("cannot remove board owner")
 	ErrInvalidBoardUniqueName = errors.New("board unique name must contain only lowercase letters, numbers, and hyphens")
+	ErrInvalidWIPLimitMode    = errors.New("WIP limit mode must be soft or hard")
 )
 
 // CreateBoardRequest represents the data needed to create a new board
@@ -39,6 +41,7 @@ type UpdateBoardRequest struct {
 	NameBoardUnique *string
 	Description     *string
 	Password        *string
+	WIPLimitMode    *string
 }
 
 // BoardWithDetails represents a board with its lists and members
@@ -79,6 +82,7 @@ func (s *Service) CreateBoard(ctx context.Context, req CreateBoardRequest) (*mod
 		Description:     req.Description,
 		PasswordHash:    string(hashedPassword),
 		IDMemberCreator: req.CreatorID,
+		WIPLimitMode:    models.WIPLimitModeSoft,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
@@ -101,7 +105,13 @@ func (s *Service) GetBoardsByMember(ctx context.Context, memberID uuid.UUID, sta
 		offset = 0
 	}
 
-	boards, total, err := s.Repo.GetBoardsByMemberID(ctx, memberID, starredOnly, limit, offset)
+	// Boards are listed in the member's preferred order
+	preferences, err := s.GetMemberPreferences(ctx, memberID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	boards, total, err := s.Repo.GetBoardsByMemberID(ctx, memberID, starredOnly, preferences.DefaultBoardSort, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get boards: %w", err)
 	}
@@ -136,6 +146,13 @@ func (s *Service) GetBoardWithDetails(ctx context.Context, boardID, memberID uui
 	}
 	board.Starred = &starred
 
+	// Check if board is watched
+	watching, err := s.Repo.IsWatching(ctx, memberID, models.WatchTargetBoard, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if board is watched: %w", err)
+	}
+	board.Watching = &watching
+
 	// Get lists
 	lists, err := s.Repo.GetBoardLists(ctx, boardID)
 	if err != nil {
@@ -217,6 +234,13 @@ func (s *Service) UpdateBoard(ctx context.Context, boardID, memberID uuid.UUID,
 		board.PasswordHash = string(hashedPassword)
 	}
 
+	if req.WIPLimitMode != nil {
+		if *req.WIPLimitMode != models.WIPLimitModeSoft && *req.WIPLimitMode != models.WIPLimitModeHard {
+			return nil, ErrInvalidWIPLimitMode
+		}
+		board.WIPLimitMode = *req.WIPLimitMode
+	}
+
 	board.UpdatedAt = time.Now()
 
 	err = s.Repo.UpdateBoard(ctx, board)
@@ -258,7 +282,19 @@ func (s *Service) DeleteBoard(ctx context.Context, boardID, memberID uuid.UUID)
 }
 
 // JoinBoard allows a user to join a board with password
-func (s *Service) JoinBoard(ctx context.Context, uniqueName string, password string, memberID uuid.UUID) (*models.Board, error) {
+func (s *Service) JoinBoard(ctx context.Context, uniqueName string, password string, memberID uuid.UUID, ipAddress string) (*models.Board, error) {
+	// Check email verification, required for joining in both join and login modes
+	member, err := s.Repo.GetMemberByID(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+	if member == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := s.checkEmailVerified(member, config.RequireVerifiedEmailJoin, config.RequireVerifiedEmailLogin); err != nil {
+		return nil, err
+	}
+
 	// Get board by unique name
 	board, err := s.Repo.GetBoardByUniqueName(ctx, uniqueName)
 	if err != nil {
@@ -277,11 +313,18 @@ func (s *Service) JoinBoard(ctx context.Context, uniqueName string, password str
 		return nil, ErrAlreadyBoardMember
 	}
 
+	// Count the attempt as failed up front, rejected while the member or IP address is locked out
+	attemptKeys := s.joinAttemptKeys(memberID, ipAddress)
+	if err := s.countAttempt(ctx, attemptKeys); err != nil {
+		return nil, err
+	}
+
 	// Verify password
 	err = bcrypt.CompareHashAndPassword([]byte(board.PasswordHash), []byte(password))
 	if err != nil {
 		return nil, ErrInvalidBoardPassword
 	}
+	s.attemptSucceeded(ctx, attemptKeys)
 
 	// Add member to board
 	boardMember := &models.BoardMember{
@@ -297,6 +340,8 @@ func (s *Service) JoinBoard(ctx context.Context, uniqueName string, password str
 		return nil, fmt.Errorf("failed to add member to board: %w", err)
 	}
 
+	s.notifyBoardMemberJoined(ctx, board, member)
+
 	return board, nil
 }
 
@@ -340,6 +385,8 @@ func (s *Service) RemoveBoardMember(ctx context.Context, boardID, targetMemberID
 		return fmt.Errorf("failed to remove member from board: %w", err)
 	}
 
+	s.notifyBoardMemberRemoved(ctx, boardID, targetMemberID, requestingMemberID)
+
 	return nil
 }
 
@@ -9,28 +9,50 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/repository"
 )
 
 var (
-	ErrCardNotFound = errors.New("card not found")
+	ErrCardNotFound         = errors.New("card not found")
+	ErrInvalidBulkOperation = errors.New("invalid bulk card operation")
+	ErrCardInOtherBoard     = errors.New("card belongs to a different board than the target")
 )
 
 // CreateCardRequest represents the data needed to create a new card
 type CreateCardRequest struct {
-	Title       string
-	Description *string
-	IDList      uuid.UUID
-	Position    *float64
-	MemberID    uuid.UUID
+	Title          string
+	Description    *string
+	IDList         uuid.UUID
+	Position       *float64
+	MemberID       uuid.UUID
+	IgnoreWIPLimit bool // Create even if the list is at its soft WIP limit
 }
 
 // UpdateCardRequest represents the data needed to update a card
 type UpdateCardRequest struct {
-	Title       *string
-	Description *string
-	IDList      *uuid.UUID // Allow moving to different list
-	Position    *float64
-	Archived    *bool
+	Title          *string
+	Description    *string
+	IDList         *uuid.UUID // Allow moving to different list
+	Position       *float64
+	Archived       *bool
+	IgnoreWIPLimit bool // Move or unarchive even if the list is at its soft WIP limit
+}
+
+// BulkCardRequest represents a single operation applied to many cards
+type BulkCardRequest struct {
+	CardIDs        []uuid.UUID
+	Operation      string
+	IDList         *uuid.UUID // Required for move
+	IDLabel        *uuid.UUID // Required for assignLabel
+	MemberID       uuid.UUID
+	IgnoreWIPLimit bool // Move or unarchive even if a list exceeds its soft WIP limit
+}
+
+// BulkCardResult represents the outcome of a bulk operation for one card.
+// Err is nil when the operation was applied.
+type BulkCardResult struct {
+	CardID uuid.UUID
+	Err    error
 }
 
 // CreateCard creates a new card in a list
@@ -89,11 +111,21 @@ func (s *Service) CreateCard(ctx context.Context, req CreateCardRequest) (*model
 		UpdatedAt:   now,
 	}
 
-	err = s.Repo.CreateCard(ctx, card)
+	err = s.Repo.CreateCard(ctx, card, &models.WIPLimitCheck{IgnoreSoftLimit: req.IgnoreWIPLimit})
 	if err != nil {
+		if errors.Is(err, repository.ErrListNotFound) {
+			return nil, ErrListNotFound
+		}
+		if limitErr := wipLimitError(err); limitErr != nil {
+			return nil, limitErr
+		}
 		return nil, fmt.Errorf("failed to create card: %w", err)
 	}
 
+	s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardCreated, map[string]interface{}{"card": card})
+	s.notifyCardChange(ctx, boardID, card, models.NotificationCardCreated, req.MemberID, nil, s.cardWatcherIDs(ctx, boardID, card))
+	s.notifyCardMentions(ctx, boardID, card, "", req.MemberID)
+
 	return card, nil
 }
 
@@ -126,6 +158,19 @@ func (s *Service) GetCard(ctx context.Context, cardID, memberID uuid.UUID) (*mod
 		return nil, ErrNotBoardMember
 	}
 
+	// Check if card is watched
+	watching, err := s.Repo.IsWatching(ctx, memberID, models.WatchTargetCard, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if card is watched: %w", err)
+	}
+	card.Watching = &watching
+
+	// Get custom field values
+	card.CustomFields, err = s.Repo.GetCardCustomFieldValues(ctx, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card custom field values: %w", err)
+	}
+
 	return card, nil
 }
 
@@ -158,6 +203,10 @@ func (s *Service) UpdateCard(ctx context.Context, cardID, memberID uuid.UUID, re
 		return nil, ErrNotBoardMember
 	}
 
+	previousListID := card.IDList
+	previousArchived := card.Archived
+	previousText := cardText(card)
+
 	// Update fields
 	if req.Title != nil {
 		card.Title = *req.Title
@@ -203,17 +252,52 @@ func (s *Service) UpdateCard(ctx context.Context, cardID, memberID uuid.UUID, re
 		card.Archived = *req.Archived
 	}
 
+	// Moved and unarchived cards count against the WIP limit of their list, archived ones don't
+	var wipLimit *models.WIPLimitCheck
+	if !card.Archived && (card.IDList != previousListID || previousArchived) {
+		wipLimit = &models.WIPLimitCheck{IgnoreSoftLimit: req.IgnoreWIPLimit}
+	}
+
 	card.UpdatedAt = time.Now()
 
 	// Save updated card
-	err = s.Repo.UpdateCard(ctx, card)
+	err = s.Repo.UpdateCard(ctx, card, wipLimit)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrCardNotFound
 		}
+		if errors.Is(err, repository.ErrListNotFound) {
+			return nil, ErrListNotFound
+		}
+		if limitErr := wipLimitError(err); limitErr != nil {
+			return nil, limitErr
+		}
 		return nil, fmt.Errorf("failed to update card: %w", err)
 	}
 
+	// Watchers of the new list are notified about a move
+	watchers := s.cardWatcherIDs(ctx, boardID, card)
+
+	// Publish the most specific event for the change
+	switch {
+	case card.IDList != previousListID:
+		s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardMoved, map[string]interface{}{
+			"card":         card,
+			"idListBefore": previousListID,
+		})
+		s.notifyCardChange(ctx, boardID, card, models.NotificationCardMoved, memberID, map[string]interface{}{"idListBefore": previousListID}, watchers)
+	case card.Archived && !previousArchived:
+		s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardArchived, map[string]interface{}{"card": card})
+		s.notifyCardChange(ctx, boardID, card, models.NotificationCardArchived, memberID, nil, watchers)
+	case !card.Archived && previousArchived:
+		s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardUnarchived, map[string]interface{}{"card": card})
+		s.notifyCardChange(ctx, boardID, card, models.NotificationCardUnarchived, memberID, nil, watchers)
+	default:
+		s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardUpdated, map[string]interface{}{"card": card})
+		s.notifyCardChange(ctx, boardID, card, models.NotificationCardUpdated, memberID, nil, watchers)
+	}
+	s.notifyCardMentions(ctx, boardID, card, previousText, memberID)
+
 	return card, nil
 }
 
@@ -246,6 +330,9 @@ func (s *Service) DeleteCard(ctx context.Context, cardID, memberID uuid.UUID) er
 		return ErrNotBoardMember
 	}
 
+	// Watchers of the card itself are deleted with it
+	watchers := s.cardWatcherIDs(ctx, boardID, card)
+
 	// Delete card
 	err = s.Repo.DeleteCard(ctx, cardID)
 	if err != nil {
@@ -255,5 +342,206 @@ func (s *Service) DeleteCard(ctx context.Context, cardID, memberID uuid.UUID) er
 		return fmt.Errorf("failed to delete card: %w", err)
 	}
 
+	s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardDeleted, map[string]interface{}{"card": card})
+	s.notifyCardChange(ctx, boardID, card, models.NotificationCardDeleted, memberID, nil, watchers)
+
 	return nil
 }
+
+// BulkUpdateCards applies one operation to many cards. Membership is checked once per board,
+// cards that fail validation are reported individually and the rest are changed in one transaction.
+func (s *Service) BulkUpdateCards(ctx context.Context, req BulkCardRequest) ([]BulkCardResult, error) {
+	// Resolve the board that every accepted card has to belong to
+	targetBoardID := uuid.Nil
+	switch req.Operation {
+	case models.BulkCardOperationArchive, models.BulkCardOperationUnarchive, models.BulkCardOperationDelete:
+	case models.BulkCardOperationMove:
+		if req.IDList == nil {
+			return nil, ErrInvalidBulkOperation
+		}
+		list, err := s.Repo.GetListByID(ctx, *req.IDList)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get target list: %w", err)
+		}
+		if list == nil {
+			return nil, ErrListNotFound
+		}
+		targetBoardID = list.IDBoard
+	case models.BulkCardOperationAssignLabel:
+		if req.IDLabel == nil {
+			return nil, ErrInvalidBulkOperation
+		}
+		label, err := s.Repo.GetLabelByID(ctx, *req.IDLabel)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get label: %w", err)
+		}
+		if label == nil {
+			return nil, ErrLabelNotFound
+		}
+		targetBoardID = label.IDBoard
+	default:
+		return nil, ErrInvalidBulkOperation
+	}
+
+	// Drop duplicated IDs, keeping the first occurrence
+	cardIDs := make([]uuid.UUID, 0, len(req.CardIDs))
+	seen := make(map[uuid.UUID]bool, len(req.CardIDs))
+	for _, id := range req.CardIDs {
+		if !seen[id] {
+			seen[id] = true
+			cardIDs = append(cardIDs, id)
+		}
+	}
+
+	// Get board ID of every card in one query
+	boardIDs, err := s.Repo.GetBoardIDsByCardIDs(ctx, cardIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board IDs: %w", err)
+	}
+
+	// Validate each card, checking membership once per board
+	membership := make(map[uuid.UUID]bool)
+	results := make([]BulkCardResult, 0, len(cardIDs))
+	accepted := make([]uuid.UUID, 0, len(cardIDs))
+	acceptedByBoard := make(map[uuid.UUID][]uuid.UUID)
+	for _, cardID := range cardIDs {
+		boardID, ok := boardIDs[cardID]
+		if !ok {
+			results = append(results, BulkCardResult{CardID: cardID, Err: ErrCardNotFound})
+			continue
+		}
+
+		isMember, checked := membership[boardID]
+		if !checked {
+			boardMember, err := s.Repo.GetBoardMember(ctx, boardID, req.MemberID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check board membership: %w", err)
+			}
+			isMember = boardMember != nil
+			membership[boardID] = isMember
+		}
+		if !isMember {
+			results = append(results, BulkCardResult{CardID: cardID, Err: ErrNotBoardMember})
+			continue
+		}
+
+		if targetBoardID != uuid.Nil && boardID != targetBoardID {
+			results = append(results, BulkCardResult{CardID: cardID, Err: ErrCardInOtherBoard})
+			continue
+		}
+
+		results = append(results, BulkCardResult{CardID: cardID})
+		accepted = append(accepted, cardID)
+		acceptedByBoard[boardID] = append(acceptedByBoard[boardID], cardID)
+	}
+
+	if len(accepted) == 0 {
+		return results, nil
+	}
+
+	// Apply operation to all accepted cards
+	op := &models.BulkCardOperation{
+		Operation: req.Operation,
+		CardIDs:   accepted,
+		IDMember:  req.MemberID,
+		UpdatedAt: time.Now(),
+	}
+	if targetBoardID != uuid.Nil {
+		op.IDBoard = &targetBoardID
+	}
+	if req.IDList != nil {
+		op.IDList = *req.IDList
+	}
+	if req.IDLabel != nil {
+		op.IDLabel = *req.IDLabel
+	}
+	if req.Operation == models.BulkCardOperationMove || req.Operation == models.BulkCardOperationUnarchive {
+		op.WIPLimit = &models.WIPLimitCheck{IgnoreSoftLimit: req.IgnoreWIPLimit}
+	}
+
+	// Deleting a card drops its own watchers, they are resolved before like in DeleteCard
+	var watchers map[uuid.UUID][]uuid.UUID
+	if req.Operation == models.BulkCardOperationDelete {
+		watchers = s.cardsWatcherIDs(ctx, accepted)
+	}
+
+	changes, err := s.Repo.ApplyBulkCardOperation(ctx, op)
+	if err != nil {
+		if errors.Is(err, repository.ErrListNotFound) {
+			return nil, ErrListNotFound
+		}
+		if limitErr := wipLimitError(err); limitErr != nil {
+			return nil, limitErr
+		}
+		return nil, fmt.Errorf("failed to apply bulk card operation: %w", err)
+	}
+
+	// Cards deleted, moved away or on boards the member left since the checks were skipped
+	applied := make(map[uuid.UUID]bool, len(changes))
+	for _, change := range changes {
+		applied[change.ID] = true
+	}
+	for i, result := range results {
+		if result.Err == nil && !applied[result.CardID] {
+			results[i].Err = ErrCardNotFound
+		}
+	}
+	for boardID, boardCardIDs := range acceptedByBoard {
+		kept := boardCardIDs[:0]
+		for _, id := range boardCardIDs {
+			if applied[id] {
+				kept = append(kept, id)
+			}
+		}
+		if len(kept) == 0 {
+			delete(acceptedByBoard, boardID)
+			continue
+		}
+		acceptedByBoard[boardID] = kept
+	}
+
+	// Publish one event per affected board
+	for boardID, boardCardIDs := range acceptedByBoard {
+		data := map[string]interface{}{
+			"operation": req.Operation,
+			"idCards":   boardCardIDs,
+		}
+		if req.IDList != nil {
+			data["idList"] = *req.IDList
+		}
+		if req.IDLabel != nil {
+			data["idLabel"] = *req.IDLabel
+		}
+		s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardsBulk, data)
+	}
+
+	// Notify the watchers of each card like for a single change, label assignments don't notify.
+	// Watchers of the new list are notified about a move.
+	if req.Operation != models.BulkCardOperationDelete && req.Operation != models.BulkCardOperationAssignLabel {
+		watchers = s.cardsWatcherIDs(ctx, changedCardIDs(changes))
+	}
+	for _, change := range changes {
+		var notificationType string
+		var data map[string]interface{}
+		switch req.Operation {
+		case models.BulkCardOperationArchive:
+			notificationType = models.NotificationCardArchived
+		case models.BulkCardOperationUnarchive:
+			notificationType = models.NotificationCardUnarchived
+		case models.BulkCardOperationDelete:
+			notificationType = models.NotificationCardDeleted
+		case models.BulkCardOperationMove:
+			notificationType = models.NotificationCardUpdated
+			if change.IDList != change.IDListBefore {
+				notificationType = models.NotificationCardMoved
+				data = map[string]interface{}{"idListBefore": change.IDListBefore}
+			}
+		default:
+			continue
+		}
+
+		s.notifyCardChange(ctx, boardIDs[change.ID], &change.Card, notificationType, req.MemberID, data, watchers[change.ID])
+	}
+
+	return results, nil
+}
@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/repository"
+)
+
+var (
+	ErrCustomFieldNotFound       = errors.New("custom field not found")
+	ErrCustomFieldNameTaken      = errors.New("a custom field with this name already exists on the board")
+	ErrInvalidCustomFieldName    = errors.New("custom field name must be 1 to 100 characters")
+	ErrInvalidCustomFieldType    = errors.New("custom field type must be text, number, date, checkbox or dropdown")
+	ErrInvalidCustomFieldOptions = errors.New("dropdown fields need 1 to 50 distinct options of 1 to 100 characters, other types have none")
+	ErrTooManyCustomFields       = errors.New("a board can have at most 50 custom fields")
+	ErrInvalidCustomFieldValue   = errors.New("invalid custom field value")
+)
+
+const (
+	maxCustomFieldsPerBoard   = 50
+	maxCustomFieldOptions     = 50
+	maxCustomFieldNameLength  = 100 // also the limit of dropdown option values
+	maxCustomFieldTextLength  = 5000
+	customFieldPositionOffset = 65536.0
+)
+
+// customFieldValueExpectations describes the accepted value of every custom field type for error messages
+var customFieldValueExpectations = map[string]string{
+	models.CustomFieldText:     "text of 1 to 5000 characters",
+	models.CustomFieldNumber:   "a number",
+	models.CustomFieldDate:     "a date",
+	models.CustomFieldCheckbox: "checked true or false",
+	models.CustomFieldDropdown: "the ID of one of its options",
+}
+
+// CustomFieldValueError names the field a value was rejected for. It matches ErrInvalidCustomFieldValue.
+type CustomFieldValueError struct {
+	Field string
+	Type  string
+}
+
+func (e *CustomFieldValueError) Error() string {
+	return fmt.Sprintf("custom field %s expects %s", e.Field, customFieldValueExpectations[e.Type])
+}
+
+func (e *CustomFieldValueError) Is(target error) bool {
+	return target == ErrInvalidCustomFieldValue
+}
+
+// CreateCustomFieldRequest represents the data needed to create a custom field
+type CreateCustomFieldRequest struct {
+	Name     string
+	Type     string
+	Options  []string // Dropdown choices in display order
+	BoardID  uuid.UUID
+	MemberID uuid.UUID
+}
+
+// UpdateCustomFieldRequest represents the data needed to update a custom field, the type can't change
+type UpdateCustomFieldRequest struct {
+	Name     *string
+	Position *float64
+	Options  *[]CustomFieldOptionRequest // Replaces all options, omitted ones are removed from cards
+}
+
+// CustomFieldOptionRequest represents a dropdown option, existing options are kept by passing their ID
+type CustomFieldOptionRequest struct {
+	ID    *uuid.UUID
+	Value string
+}
+
+// SetCustomFieldValueRequest represents a card value, the one matching the field type is set.
+// A request without any value clears the field on the card.
+type SetCustomFieldValueRequest struct {
+	Text     *string
+	Number   *float64
+	Date     *time.Time
+	Checked  *bool
+	IDOption *uuid.UUID
+}
+
+// CreateCustomField creates a custom field on a board (owner only)
+func (s *Service) CreateCustomField(ctx context.Context, req CreateCustomFieldRequest) (*models.CustomField, error) {
+	// Only owners can manage custom fields
+	if err := s.checkBoardOwner(ctx, req.BoardID, req.MemberID); err != nil {
+		return nil, err
+	}
+
+	name, err := validateCustomFieldName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := customFieldValueExpectations[req.Type]; !ok {
+		return nil, ErrInvalidCustomFieldType
+	}
+
+	options := make([]CustomFieldOptionRequest, 0, len(req.Options))
+	for _, value := range req.Options {
+		options = append(options, CustomFieldOptionRequest{Value: value})
+	}
+
+	fields, err := s.Repo.GetBoardCustomFields(ctx, req.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board custom fields: %w", err)
+	}
+	if len(fields) >= maxCustomFieldsPerBoard {
+		return nil, ErrTooManyCustomFields
+	}
+	if customFieldNameTaken(fields, name, uuid.Nil) {
+		return nil, ErrCustomFieldNameTaken
+	}
+
+	// New fields go last
+	position := customFieldPositionOffset
+	if len(fields) > 0 {
+		position = fields[len(fields)-1].Position + customFieldPositionOffset
+	}
+
+	// Create custom field
+	now := time.Now()
+	field := &models.CustomField{
+		ID:        uuid.New(),
+		IDBoard:   req.BoardID,
+		Name:      name,
+		Type:      req.Type,
+		Position:  position,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	field.Options, err = buildCustomFieldOptions(field, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.CreateCustomField(ctx, field)
+	if err != nil {
+		if errors.Is(err, repository.ErrCustomFieldNameTaken) {
+			return nil, ErrCustomFieldNameTaken
+		}
+		return nil, fmt.Errorf("failed to create custom field: %w", err)
+	}
+
+	return field, nil
+}
+
+// GetBoardCustomFields retrieves all custom fields of a board
+func (s *Service) GetBoardCustomFields(ctx context.Context, boardID, memberID uuid.UUID) ([]*models.CustomField, error) {
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, boardID, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return nil, ErrNotBoardMember
+	}
+
+	fields, err := s.Repo.GetBoardCustomFields(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board custom fields: %w", err)
+	}
+
+	return fields, nil
+}
+
+// UpdateCustomField updates a custom field of a board (owner only)
+func (s *Service) UpdateCustomField(ctx context.Context, boardID, fieldID, memberID uuid.UUID, req UpdateCustomFieldRequest) (*models.CustomField, error) {
+	// Only owners can manage custom fields
+	if err := s.checkBoardOwner(ctx, boardID, memberID); err != nil {
+		return nil, err
+	}
+
+	fields, err := s.Repo.GetBoardCustomFields(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board custom fields: %w", err)
+	}
+	var field *models.CustomField
+	for _, candidate := range fields {
+		if candidate.ID == fieldID {
+			field = candidate
+			break
+		}
+	}
+	if field == nil {
+		return nil, ErrCustomFieldNotFound
+	}
+
+	// Update fields
+	if req.Name != nil {
+		name, err := validateCustomFieldName(*req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if customFieldNameTaken(fields, name, field.ID) {
+			return nil, ErrCustomFieldNameTaken
+		}
+		field.Name = name
+	}
+
+	if req.Position != nil {
+		field.Position = *req.Position
+	}
+
+	if req.Options != nil {
+		field.Options, err = buildCustomFieldOptions(field, *req.Options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	field.UpdatedAt = time.Now()
+
+	err = s.Repo.UpdateCustomField(ctx, field)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCustomFieldNotFound
+		}
+		if errors.Is(err, repository.ErrCustomFieldNameTaken) {
+			return nil, ErrCustomFieldNameTaken
+		}
+		return nil, fmt.Errorf("failed to update custom field: %w", err)
+	}
+
+	return field, nil
+}
+
+// DeleteCustomField deletes a custom field of a board with all card values (owner only)
+func (s *Service) DeleteCustomField(ctx context.Context, boardID, fieldID, memberID uuid.UUID) error {
+	// Only owners can manage custom fields
+	if err := s.checkBoardOwner(ctx, boardID, memberID); err != nil {
+		return err
+	}
+
+	field, err := s.Repo.GetCustomFieldByID(ctx, fieldID)
+	if err != nil {
+		return fmt.Errorf("failed to get custom field: %w", err)
+	}
+	if field == nil || field.IDBoard != boardID {
+		return ErrCustomFieldNotFound
+	}
+
+	err = s.Repo.DeleteCustomField(ctx, fieldID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCustomFieldNotFound
+		}
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+
+	return nil
+}
+
+// SetCardCustomFieldValue validates and stores the value of a custom field on a card.
+// It returns nil when the request cleared the value.
+func (s *Service) SetCardCustomFieldValue(ctx context.Context, cardID, fieldID, memberID uuid.UUID, req SetCustomFieldValueRequest) (*models.CardCustomFieldValue, error) {
+	// Get card
+	card, err := s.Repo.GetCardByID(ctx, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card: %w", err)
+	}
+	if card == nil {
+		return nil, ErrCardNotFound
+	}
+
+	// Get board ID from card
+	boardID, err := s.Repo.GetBoardIDByCardID(ctx, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board ID: %w", err)
+	}
+	if boardID == uuid.Nil {
+		return nil, ErrCardNotFound
+	}
+
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, boardID, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return nil, ErrNotBoardMember
+	}
+
+	// Fields of other boards don't exist for this card
+	field, err := s.Repo.GetCustomFieldByID(ctx, fieldID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field: %w", err)
+	}
+	if field == nil || field.IDBoard != boardID {
+		return nil, ErrCustomFieldNotFound
+	}
+
+	var value *models.CardCustomFieldValue
+	if req == (SetCustomFieldValueRequest{}) {
+		err = s.Repo.DeleteCardCustomFieldValue(ctx, cardID, fieldID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to clear card custom field value: %w", err)
+		}
+	} else {
+		value, err = buildCardCustomFieldValue(field, req)
+		if err != nil {
+			return nil, err
+		}
+		value.IDCard = cardID
+		value.UpdatedAt = time.Now()
+
+		err = s.Repo.SetCardCustomFieldValue(ctx, value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set card custom field value: %w", err)
+		}
+	}
+
+	s.publishWebhookEvent(ctx, boardID, models.WebhookEventCardUpdated, map[string]interface{}{
+		"card":          card,
+		"idCustomField": fieldID,
+		"customField":   value,
+	})
+	s.notifyCardChange(ctx, boardID, card, models.NotificationCardUpdated, memberID, map[string]interface{}{"customField": field.Name}, s.cardWatcherIDs(ctx, boardID, card))
+
+	return value, nil
+}
+
+// validateCustomFieldName trims a custom field name and checks its length
+func validateCustomFieldName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCustomFieldNameLength {
+		return "", ErrInvalidCustomFieldName
+	}
+	return name, nil
+}
+
+// customFieldNameTaken reports whether another field of the board has the name, ignoring case
+func customFieldNameTaken(fields []*models.CustomField, name string, exceptID uuid.UUID) bool {
+	for _, field := range fields {
+		if field.ID != exceptID && strings.EqualFold(field.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildCustomFieldOptions validates the options of a field and assigns IDs and positions in the given order.
+// IDs of existing options must belong to the field.
+func buildCustomFieldOptions(field *models.CustomField, requested []CustomFieldOptionRequest) ([]*models.CustomFieldOption, error) {
+	if field.Type != models.CustomFieldDropdown {
+		if len(requested) > 0 {
+			return nil, ErrInvalidCustomFieldOptions
+		}
+		return nil, nil
+	}
+	if len(requested) == 0 || len(requested) > maxCustomFieldOptions {
+		return nil, ErrInvalidCustomFieldOptions
+	}
+
+	existing := make(map[uuid.UUID]bool, len(field.Options))
+	for _, option := range field.Options {
+		existing[option.ID] = true
+	}
+
+	options := make([]*models.CustomFieldOption, 0, len(requested))
+	seenValues := make(map[string]bool, len(requested))
+	seenIDs := make(map[uuid.UUID]bool, len(requested))
+	for i, option := range requested {
+		value := strings.TrimSpace(option.Value)
+		key := strings.ToLower(value)
+		if value == "" || utf8.RuneCountInString(value) > maxCustomFieldNameLength || seenValues[key] {
+			return nil, ErrInvalidCustomFieldOptions
+		}
+		seenValues[key] = true
+
+		id := uuid.New()
+		if option.ID != nil {
+			if !existing[*option.ID] || seenIDs[*option.ID] {
+				return nil, ErrInvalidCustomFieldOptions
+			}
+			id = *option.ID
+			seenIDs[id] = true
+		}
+
+		options = append(options, &models.CustomFieldOption{
+			ID:            id,
+			IDCustomField: field.ID,
+			Value:         value,
+			Position:      float64(i+1) * customFieldPositionOffset,
+		})
+	}
+
+	return options, nil
+}
+
+// buildCardCustomFieldValue checks that exactly the value matching the field type is set
+func buildCardCustomFieldValue(field *models.CustomField, req SetCustomFieldValueRequest) (*models.CardCustomFieldValue, error) {
+	invalid := &CustomFieldValueError{Field: field.Name, Type: field.Type}
+	value := &models.CardCustomFieldValue{IDCustomField: field.ID}
+
+	set := 0
+	for _, isSet := range []bool{req.Text != nil, req.Number != nil, req.Date != nil, req.Checked != nil, req.IDOption != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, invalid
+	}
+
+	switch field.Type {
+	case models.CustomFieldText:
+		if req.Text == nil || *req.Text == "" || utf8.RuneCountInString(*req.Text) > maxCustomFieldTextLength {
+			return nil, invalid
+		}
+		value.Text = req.Text
+	case models.CustomFieldNumber:
+		if req.Number == nil {
+			return nil, invalid
+		}
+		value.Number = req.Number
+	case models.CustomFieldDate:
+		if req.Date == nil {
+			return nil, invalid
+		}
+		value.Date = req.Date
+	case models.CustomFieldCheckbox:
+		if req.Checked == nil {
+			return nil, invalid
+		}
+		value.Checked = req.Checked
+	case models.CustomFieldDropdown:
+		if req.IDOption == nil {
+			return nil, invalid
+		}
+		found := false
+		for _, option := range field.Options {
+			if option.ID == *req.IDOption {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, invalid
+		}
+		value.IDOption = req.IDOption
+	default:
+		return nil, invalid
+	}
+
+	return value, nil
+}
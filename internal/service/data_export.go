@@ -0,0 +1,493 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var (
+	ErrInvalidDataExportFormat = errors.New("invalid data export format")
+	ErrDataExportNotFound      = errors.New("data export not found")
+	ErrDataExportNotReady      = errors.New("data export is not ready")
+)
+
+// MemberDataExportVersion is the version of the personal data export format
+const MemberDataExportVersion = 1
+
+const expiredDataExportBatchSize = 100
+
+// exportedSecurityEvent writes the event metadata as JSON instead of base64 bytes
+type exportedSecurityEvent struct {
+	*models.SecurityEvent
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// exportedMFA describes the second factor without its secret
+type exportedMFA struct {
+	Enabled     bool       `json:"enabled"`
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty"`
+}
+
+// memberDataWriter writes a personal data export in a specific format.
+// Sections are written first, then the cards, then Finish is called once.
+type memberDataWriter interface {
+	WriteSection(name string, data interface{}) error
+	WriteCard(card *models.Card) error
+	Finish() error
+}
+
+// ValidDataExportFormat reports whether a personal data export can be written in the format
+func ValidDataExportFormat(format string) bool {
+	return format == models.DataExportFormatJSON || format == models.DataExportFormatZIP
+}
+
+// CanExportMemberDataInline reports whether the member's data is small enough to export within the request
+func (s *Service) CanExportMemberDataInline(ctx context.Context, memberID uuid.UUID) (bool, error) {
+	count, err := s.Repo.CountMemberCards(ctx, memberID)
+	if err != nil {
+		return false, err
+	}
+	return count <= s.DataExportConfig.SyncCardLimit, nil
+}
+
+// WriteMemberDataExport writes everything stored about a member to w in the format
+func (s *Service) WriteMemberDataExport(ctx context.Context, memberID uuid.UUID, format string, w io.Writer) error {
+	var writer memberDataWriter
+	switch format {
+	case models.DataExportFormatJSON:
+		writer = &jsonMemberDataWriter{w: w}
+	case models.DataExportFormatZIP:
+		writer = &zipMemberDataWriter{zw: zip.NewWriter(w)}
+	default:
+		return ErrInvalidDataExportFormat
+	}
+
+	// Get profile
+	member, err := s.Repo.GetMemberByID(ctx, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to get member: %w", err)
+	}
+	if member == nil || member.DeletedAt != nil {
+		return ErrUserNotFound
+	}
+
+	totp, err := s.Repo.GetMemberTOTP(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	mfa := exportedMFA{Enabled: totp.Enabled()}
+	if totp.Enabled() {
+		mfa.ConfirmedAt = totp.ConfirmedAt
+	}
+
+	identities, err := s.Repo.GetMemberIdentities(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	memberships, err := s.Repo.GetMemberBoardMemberships(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	starred, err := s.Repo.GetMemberStarredBoards(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	sessions, err := s.Repo.GetMemberRefreshTokens(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	accessTokens, err := s.Repo.GetMemberPersonalAccessTokens(ctx, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to get personal access tokens: %w", err)
+	}
+	preferences, err := s.GetMemberPreferences(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	events, err := s.Repo.GetMemberSecurityEvents(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	securityEvents := make([]exportedSecurityEvent, 0, len(events))
+	for _, event := range events {
+		securityEvents = append(securityEvents, exportedSecurityEvent{SecurityEvent: event, Metadata: event.Metadata})
+	}
+
+	sections := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile", member},
+		{"preferences", preferences},
+		{"twoFactor", mfa},
+		{"identities", identities},
+		{"boardMemberships", memberships},
+		{"starredBoards", starred},
+		{"sessions", sessions},
+		{"personalAccessTokens", accessTokens},
+		{"securityEvents", securityEvents},
+	}
+	for _, section := range sections {
+		if err := writer.WriteSection(section.name, section.data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", section.name, err)
+		}
+	}
+
+	// Stream cards
+	err = s.Repo.IterateMemberCards(ctx, memberID, writer.WriteCard)
+	if err != nil {
+		return fmt.Errorf("failed to export cards: %w", err)
+	}
+
+	return writer.Finish()
+}
+
+// QueueMemberDataExport creates a background export, or returns the one already queued in the same format
+func (s *Service) QueueMemberDataExport(ctx context.Context, memberID uuid.UUID, format string) (*models.MemberDataExport, error) {
+	if !ValidDataExportFormat(format) {
+		return nil, ErrInvalidDataExportFormat
+	}
+
+	active, err := s.Repo.GetActiveMemberDataExport(ctx, memberID, format)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return active, nil
+	}
+
+	export := &models.MemberDataExport{
+		ID:        uuid.New(),
+		IDMember:  memberID,
+		Format:    format,
+		Status:    models.DataExportStatusPending,
+		CreatedAt: time.Now(),
+	}
+	if err := s.Repo.CreateMemberDataExport(ctx, export); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// GetMemberDataExport retrieves a background export of the member
+func (s *Service) GetMemberDataExport(ctx context.Context, exportID, memberID uuid.UUID) (*models.MemberDataExport, error) {
+	export, err := s.Repo.GetMemberDataExport(ctx, exportID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil {
+		return nil, ErrDataExportNotFound
+	}
+	return export, nil
+}
+
+// OpenMemberDataExport opens the archive of a finished background export. The caller closes the file.
+func (s *Service) OpenMemberDataExport(ctx context.Context, exportID, memberID uuid.UUID) (*models.MemberDataExport, *os.File, error) {
+	export, err := s.GetMemberDataExport(ctx, exportID, memberID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.Status != models.DataExportStatusReady || export.FilePath == nil {
+		return export, nil, ErrDataExportNotReady
+	}
+
+	file, err := os.Open(*export.FilePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open data export: %w", err)
+	}
+	return export, file, nil
+}
+
+// RunDataExportWorker generates queued exports and removes expired archives until ctx is cancelled
+func (s *Service) RunDataExportWorker(ctx context.Context) {
+	log := utils.Logger()
+	ticker := time.NewTicker(time.Duration(s.DataExportConfig.PollInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		// Work through the queue before waiting for the next tick
+		for {
+			processed, err := s.ProcessMemberDataExport(ctx)
+			if err != nil {
+				log.WithError(err).Error("Failed to process member data export")
+				break
+			}
+			if !processed {
+				break
+			}
+		}
+
+		if err := s.DeleteExpiredMemberDataExports(ctx); err != nil {
+			log.WithError(err).Error("Failed to delete expired member data exports")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessMemberDataExport generates the oldest queued export and reports whether there was one
+func (s *Service) ProcessMemberDataExport(ctx context.Context) (bool, error) {
+	now := time.Now()
+	staleBefore := now.Add(-time.Duration(s.DataExportConfig.StaleAfter) * time.Second)
+	export, err := s.Repo.ClaimMemberDataExport(ctx, now, staleBefore)
+	if err != nil {
+		return false, err
+	}
+	if export == nil {
+		return false, nil
+	}
+
+	path, size, genErr := s.generateMemberDataExport(ctx, export)
+
+	// Failures stay visible for as long as an archive would
+	completedAt := time.Now()
+	expiresAt := completedAt.Add(time.Duration(s.DataExportConfig.TTL) * time.Second)
+	export.CompletedAt = &completedAt
+	export.ExpiresAt = &expiresAt
+	if genErr != nil {
+		utils.Logger().WithError(genErr).WithField("export", export.ID).Error("Failed to generate member data export")
+		message := genErr.Error()
+		export.Status = models.DataExportStatusFailed
+		export.Error = &message
+	} else {
+		export.Status = models.DataExportStatusReady
+		export.FilePath = &path
+		export.SizeBytes = &size
+	}
+
+	if err := s.Repo.CompleteMemberDataExport(ctx, export); err != nil {
+		if genErr == nil {
+			_ = os.Remove(path)
+		}
+		return true, err
+	}
+
+	return true, nil
+}
+
+// DeleteExpiredMemberDataExports removes expired archives from disk together with their export rows
+func (s *Service) DeleteExpiredMemberDataExports(ctx context.Context) error {
+	for {
+		exports, err := s.Repo.GetExpiredMemberDataExports(ctx, time.Now(), expiredDataExportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, export := range exports {
+			if export.FilePath != nil {
+				if err := os.Remove(*export.FilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+					return fmt.Errorf("failed to delete data export file: %w", err)
+				}
+			}
+			if err := s.Repo.DeleteMemberDataExport(ctx, export.ID); err != nil {
+				return err
+			}
+		}
+
+		if len(exports) < expiredDataExportBatchSize {
+			return nil
+		}
+	}
+}
+
+// generateMemberDataExport writes the archive to a temporary file and moves it in place once complete
+func (s *Service) generateMemberDataExport(ctx context.Context, export *models.MemberDataExport) (string, int64, error) {
+	if err := os.MkdirAll(s.DataExportConfig.Dir, 0o750); err != nil {
+		return "", 0, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	path := filepath.Join(s.DataExportConfig.Dir, export.ID.String()+"."+export.Format)
+	file, err := os.CreateTemp(s.DataExportConfig.Dir, export.ID.String()+"-*.tmp")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create export file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	err = s.WriteMemberDataExport(ctx, export.IDMember, export.Format, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", 0, fmt.Errorf("failed to store export file: %w", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to stat export file: %w", err)
+	}
+
+	return path, info.Size(), nil
+}
+
+// jsonMemberDataWriter writes one JSON document, streaming the cards array last
+type jsonMemberDataWriter struct {
+	w         io.Writer
+	opened    bool
+	cardCount int
+}
+
+func (e *jsonMemberDataWriter) open() error {
+	if e.opened {
+		return nil
+	}
+	e.opened = true
+	exportedAt, err := json.Marshal(time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.w, `{"version":%d,"exportedAt":%s`, MemberDataExportVersion, exportedAt)
+	return err
+}
+
+// WriteSection adds a top level field
+func (e *jsonMemberDataWriter) WriteSection(name string, data interface{}) error {
+	if err := e.open(); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.w, `,%q:%s`, name, encoded)
+	return err
+}
+
+// WriteCard appends a card to the cards array
+func (e *jsonMemberDataWriter) WriteCard(card *models.Card) error {
+	if err := e.open(); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(card)
+	if err != nil {
+		return err
+	}
+
+	separator := ","
+	if e.cardCount == 0 {
+		separator = `,"cards":[`
+	}
+	e.cardCount++
+
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(encoded)
+	return err
+}
+
+// Finish closes the cards array and the document
+func (e *jsonMemberDataWriter) Finish() error {
+	if err := e.open(); err != nil {
+		return err
+	}
+	closing := "]}\n"
+	if e.cardCount == 0 {
+		closing = `,"cards":[]}` + "\n"
+	}
+	_, err := io.WriteString(e.w, closing)
+	return err
+}
+
+// zipMemberDataWriter writes every section to its own JSON file in a ZIP archive
+type zipMemberDataWriter struct {
+	zw        *zip.Writer
+	started   bool
+	cards     io.Writer
+	cardCount int
+}
+
+// WriteSection adds <name>.json to the archive
+func (e *zipMemberDataWriter) WriteSection(name string, data interface{}) error {
+	// Describe the archive in its first file
+	if !e.started {
+		e.started = true
+		err := e.writeFile("export", struct {
+			Version    int       `json:"version"`
+			ExportedAt time.Time `json:"exportedAt"`
+		}{
+			Version:    MemberDataExportVersion,
+			ExportedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.writeFile(name, data)
+}
+
+func (e *zipMemberDataWriter) writeFile(name string, data interface{}) error {
+	file, err := e.zw.CreateHeader(&zip.FileHeader{Name: name + ".json", Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+// WriteCard appends a card to cards.json
+func (e *zipMemberDataWriter) WriteCard(card *models.Card) error {
+	if err := e.openCards(); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(card)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n  "
+	if e.cardCount == 0 {
+		separator = "\n  "
+	}
+	e.cardCount++
+
+	if _, err := io.WriteString(e.cards, separator); err != nil {
+		return err
+	}
+	_, err = e.cards.Write(encoded)
+	return err
+}
+
+// Finish closes cards.json and the archive
+func (e *zipMemberDataWriter) Finish() error {
+	if err := e.openCards(); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(e.cards, "\n]\n"); err != nil {
+		return err
+	}
+	return e.zw.Close()
+}
+
+func (e *zipMemberDataWriter) openCards() error {
+	if e.cards != nil {
+		return nil
+	}
+	file, err := e.zw.CreateHeader(&zip.FileHeader{Name: "cards.json", Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	e.cards = file
+	_, err = io.WriteString(file, "[")
+	return err
+}
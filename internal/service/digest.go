@@ -0,0 +1,277 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/mailer"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+const (
+	digestRecipientBatchSize = 100
+	digestWeekday            = time.Monday // weekly digests are sent on this day
+	digestTimeLayout         = "Mon Jan 2 15:04"
+)
+
+var digestHTMLTemplate = template.Must(template.New("digest").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #222">
+<p>Hi {{.Username}},</p>
+<p>{{.Summary}}</p>
+{{range .Boards}}<h3>{{.Name}}</h3>
+<ul>
+{{range .Items}}<li><span style="color: #666">{{.Time}}</span> {{.Text}}</li>
+{{end}}</ul>
+{{end}}{{if .More}}<p>And {{.More}} more in the app.</p>
+{{end}}<p style="color: #666; font-size: small">{{.Footer}}</p>
+</body>
+</html>
+`))
+
+// emailDigest is the content of a digest email, rendered as text and HTML
+type emailDigest struct {
+	Username string
+	Summary  string
+	Boards   []*digestBoard
+	More     int
+	Footer   string
+}
+
+type digestBoard struct {
+	Name  string
+	Items []digestItem
+}
+
+type digestItem struct {
+	Time string
+	Text string
+}
+
+// RunEmailDigests sends due email digests until ctx is cancelled
+func (s *Service) RunEmailDigests(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.NotificationsConfig.DigestInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		sent, err := s.SendEmailDigests(ctx)
+		if err != nil {
+			utils.Logger().WithError(err).Error("Failed to send email digests")
+		} else if sent > 0 {
+			utils.Logger().WithField("sent", sent).Info("Email digests sent")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendEmailDigests emails every member whose digest is due a summary of the unread notifications
+// created since the previous one and returns how many digests were sent
+func (s *Service) SendEmailDigests(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	sent := 0
+	afterMemberID := uuid.Nil
+	for {
+		recipients, err := s.Repo.GetDigestRecipients(ctx, afterMemberID, digestRecipientBatchSize)
+		if err != nil {
+			return sent, fmt.Errorf("failed to get digest recipients: %w", err)
+		}
+
+		for _, recipient := range recipients {
+			// One failing member must not hold back the others, the digest is retried on the next run
+			ok, err := s.sendEmailDigest(ctx, recipient, now)
+			if err != nil {
+				utils.Logger().WithError(err).WithField("member", recipient.IDMember).Error("Failed to send email digest")
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+
+		if len(recipients) < digestRecipientBatchSize {
+			return sent, nil
+		}
+		afterMemberID = recipients[len(recipients)-1].IDMember
+	}
+}
+
+// sendEmailDigest sends the digest of a member if it is due and reports whether it was sent.
+// A digest is due once the oldest notification not emailed yet is older than the latest scheduled
+// digest time, so notifications created after it wait for the next one.
+func (s *Service) sendEmailDigest(ctx context.Context, recipient *models.DigestRecipient, now time.Time) (bool, error) {
+	preferences, err := s.GetMemberPreferences(ctx, recipient.IDMember)
+	if err != nil {
+		return false, err
+	}
+
+	if preferences.EmailDigest == models.EmailDigestOff {
+		// Notifications created while digests are off are never emailed
+		if err := s.Repo.SetLastDigestAt(ctx, recipient.IDMember, now); err != nil {
+			return false, fmt.Errorf("failed to skip email digest: %w", err)
+		}
+		return false, nil
+	}
+
+	location, err := time.LoadLocation(preferences.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	slot := digestSlot(now.In(location), preferences.EmailDigest, s.NotificationsConfig.DigestHour)
+
+	notifications, total, err := s.Repo.GetDigestNotifications(ctx, recipient.IDMember, recipient.LastDigestAt, now, s.NotificationsConfig.DigestMaxItems)
+	if err != nil {
+		return false, fmt.Errorf("failed to get digest notifications: %w", err)
+	}
+	if len(notifications) == 0 || !notifications[0].CreatedAt.Before(slot) {
+		return false, nil
+	}
+
+	digest := buildEmailDigest(recipient, preferences.EmailDigest, notifications, total, location)
+	text := renderDigestText(digest)
+	var html bytes.Buffer
+	if err := digestHTMLTemplate.Execute(&html, digest); err != nil {
+		return false, fmt.Errorf("failed to render email digest: %w", err)
+	}
+
+	err = s.Mailer.Send(ctx, mailer.Message{
+		To:       recipient.Email,
+		Subject:  fmt.Sprintf("Your %s Tasks Control digest: %s", preferences.EmailDigest, pluralize(total, "unread notification")),
+		Body:     text,
+		HTMLBody: html.String(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to send digest email: %w", err)
+	}
+
+	// A failure here sends the same notifications again with the next digest
+	if err := s.Repo.SetLastDigestAt(ctx, recipient.IDMember, now); err != nil {
+		return true, fmt.Errorf("failed to record email digest: %w", err)
+	}
+	return true, nil
+}
+
+// digestSlot returns the latest scheduled digest time at or before now, which must be in the member's time zone
+func digestSlot(now time.Time, frequency string, hour int) time.Time {
+	slot := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	if slot.After(now) {
+		slot = slot.AddDate(0, 0, -1)
+	}
+	if frequency == models.EmailDigestWeekly {
+		for slot.Weekday() != digestWeekday {
+			slot = slot.AddDate(0, 0, -1)
+		}
+	}
+	return slot
+}
+
+// buildEmailDigest groups the notifications by board in the order their first notification was created
+func buildEmailDigest(recipient *models.DigestRecipient, frequency string, notifications []*models.DigestNotification, total int, location *time.Location) *emailDigest {
+	schedule := frequency
+	if frequency == models.EmailDigestWeekly {
+		schedule = "weekly on " + digestWeekday.String() + "s"
+	}
+
+	digest := &emailDigest{
+		Username: recipient.Username,
+		Summary:  fmt.Sprintf("You have %s on Tasks Control:", pluralize(total, "unread notification")),
+		More:     total - len(notifications),
+		Footer:   fmt.Sprintf("You get this email %s. Change how often or turn it off in your notification preferences.", schedule),
+	}
+
+	boards := make(map[uuid.UUID]*digestBoard)
+	for _, notification := range notifications {
+		var boardID uuid.UUID
+		if notification.IDBoard != nil {
+			boardID = *notification.IDBoard
+		}
+		board, ok := boards[boardID]
+		if !ok {
+			board = &digestBoard{Name: "Board"}
+			if notification.BoardName != nil {
+				board.Name = *notification.BoardName
+			}
+			boards[boardID] = board
+			digest.Boards = append(digest.Boards, board)
+		}
+		board.Items = append(board.Items, digestItem{
+			Time: notification.CreatedAt.In(location).Format(digestTimeLayout),
+			Text: describeNotification(notification),
+		})
+	}
+
+	return digest
+}
+
+// renderDigestText renders the plain text part of a digest email
+func renderDigestText(digest *emailDigest) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Hi %s,\n\n%s\n", digest.Username, digest.Summary)
+	for _, board := range digest.Boards {
+		fmt.Fprintf(&b, "\n%s\n", board.Name)
+		for _, item := range board.Items {
+			fmt.Fprintf(&b, "  - %s  %s\n", item.Time, item.Text)
+		}
+	}
+	if digest.More > 0 {
+		fmt.Fprintf(&b, "\nAnd %d more in the app.\n", digest.More)
+	}
+	fmt.Fprintf(&b, "\n%s\n", digest.Footer)
+	return b.String()
+}
+
+// describeNotification returns a one line description of a notification for the recipient
+func describeNotification(notification *models.DigestNotification) string {
+	actor := "Someone"
+	if notification.ActorUsername != nil {
+		actor = *notification.ActorUsername
+	}
+
+	var data struct {
+		CardTitle string `json:"cardTitle"`
+	}
+	// Older or unexpected data only loses the card title
+	_ = json.Unmarshal(notification.Data, &data)
+
+	switch notification.Type {
+	case models.NotificationBoardMemberJoined:
+		return actor + " joined the board"
+	case models.NotificationBoardMemberLeft:
+		return actor + " left the board"
+	case models.NotificationBoardMemberRemoved:
+		return actor + " removed you from the board"
+	case models.NotificationCardCreated:
+		return fmt.Sprintf("%s added \"%s\"", actor, data.CardTitle)
+	case models.NotificationCardMoved:
+		return fmt.Sprintf("%s moved \"%s\"", actor, data.CardTitle)
+	case models.NotificationCardArchived:
+		return fmt.Sprintf("%s archived \"%s\"", actor, data.CardTitle)
+	case models.NotificationCardUnarchived:
+		return fmt.Sprintf("%s restored \"%s\"", actor, data.CardTitle)
+	case models.NotificationCardDeleted:
+		return fmt.Sprintf("%s deleted \"%s\"", actor, data.CardTitle)
+	case models.NotificationCardMentioned:
+		return fmt.Sprintf("%s mentioned you in \"%s\"", actor, data.CardTitle)
+	default:
+		return fmt.Sprintf("%s updated \"%s\"", actor, data.CardTitle)
+	}
+}
+
+func pluralize(count int, noun string) string {
+	if count == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
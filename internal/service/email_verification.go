@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/mailer"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var (
+	ErrEmailNotVerified         = errors.New("email address is not verified")
+	ErrInvalidVerificationToken = errors.New("invalid or expired email verification token")
+)
+
+const emailVerificationTokenBytes = 32
+
+// VerifyEmail marks the member's email as verified with a token from the verification link
+func (s *Service) VerifyEmail(ctx context.Context, token string) error {
+	memberID, err := s.Repo.VerifyMemberEmail(ctx, utils.HashToken(token), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to verify email: %w", err)
+	}
+	if memberID == uuid.Nil {
+		return ErrInvalidVerificationToken
+	}
+	return nil
+}
+
+// ResendEmailVerification sends a new verification link to an unverified member.
+// Unknown or verified emails and resends within the cooldown are ignored, so the response
+// doesn't reveal which accounts exist.
+func (s *Service) ResendEmailVerification(ctx context.Context, email string) error {
+	member, err := s.Repo.GetMemberByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to get member: %w", err)
+	}
+	if member == nil || member.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	// Check cooldown
+	last, err := s.Repo.GetLastEmailVerificationToken(ctx, member.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get last verification token: %w", err)
+	}
+	cooldown := time.Duration(s.AuthConfig.EmailVerificationCooldown) * time.Second
+	if last != nil && time.Since(last.CreatedAt) < cooldown {
+		return nil
+	}
+
+	return s.sendEmailVerification(ctx, member)
+}
+
+// checkEmailVerified returns ErrEmailNotVerified when the config requires a verified email for the action
+func (s *Service) checkEmailVerified(member *models.Member, modes ...string) error {
+	if member.EmailVerifiedAt != nil {
+		return nil
+	}
+	for _, mode := range modes {
+		if s.AuthConfig.RequireVerifiedEmail == mode {
+			return ErrEmailNotVerified
+		}
+	}
+	return nil
+}
+
+// sendEmailVerification emails a one-time verification link for the member's current address
+func (s *Service) sendEmailVerification(ctx context.Context, member *models.Member) error {
+	// Generate token, only its hash is stored
+	token, err := utils.GenerateSecret(emailVerificationTokenBytes)
+	if err != nil {
+		return fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	now := time.Now()
+	ttl := time.Duration(s.AuthConfig.EmailVerificationTTL) * time.Second
+	err = s.Repo.CreateEmailVerificationToken(ctx, &models.EmailVerificationToken{
+		ID:        uuid.New(),
+		IDMember:  member.ID,
+		Email:     member.Email,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	// Send verification link
+	link := strings.ReplaceAll(s.AuthConfig.EmailVerificationURL, "{token}", token)
+	err = s.Mailer.Send(ctx, mailer.Message{
+		To:      member.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\n"+
+			"Please confirm this is your email address by opening the link below:\n\n"+
+			"%s\n\n"+
+			"The link expires in %d hours.\n",
+			member.Username, link, int(ttl.Hours())),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+// BoardExportVersion is the version of the JSON board export format
+const BoardExportVersion = 1
+
+// BoardExport holds board data written before the card stream starts
+type BoardExport struct {
+	Board        *models.Board
+	Lists        []*models.List
+	Members      []*models.BoardMemberWithRole
+	Labels       []*models.Label
+	CustomFields []*models.CustomField
+}
+
+// BoardExporter writes a board export in a specific format.
+// WriteHeader is called once before any card, Finish once after the last card.
+// Cards are passed with their custom field values.
+type BoardExporter interface {
+	WriteHeader(export *BoardExport) error
+	WriteCard(card *models.Card, labelIDs []uuid.UUID) error
+	Finish() error
+}
+
+// ExportBoard streams a board with its lists, members, labels and all cards (including archived) to the exporter
+func (s *Service) ExportBoard(ctx context.Context, boardID, memberID uuid.UUID, exporter BoardExporter) error {
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, boardID, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return ErrNotBoardMember
+	}
+
+	// Get board
+	board, err := s.Repo.GetBoardByID(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board: %w", err)
+	}
+	if board == nil {
+		return ErrBoardNotFound
+	}
+
+	// Get lists, including archived ones
+	lists, err := s.Repo.GetAllBoardLists(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board lists: %w", err)
+	}
+
+	// Get members with roles
+	members, err := s.Repo.GetBoardMembersWithRoles(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board members: %w", err)
+	}
+
+	// Get labels
+	labels, err := s.Repo.GetBoardLabels(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board labels: %w", err)
+	}
+
+	// Get custom fields and the values of all cards, they are small next to the cards
+	customFields, err := s.Repo.GetBoardCustomFields(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board custom fields: %w", err)
+	}
+	values, err := s.Repo.GetBoardCustomFieldValues(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board custom field values: %w", err)
+	}
+	cardValues := make(map[uuid.UUID][]*models.CardCustomFieldValue)
+	for _, value := range values {
+		cardValues[value.IDCard] = append(cardValues[value.IDCard], value)
+	}
+
+	err = exporter.WriteHeader(&BoardExport{
+		Board:        board,
+		Lists:        lists,
+		Members:      members,
+		Labels:       labels,
+		CustomFields: customFields,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write export header: %w", err)
+	}
+
+	// Stream cards
+	err = s.Repo.IterateBoardCards(ctx, boardID, func(card *models.Card, labelIDs []uuid.UUID) error {
+		card.CustomFields = cardValues[card.ID]
+		return exporter.WriteCard(card, labelIDs)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export board cards: %w", err)
+	}
+
+	return exporter.Finish()
+}
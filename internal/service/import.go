@@ -0,0 +1,603 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrUnsupportedImportFormat = errors.New("unsupported import format")
+	ErrInvalidImportData       = errors.New("invalid import data")
+	ErrInvalidImportBoardName  = fmt.Errorf("board name must be 1 to %d characters", maxBoardNameLength)
+)
+
+// ImportNameError reports an imported list or card with an empty name or one longer than a created list or card may have.
+// It matches ErrInvalidImportData.
+type ImportNameError struct {
+	Type      string // list or card
+	ID        string // ID in the imported data
+	MaxLength int
+}
+
+func (e *ImportNameError) Error() string {
+	return fmt.Sprintf("%s %s must have a name of 1 to %d characters", e.Type, e.ID, e.MaxLength)
+}
+
+func (e *ImportNameError) Is(target error) bool {
+	return target == ErrInvalidImportData
+}
+
+// Import sources
+const (
+	ImportSourceExport = "export"
+	ImportSourceTrello = "trello"
+)
+
+// Column limits of the imported tables
+const (
+	maxBoardNameLength = 100
+	maxListNameLength  = 100
+	maxCardTitleLength = 200
+	maxLabelNameLength = 100
+	maxLabelColorLen   = 20
+)
+
+// ImportBoardRequest represents the data needed to import a board
+type ImportBoardRequest struct {
+	Name            *string // Overrides the name found in the imported data
+	NameBoardUnique string
+	Password        string
+	Data            []byte
+	MemberID        uuid.UUID
+}
+
+// ImportUnmappedField counts imported items that had a value in a field we can't store
+type ImportUnmappedField struct {
+	Field string
+	Count int
+}
+
+// ImportSkippedItem describes an item that was not imported
+type ImportSkippedItem struct {
+	Type   string
+	ID     string
+	Reason string
+}
+
+// ImportReport summarizes what was imported and what was left out
+type ImportReport struct {
+	Source             string
+	ListsImported      int
+	CardsImported      int
+	LabelsImported     int
+	ChecklistsImported int
+	UnmappedFields     []ImportUnmappedField
+	Skipped            []ImportSkippedItem
+}
+
+// ImportResult represents the imported board together with the import report
+type ImportResult struct {
+	Board  *models.Board
+	Report *ImportReport
+}
+
+// ImportBoard creates a new board from a board export or a Trello board export
+func (s *Service) ImportBoard(ctx context.Context, req ImportBoardRequest) (*ImportResult, error) {
+	// Validate the name override like a created board name
+	if req.Name != nil && (strings.TrimSpace(*req.Name) == "" || utf8.RuneCountInString(*req.Name) > maxBoardNameLength) {
+		return nil, ErrInvalidImportBoardName
+	}
+
+	// Validate unique name format
+	if !isValidBoardUniqueName(req.NameBoardUnique) {
+		return nil, ErrInvalidBoardUniqueName
+	}
+
+	// Check if unique name is already taken
+	existingBoard, err := s.Repo.GetBoardByUniqueName(ctx, req.NameBoardUnique)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check board existence: %w", err)
+	}
+	if existingBoard != nil {
+		return nil, ErrBoardAlreadyExists
+	}
+
+	// Detect source format
+	var topLevel map[string]json.RawMessage
+	if err := json.Unmarshal(req.Data, &topLevel); err != nil {
+		return nil, ErrInvalidImportData
+	}
+
+	builder := newBoardImportBuilder(req.MemberID)
+	switch detectImportSource(topLevel) {
+	case ImportSourceExport:
+		err = builder.fromExport(req.Data)
+	case ImportSourceTrello:
+		err = builder.fromTrello(req.Data, topLevel)
+	default:
+		return nil, ErrUnsupportedImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if builder.invalidName != nil {
+		return nil, builder.invalidName
+	}
+
+	// Hash password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	board := builder.data.Board
+	board.NameBoardUnique = req.NameBoardUnique
+	board.PasswordHash = string(hashedPassword)
+	if req.Name != nil {
+		board.Name = *req.Name
+	}
+
+	// Insert everything in one transaction
+	err = s.Repo.ImportBoard(ctx, builder.data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import board: %w", err)
+	}
+
+	return &ImportResult{
+		Board:  board,
+		Report: builder.finishReport(),
+	}, nil
+}
+
+// detectImportSource guesses the format of the imported document from its top-level keys
+func detectImportSource(topLevel map[string]json.RawMessage) string {
+	_, hasVersion := topLevel["version"]
+	_, hasBoard := topLevel["board"]
+	if hasVersion && hasBoard {
+		return ImportSourceExport
+	}
+
+	_, hasLists := topLevel["lists"]
+	_, hasCards := topLevel["cards"]
+	_, hasName := topLevel["name"]
+	if hasLists && hasCards && hasName {
+		return ImportSourceTrello
+	}
+
+	return ""
+}
+
+// boardImportBuilder maps an imported document onto new board rows and collects the report
+type boardImportBuilder struct {
+	data        *models.BoardImport
+	report      *ImportReport
+	unmapped    map[string]int
+	invalidName *ImportNameError // first list or card that can't be imported with its name
+	memberID    uuid.UUID
+	now         time.Time
+}
+
+func newBoardImportBuilder(memberID uuid.UUID) *boardImportBuilder {
+	now := time.Now()
+	return &boardImportBuilder{
+		data: &models.BoardImport{
+			Board: &models.Board{
+				ID:              uuid.New(),
+				IDMemberCreator: memberID,
+				WIPLimitMode:    models.WIPLimitModeSoft,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+			},
+		},
+		report:   &ImportReport{},
+		unmapped: make(map[string]int),
+		memberID: memberID,
+		now:      now,
+	}
+}
+
+func (b *boardImportBuilder) skip(itemType, id, reason string) {
+	b.report.Skipped = append(b.report.Skipped, ImportSkippedItem{Type: itemType, ID: id, Reason: reason})
+}
+
+// truncate shortens a value to the column limit, counting it as partially unmapped
+func (b *boardImportBuilder) truncate(field, value string, limit int) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+	b.unmapped[field+" (truncated)"]++
+	return string([]rune(value)[:limit])
+}
+
+// checkName records a list or card name that is empty or over the limit, the import is rejected then
+func (b *boardImportBuilder) checkName(itemType, id, name string, limit int) {
+	if b.invalidName != nil {
+		return
+	}
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > limit {
+		b.invalidName = &ImportNameError{Type: itemType, ID: id, MaxLength: limit}
+	}
+}
+
+func (b *boardImportBuilder) setBoardName(name string, description *string) {
+	if name == "" {
+		name = "Imported board"
+	}
+	b.data.Board.Name = b.truncate("name", name, maxBoardNameLength)
+	if description != nil && *description != "" {
+		b.data.Board.Description = description
+	}
+}
+
+func (b *boardImportBuilder) addList(sourceID, name string, position float64, archived bool, createdAt, updatedAt time.Time) uuid.UUID {
+	b.checkName("list", sourceID, name, maxListNameLength)
+	list := &models.List{
+		ID:        uuid.New(),
+		Name:      name,
+		IDBoard:   b.data.Board.ID,
+		Position:  position,
+		Archived:  archived,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+	b.data.Lists = append(b.data.Lists, list)
+	b.report.ListsImported++
+	return list.ID
+}
+
+func (b *boardImportBuilder) addLabel(name *string, color string, createdAt time.Time) uuid.UUID {
+	if name != nil {
+		truncated := b.truncate("labels[].name", *name, maxLabelNameLength)
+		name = &truncated
+		if *name == "" {
+			name = nil
+		}
+	}
+	label := &models.Label{
+		ID:        uuid.New(),
+		IDBoard:   b.data.Board.ID,
+		Name:      name,
+		Color:     b.truncate("labels[].color", color, maxLabelColorLen),
+		CreatedAt: createdAt,
+	}
+	b.data.Labels = append(b.data.Labels, label)
+	b.report.LabelsImported++
+	return label.ID
+}
+
+func (b *boardImportBuilder) addCard(sourceID, title string, description *string, listID uuid.UUID, position float64, archived bool,
+	createdAt, updatedAt time.Time, labelIDs []uuid.UUID,
+) *models.Card {
+	b.checkName("card", sourceID, title, maxCardTitleLength)
+	if description != nil && *description == "" {
+		description = nil
+	}
+	card := &models.Card{
+		ID:          uuid.New(),
+		Title:       title,
+		Description: description,
+		IDList:      listID,
+		Position:    position,
+		Archived:    archived,
+		CreatedBy:   b.memberID,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+	b.data.Cards = append(b.data.Cards, card)
+	for _, labelID := range labelIDs {
+		b.data.CardLabels = append(b.data.CardLabels, models.CardLabel{IDCard: card.ID, IDLabel: labelID})
+	}
+	b.report.CardsImported++
+	return card
+}
+
+func (b *boardImportBuilder) finishReport() *ImportReport {
+	b.report.UnmappedFields = make([]ImportUnmappedField, 0, len(b.unmapped))
+	for field, count := range b.unmapped {
+		b.report.UnmappedFields = append(b.report.UnmappedFields, ImportUnmappedField{Field: field, Count: count})
+	}
+	sort.Slice(b.report.UnmappedFields, func(i, j int) bool {
+		return b.report.UnmappedFields[i].Field < b.report.UnmappedFields[j].Field
+	})
+	if b.report.Skipped == nil {
+		b.report.Skipped = []ImportSkippedItem{}
+	}
+	return b.report
+}
+
+// exportDocument mirrors the parts of the BoardExport JSON that can be imported
+type exportDocument struct {
+	Version int `json:"version"`
+	Board   struct {
+		Name        string  `json:"name"`
+		Description *string `json:"description"`
+	} `json:"board"`
+	Lists []struct {
+		ID        uuid.UUID  `json:"id"`
+		Name      string     `json:"name"`
+		Position  float64    `json:"position"`
+		Archived  bool       `json:"archived"`
+		CreatedAt *time.Time `json:"createdAt"`
+		UpdatedAt *time.Time `json:"updatedAt"`
+	} `json:"lists"`
+	Labels []struct {
+		ID        uuid.UUID  `json:"id"`
+		Name      *string    `json:"name"`
+		Color     string     `json:"color"`
+		CreatedAt *time.Time `json:"createdAt"`
+	} `json:"labels"`
+	Cards []struct {
+		ID          uuid.UUID   `json:"id"`
+		Title       string      `json:"title"`
+		Description *string     `json:"description"`
+		IDList      uuid.UUID   `json:"idList"`
+		Position    float64     `json:"position"`
+		Archived    bool        `json:"archived"`
+		CreatedBy   *uuid.UUID  `json:"createdBy"`
+		CreatedAt   *time.Time  `json:"createdAt"`
+		UpdatedAt   *time.Time  `json:"updatedAt"`
+		IDLabels    []uuid.UUID `json:"idLabels"`
+	} `json:"cards"`
+	Members []json.RawMessage `json:"members"`
+}
+
+// fromExport maps a BoardExport document, generating new IDs for every row
+func (b *boardImportBuilder) fromExport(raw []byte) error {
+	var doc exportDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return ErrInvalidImportData
+	}
+	if doc.Version != BoardExportVersion {
+		return ErrUnsupportedImportFormat
+	}
+	b.report.Source = ImportSourceExport
+
+	b.setBoardName(doc.Board.Name, doc.Board.Description)
+
+	// Memberships can't be recreated, the importing member becomes the only owner
+	if len(doc.Members) > 0 {
+		b.unmapped["members"] = len(doc.Members)
+	}
+
+	listIDs := make(map[uuid.UUID]uuid.UUID, len(doc.Lists))
+	for _, list := range doc.Lists {
+		listIDs[list.ID] = b.addList(list.ID.String(), list.Name, list.Position, list.Archived, timeOr(list.CreatedAt, b.now), timeOr(list.UpdatedAt, b.now))
+	}
+
+	labelIDs := make(map[uuid.UUID]uuid.UUID, len(doc.Labels))
+	for _, label := range doc.Labels {
+		labelIDs[label.ID] = b.addLabel(label.Name, label.Color, timeOr(label.CreatedAt, b.now))
+	}
+
+	for _, card := range doc.Cards {
+		listID, ok := listIDs[card.IDList]
+		if !ok {
+			b.skip("card", card.ID.String(), "list not found")
+			continue
+		}
+
+		cardLabelIDs := make([]uuid.UUID, 0, len(card.IDLabels))
+		for _, oldLabelID := range card.IDLabels {
+			if labelID, ok := labelIDs[oldLabelID]; ok {
+				cardLabelIDs = append(cardLabelIDs, labelID)
+			}
+		}
+
+		if card.CreatedBy != nil && *card.CreatedBy != b.memberID {
+			b.unmapped["cards[].createdBy"]++
+		}
+
+		b.addCard(card.ID.String(), card.Title, card.Description, listID, card.Position, card.Archived,
+			timeOr(card.CreatedAt, b.now), timeOr(card.UpdatedAt, b.now), cardLabelIDs)
+	}
+
+	return nil
+}
+
+// trelloBoard mirrors the parts of a Trello board JSON export that can be imported
+type trelloBoard struct {
+	Name  string `json:"name"`
+	Desc  string `json:"desc"`
+	Lists []struct {
+		ID     string  `json:"id"`
+		Name   string  `json:"name"`
+		Closed bool    `json:"closed"`
+		Pos    float64 `json:"pos"`
+	} `json:"lists"`
+	Labels []struct {
+		ID    string  `json:"id"`
+		Name  string  `json:"name"`
+		Color *string `json:"color"`
+	} `json:"labels"`
+	Cards []struct {
+		ID               string     `json:"id"`
+		Name             string     `json:"name"`
+		Desc             string     `json:"desc"`
+		IDList           string     `json:"idList"`
+		Closed           bool       `json:"closed"`
+		Pos              float64    `json:"pos"`
+		IDLabels         []string   `json:"idLabels"`
+		DateLastActivity *time.Time `json:"dateLastActivity"`
+	} `json:"cards"`
+	Checklists []struct {
+		ID         string  `json:"id"`
+		Name       string  `json:"name"`
+		IDCard     string  `json:"idCard"`
+		Pos        float64 `json:"pos"`
+		CheckItems []struct {
+			Name  string  `json:"name"`
+			State string  `json:"state"`
+			Pos   float64 `json:"pos"`
+		} `json:"checkItems"`
+	} `json:"checklists"`
+}
+
+// trelloRawBoard is used to find fields of a Trello export that have no counterpart here
+type trelloRawBoard struct {
+	Lists      []map[string]json.RawMessage `json:"lists"`
+	Labels     []map[string]json.RawMessage `json:"labels"`
+	Cards      []map[string]json.RawMessage `json:"cards"`
+	Checklists []map[string]json.RawMessage `json:"checklists"`
+}
+
+// fromTrello maps a Trello board export. Checklists are appended to the card description as task lists.
+func (b *boardImportBuilder) fromTrello(raw []byte, topLevel map[string]json.RawMessage) error {
+	var board trelloBoard
+	if err := json.Unmarshal(raw, &board); err != nil {
+		return ErrInvalidImportData
+	}
+	var rawBoard trelloRawBoard
+	if err := json.Unmarshal(raw, &rawBoard); err != nil {
+		return ErrInvalidImportData
+	}
+	b.report.Source = ImportSourceTrello
+
+	// Collect fields we can't store. Known fields are either imported or only meaningful inside Trello.
+	b.countUnmapped("", []map[string]json.RawMessage{topLevel},
+		fieldSet("id", "name", "desc", "lists", "labels", "cards", "checklists", "url", "shortUrl", "shortLink"))
+	b.countUnmapped("lists[].", rawBoard.Lists,
+		fieldSet("id", "name", "closed", "pos", "idBoard"))
+	b.countUnmapped("labels[].", rawBoard.Labels,
+		fieldSet("id", "name", "color", "idBoard"))
+	b.countUnmapped("cards[].", rawBoard.Cards,
+		fieldSet("id", "name", "desc", "idList", "closed", "pos", "idLabels", "labels", "idChecklists",
+			"dateLastActivity", "idBoard", "idShort", "shortLink", "shortUrl", "url"))
+	b.countUnmapped("checklists[].", rawBoard.Checklists,
+		fieldSet("id", "name", "idCard", "pos", "checkItems", "idBoard"))
+
+	b.setBoardName(board.Name, &board.Desc)
+
+	listIDs := make(map[string]uuid.UUID, len(board.Lists))
+	for _, list := range board.Lists {
+		createdAt := trelloIDTime(list.ID, b.now)
+		listIDs[list.ID] = b.addList(list.ID, list.Name, list.Pos, list.Closed, createdAt, createdAt)
+	}
+
+	labelIDs := make(map[string]uuid.UUID, len(board.Labels))
+	for _, label := range board.Labels {
+		// Trello allows labels without a color
+		color := "none"
+		if label.Color != nil && *label.Color != "" {
+			color = *label.Color
+		}
+		name := label.Name
+		labelIDs[label.ID] = b.addLabel(&name, color, trelloIDTime(label.ID, b.now))
+	}
+
+	cards := make(map[string]*models.Card, len(board.Cards))
+	for _, card := range board.Cards {
+		listID, ok := listIDs[card.IDList]
+		if !ok {
+			b.skip("card", card.ID, "list not found")
+			continue
+		}
+
+		cardLabelIDs := make([]uuid.UUID, 0, len(card.IDLabels))
+		for _, trelloLabelID := range card.IDLabels {
+			if labelID, ok := labelIDs[trelloLabelID]; ok {
+				cardLabelIDs = append(cardLabelIDs, labelID)
+			}
+		}
+
+		createdAt := trelloIDTime(card.ID, b.now)
+		updatedAt := timeOr(card.DateLastActivity, createdAt)
+		description := card.Desc
+		cards[card.ID] = b.addCard(card.ID, card.Name, &description, listID, card.Pos, card.Closed, createdAt, updatedAt, cardLabelIDs)
+	}
+
+	// Append checklists to descriptions, in Trello order
+	checklists := board.Checklists
+	sort.SliceStable(checklists, func(i, j int) bool { return checklists[i].Pos < checklists[j].Pos })
+	for _, checklist := range checklists {
+		card, ok := cards[checklist.IDCard]
+		if !ok {
+			b.skip("checklist", checklist.ID, "card not found")
+			continue
+		}
+
+		items := checklist.CheckItems
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Pos < items[j].Pos })
+
+		var sb strings.Builder
+		if card.Description != nil {
+			sb.WriteString(*card.Description)
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString("### ")
+		sb.WriteString(checklist.Name)
+		for _, item := range items {
+			if item.State == "complete" {
+				sb.WriteString("\n- [x] ")
+			} else {
+				sb.WriteString("\n- [ ] ")
+			}
+			sb.WriteString(item.Name)
+		}
+
+		description := sb.String()
+		card.Description = &description
+		b.report.ChecklistsImported++
+	}
+
+	return nil
+}
+
+// countUnmapped counts items that have a non-empty value in a field outside of known
+func (b *boardImportBuilder) countUnmapped(prefix string, items []map[string]json.RawMessage, known map[string]bool) {
+	for _, item := range items {
+		for field, value := range item {
+			if known[field] || isEmptyJSON(value) {
+				continue
+			}
+			b.unmapped[prefix+field]++
+		}
+	}
+}
+
+func fieldSet(fields ...string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[field] = true
+	}
+	return set
+}
+
+// isEmptyJSON reports whether a raw JSON value carries no information
+func isEmptyJSON(value json.RawMessage) bool {
+	switch string(bytes.TrimSpace(value)) {
+	case "", "null", `""`, "[]", "{}", "false", "0":
+		return true
+	}
+	return false
+}
+
+// trelloIDTime extracts the creation time encoded in the first 4 bytes of a Trello object ID
+func trelloIDTime(id string, fallback time.Time) time.Time {
+	if len(id) < 8 {
+		return fallback
+	}
+	seconds, err := strconv.ParseInt(id[:8], 16, 64)
+	if err != nil {
+		return fallback
+	}
+	return time.Unix(seconds, 0)
+}
+
+func timeOr(t *time.Time, fallback time.Time) time.Time {
+	if t == nil || t.IsZero() {
+		return fallback
+	}
+	return *t
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+)
+
+var (
+	ErrLabelNotFound = errors.New("label not found")
+)
+
+// CreateLabelRequest represents the data needed to create a new label
+type CreateLabelRequest struct {
+	Name     *string
+	Color    string
+	IDBoard  uuid.UUID
+	MemberID uuid.UUID
+}
+
+// CreateLabel creates a new label on a board
+func (s *Service) CreateLabel(ctx context.Context, req CreateLabelRequest) (*models.Label, error) {
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, req.IDBoard, req.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return nil, ErrNotBoardMember
+	}
+
+	// Create label
+	label := &models.Label{
+		ID:        uuid.New(),
+		IDBoard:   req.IDBoard,
+		Name:      req.Name,
+		Color:     req.Color,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.Repo.CreateLabel(ctx, label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create label: %w", err)
+	}
+
+	return label, nil
+}
+
+// GetBoardLabels retrieves all labels of a board
+func (s *Service) GetBoardLabels(ctx context.Context, boardID, memberID uuid.UUID) ([]*models.Label, error) {
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, boardID, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return nil, ErrNotBoardMember
+	}
+
+	labels, err := s.Repo.GetBoardLabels(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board labels: %w", err)
+	}
+
+	return labels, nil
+}
@@ -9,12 +9,34 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/repository"
 )
 
 var (
-	ErrListNotFound = errors.New("list not found")
+	ErrListNotFound     = errors.New("list not found")
+	ErrSameList         = errors.New("source and target list are the same")
+	ErrListInOtherBoard = errors.New("target list belongs to a different board")
+	ErrInvalidWIPLimit  = errors.New("WIP limit must not be negative")
+	ErrWIPLimitExceeded = errors.New("list WIP limit exceeded")
 )
 
+// WIPLimitError reports the list whose WIP limit a card would exceed. It matches ErrWIPLimitExceeded.
+type WIPLimitError struct {
+	ListID   uuid.UUID
+	ListName string
+	Limit    int
+	Count    int    // Active cards in the list
+	Mode     string // Soft limits can be exceeded by retrying with IgnoreWIPLimit
+}
+
+func (e *WIPLimitError) Error() string {
+	return fmt.Sprintf("list %q is at its WIP limit of %d cards", e.ListName, e.Limit)
+}
+
+func (e *WIPLimitError) Is(target error) bool {
+	return target == ErrWIPLimitExceeded
+}
+
 // CreateListRequest represents the data needed to create a new list
 type CreateListRequest struct {
 	Name     string
@@ -28,6 +50,7 @@ type UpdateListRequest struct {
 	Name     *string
 	Position *float64
 	Archived *bool
+	WIPLimit *int // 0 removes the limit
 }
 
 // ListWithCards represents a list with its cards
@@ -154,6 +177,17 @@ func (s *Service) UpdateList(ctx context.Context, listID, memberID uuid.UUID, re
 		list.Archived = *req.Archived
 	}
 
+	// Lowering the limit below the current card count is allowed, it only blocks new cards
+	if req.WIPLimit != nil {
+		if *req.WIPLimit < 0 {
+			return nil, ErrInvalidWIPLimit
+		}
+		list.WIPLimit = req.WIPLimit
+		if *req.WIPLimit == 0 {
+			list.WIPLimit = nil
+		}
+	}
+
 	list.UpdatedAt = time.Now()
 
 	// Save updated list
@@ -199,3 +233,124 @@ func (s *Service) DeleteList(ctx context.Context, listID, memberID uuid.UUID) er
 
 	return nil
 }
+
+// ArchiveAllListCards archives every active card in a list
+func (s *Service) ArchiveAllListCards(ctx context.Context, listID, memberID uuid.UUID) (int, error) {
+	// Get list
+	list, err := s.Repo.GetListByID(ctx, listID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get list: %w", err)
+	}
+	if list == nil {
+		return 0, ErrListNotFound
+	}
+
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, list.IDBoard, memberID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return 0, ErrNotBoardMember
+	}
+
+	// Archive cards
+	archived, err := s.Repo.ArchiveAllListCards(ctx, listID, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive list cards: %w", err)
+	}
+
+	if len(archived) > 0 {
+		s.publishWebhookEvent(ctx, list.IDBoard, models.WebhookEventListCardsArchived, map[string]interface{}{
+			"idList": listID,
+			"count":  len(archived),
+		})
+	}
+	watchers := s.cardsWatcherIDs(ctx, changedCardIDs(archived))
+	for _, change := range archived {
+		s.notifyCardChange(ctx, list.IDBoard, &change.Card, models.NotificationCardArchived, memberID, nil, watchers[change.ID])
+	}
+
+	return len(archived), nil
+}
+
+// MoveAllListCards moves every active card in a list to the end of another list in the same board.
+// ignoreWIPLimit lets the cards exceed a soft WIP limit of the target list.
+func (s *Service) MoveAllListCards(ctx context.Context, sourceListID, targetListID, memberID uuid.UUID, ignoreWIPLimit bool) (int, error) {
+	if sourceListID == targetListID {
+		return 0, ErrSameList
+	}
+
+	// Get source list
+	sourceList, err := s.Repo.GetListByID(ctx, sourceListID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get list: %w", err)
+	}
+	if sourceList == nil {
+		return 0, ErrListNotFound
+	}
+
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, sourceList.IDBoard, memberID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return 0, ErrNotBoardMember
+	}
+
+	// Check if target list exists and is in the same board
+	targetList, err := s.Repo.GetListByID(ctx, targetListID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get target list: %w", err)
+	}
+	if targetList == nil {
+		return 0, ErrListNotFound
+	}
+	if targetList.IDBoard != sourceList.IDBoard {
+		return 0, ErrListInOtherBoard
+	}
+
+	// Move cards
+	moved, err := s.Repo.MoveAllListCards(ctx, sourceListID, targetListID, time.Now(), &models.WIPLimitCheck{IgnoreSoftLimit: ignoreWIPLimit})
+	if err != nil {
+		if errors.Is(err, repository.ErrListNotFound) {
+			return 0, ErrListNotFound
+		}
+		if limitErr := wipLimitError(err); limitErr != nil {
+			return 0, limitErr
+		}
+		return 0, fmt.Errorf("failed to move list cards: %w", err)
+	}
+
+	if len(moved) > 0 {
+		s.publishWebhookEvent(ctx, sourceList.IDBoard, models.WebhookEventListCardsMoved, map[string]interface{}{
+			"idListSource": sourceListID,
+			"idListTarget": targetListID,
+			"count":        len(moved),
+		})
+	}
+	// Watchers of the target list are notified about the move
+	watchers := s.cardsWatcherIDs(ctx, changedCardIDs(moved))
+	for _, change := range moved {
+		data := map[string]interface{}{"idListBefore": sourceListID}
+		s.notifyCardChange(ctx, sourceList.IDBoard, &change.Card, models.NotificationCardMoved, memberID, data, watchers[change.ID])
+	}
+
+	return len(moved), nil
+}
+
+// wipLimitError converts a WIP limit rejection of the repository into a WIPLimitError, other errors give nil
+func wipLimitError(err error) error {
+	var exceeded *models.WIPLimitExceeded
+	if !errors.As(err, &exceeded) {
+		return nil
+	}
+	return &WIPLimitError{
+		ListID:   exceeded.IDList,
+		ListName: exceeded.ListName,
+		Limit:    exceeded.Limit,
+		Count:    exceeded.Count,
+		Mode:     exceeded.Mode,
+	}
+}
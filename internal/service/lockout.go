@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var ErrTooManyAttempts = errors.New("too many failed attempts")
+
+const authFailureCleanupBatchSize = 1000
+
+// Failed attempt scopes, accounts and IP addresses are counted separately
+const (
+	attemptScopeLoginAccount = "login_account"
+	attemptScopeLoginIP      = "login_ip"
+	attemptScopeJoinMember   = "join_member"
+	attemptScopeJoinIP       = "join_ip"
+	attemptScopeMFAMember    = "mfa_member"
+	attemptScopeMFAIP        = "mfa_ip"
+)
+
+// LockedOutError is returned while an account or IP address is locked out. It matches ErrTooManyAttempts.
+type LockedOutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedOutError) Error() string {
+	return fmt.Sprintf("too many failed attempts, retry after %s", e.RetryAfter)
+}
+
+func (e *LockedOutError) Is(target error) bool {
+	return target == ErrTooManyAttempts
+}
+
+// attemptKey identifies what failed attempts are counted against
+type attemptKey struct {
+	scope       string
+	key         string
+	maxFailures int
+}
+
+// loginAttemptKeys returns the keys limiting password guesses for an email
+func (s *Service) loginAttemptKeys(email, ipAddress string) []attemptKey {
+	keys := []attemptKey{{
+		scope:       attemptScopeLoginAccount,
+		key:         strings.ToLower(strings.TrimSpace(email)),
+		maxFailures: s.AuthConfig.Lockout.AccountMaxFailures,
+	}}
+	if ipAddress != "" {
+		keys = append(keys, attemptKey{
+			scope:       attemptScopeLoginIP,
+			key:         ipAddress,
+			maxFailures: s.AuthConfig.Lockout.IPMaxFailures,
+		})
+	}
+	return keys
+}
+
+// joinAttemptKeys returns the keys limiting board password guesses by a member
+func (s *Service) joinAttemptKeys(memberID uuid.UUID, ipAddress string) []attemptKey {
+	keys := []attemptKey{{
+		scope:       attemptScopeJoinMember,
+		key:         memberID.String(),
+		maxFailures: s.AuthConfig.Lockout.AccountMaxFailures,
+	}}
+	if ipAddress != "" {
+		keys = append(keys, attemptKey{
+			scope:       attemptScopeJoinIP,
+			key:         ipAddress,
+			maxFailures: s.AuthConfig.Lockout.IPMaxFailures,
+		})
+	}
+	return keys
+}
+
+// mfaAttemptKeys returns the keys limiting second factor guesses for a member across login challenges
+func (s *Service) mfaAttemptKeys(memberID uuid.UUID, ipAddress string) []attemptKey {
+	keys := []attemptKey{{
+		scope:       attemptScopeMFAMember,
+		key:         memberID.String(),
+		maxFailures: s.AuthConfig.Lockout.AccountMaxFailures,
+	}}
+	if ipAddress != "" {
+		keys = append(keys, attemptKey{
+			scope:       attemptScopeMFAIP,
+			key:         ipAddress,
+			maxFailures: s.AuthConfig.Lockout.IPMaxFailures,
+		})
+	}
+	return keys
+}
+
+// countAttempt counts an attempt against every key before the password is verified and returns a LockedOutError
+// with the longest remaining lockout when a key is locked. Counting first keeps concurrent guesses within the limit,
+// a successful attempt is taken back with attemptSucceeded.
+func (s *Service) countAttempt(ctx context.Context, keys []attemptKey) error {
+	cfg := s.AuthConfig.Lockout
+	now := time.Now()
+	resetBefore := now.Add(-time.Duration(cfg.ResetAfter) * time.Second)
+
+	var counted []attemptKey
+	var retryAfter time.Duration
+	for _, k := range keys {
+		failure, ok, err := s.Repo.CountAuthAttempt(ctx, k.scope, k.key, now, resetBefore, s.lockoutPolicy(k))
+		if err != nil {
+			s.releaseAttempts(ctx, counted)
+			return fmt.Errorf("failed to count attempt: %w", err)
+		}
+		if !ok {
+			if remaining := failure.LockedUntil.Sub(now); remaining > retryAfter {
+				retryAfter = remaining
+			}
+			continue
+		}
+		counted = append(counted, k)
+
+		if failure.Failures >= k.maxFailures {
+			utils.Logger().WithField("scope", k.scope).
+				WithField("failures", failure.Failures).
+				WithField("duration", failure.LockedUntil.Sub(now)).
+				Warn("Locked out after failed attempts")
+		}
+	}
+
+	// A rejected attempt isn't a guess, don't count it against the other keys
+	if retryAfter > 0 {
+		s.releaseAttempts(ctx, counted)
+		return &LockedOutError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// attemptSucceeded forgets the failed attempts of the first key and takes back the attempt counted on the others
+func (s *Service) attemptSucceeded(ctx context.Context, keys []attemptKey) {
+	s.clearFailedAttempts(ctx, keys[0])
+	s.releaseAttempts(ctx, keys[1:])
+}
+
+// releaseAttempts takes back an attempt counted against the keys. Failures are logged.
+func (s *Service) releaseAttempts(ctx context.Context, keys []attemptKey) {
+	for _, k := range keys {
+		if err := s.Repo.ReleaseAuthAttempt(ctx, k.scope, k.key, k.maxFailures); err != nil {
+			utils.Logger().WithError(err).WithField("scope", k.scope).Warn("Failed to release attempt")
+		}
+	}
+}
+
+// clearFailedAttempts forgets failed attempts of a key after a successful attempt
+func (s *Service) clearFailedAttempts(ctx context.Context, k attemptKey) {
+	if err := s.Repo.ClearAuthFailure(ctx, k.scope, k.key); err != nil {
+		utils.Logger().WithError(err).WithField("scope", k.scope).Warn("Failed to clear failed attempts")
+	}
+}
+
+// RunAuthFailureCleanup deletes failure counts that no longer lock anything until ctx is cancelled.
+// Counts expire after ResetAfter, so the cleanup runs at the same interval.
+func (s *Service) RunAuthFailureCleanup(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.AuthConfig.Lockout.ResetAfter) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.DeleteExpiredAuthFailures(ctx)
+		if err != nil {
+			utils.Logger().WithError(err).Error("Failed to delete expired auth failures")
+		} else if deleted > 0 {
+			utils.Logger().WithField("deleted", deleted).Info("Expired auth failures deleted")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteExpiredAuthFailures deletes failure counts past ResetAfter whose lockout ended and returns how many
+func (s *Service) DeleteExpiredAuthFailures(ctx context.Context) (int64, error) {
+	now := time.Now()
+	resetBefore := now.Add(-time.Duration(s.AuthConfig.Lockout.ResetAfter) * time.Second)
+
+	// Delete in batches to keep transactions short
+	var total int64
+	for {
+		deleted, err := s.Repo.DeleteExpiredAuthFailures(ctx, resetBefore, now, authFailureCleanupBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < authFailureCleanupBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// lockoutPolicy returns the lockout of a key, doubling from the base duration with every failure past the limit
+func (s *Service) lockoutPolicy(k attemptKey) models.LockoutPolicy {
+	cfg := s.AuthConfig.Lockout
+	return models.LockoutPolicy{
+		MaxFailures:  k.maxFailures,
+		BaseDuration: time.Duration(cfg.BaseDuration) * time.Second,
+		MaxDuration:  time.Duration(cfg.MaxDuration) * time.Second,
+	}
+}
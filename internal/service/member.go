@@ -2,26 +2,35 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
 	"golang.org/x/crypto/bcrypt"
 )
 
 var (
-	ErrEmailAlreadyTaken    = errors.New("email is already taken")
-	ErrUsernameAlreadyTaken = errors.New("username is already taken")
+	ErrEmailAlreadyTaken       = errors.New("email is already taken")
+	ErrUsernameAlreadyTaken    = errors.New("username is already taken")
+	ErrCurrentPasswordRequired = errors.New("current password is required to change email or password")
+	ErrInvalidCurrentPassword  = errors.New("current password is incorrect")
+	ErrSearchQueryRequired     = errors.New("search query is required")
 )
 
 // UpdateMemberRequest represents the data needed to update a member profile
 type UpdateMemberRequest struct {
-	Email    *string
-	Username *string
-	FullName *string
-	Password *string
+	Email           *string
+	Username        *string
+	FullName        *string
+	Password        *string
+	CurrentPassword *string // Required when changing email or password
+	IPAddress       string  // Recorded in the security history
+	UserAgent       string  // Recorded in the security history
 }
 
 // GetMemberProfile retrieves the member profile by ID
@@ -30,12 +39,27 @@ func (s *Service) GetMemberProfile(ctx context.Context, memberID uuid.UUID) (*mo
 	if err != nil {
 		return nil, fmt.Errorf("failed to get member profile: %w", err)
 	}
-	if member == nil {
+	if member == nil || member.DeletedAt != nil {
 		return nil, ErrUserNotFound
 	}
 	return member, nil
 }
 
+// SearchMembers finds members by username or full name prefix among the members sharing a board with memberID.
+// A query equal to a member's email finds that member even without a shared board.
+func (s *Service) SearchMembers(ctx context.Context, memberID uuid.UUID, query string, limit int) ([]*models.Member, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrSearchQueryRequired
+	}
+
+	members, err := s.Repo.SearchMembers(ctx, memberID, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search members: %w", err)
+	}
+	return members, nil
+}
+
 // UpdateMemberProfile updates a member's profile information
 func (s *Service) UpdateMemberProfile(ctx context.Context, memberID uuid.UUID, req UpdateMemberRequest) (*models.Member, error) {
 	// Get current member
@@ -47,8 +71,24 @@ func (s *Service) UpdateMemberProfile(ctx context.Context, memberID uuid.UUID, r
 		return nil, ErrUserNotFound
 	}
 
+	emailChanged := req.Email != nil && *req.Email != member.Email
+	passwordChanged := req.Password != nil && *req.Password != ""
+
+	// Sensitive changes require re-authentication with the current password
+	if emailChanged || passwordChanged {
+		if req.CurrentPassword == nil || *req.CurrentPassword == "" {
+			return nil, ErrCurrentPasswordRequired
+		}
+		err = bcrypt.CompareHashAndPassword([]byte(member.PasswordHash), []byte(*req.CurrentPassword))
+		if err != nil {
+			return nil, ErrInvalidCurrentPassword
+		}
+	}
+
+	previousEmail := member.Email
+
 	// Update email if provided
-	if req.Email != nil && *req.Email != member.Email {
+	if emailChanged {
 		// Check if email is already taken
 		existingMember, err := s.Repo.GetMemberByEmail(ctx, *req.Email)
 		if err != nil {
@@ -58,6 +98,7 @@ func (s *Service) UpdateMemberProfile(ctx context.Context, memberID uuid.UUID, r
 			return nil, ErrEmailAlreadyTaken
 		}
 		member.Email = *req.Email
+		member.EmailVerifiedAt = nil
 	}
 
 	// Update username if provided
@@ -79,10 +120,10 @@ func (s *Service) UpdateMemberProfile(ctx context.Context, memberID uuid.UUID, r
 	}
 
 	// Update password if provided
-	if req.Password != nil && *req.Password != "" {
+	if passwordChanged {
 		// Validate password length
 		if len(*req.Password) < 8 {
-			return nil, fmt.Errorf("password must be at least 8 characters long")
+			return nil, ErrPasswordTooShort
 		}
 
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
@@ -94,11 +135,77 @@ func (s *Service) UpdateMemberProfile(ctx context.Context, memberID uuid.UUID, r
 
 	member.UpdatedAt = time.Now()
 
+	// Changing email or password ends every existing session.
+	// Reject access tokens issued before the change. JWT iat has second precision, so tokens
+	// issued within the second of the change are rejected too and the client logs in again.
+	credentialsChanged := emailChanged || passwordChanged
+	if credentialsChanged {
+		validAfter := member.UpdatedAt.Truncate(time.Second).Add(time.Second)
+		member.TokensValidAfter = &validAfter
+	}
+
 	// Save updated member
 	err = s.Repo.UpdateMember(ctx, member)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update member: %w", err)
 	}
 
+	// Revoke refresh, personal access and password reset tokens so revoked sessions can't be renewed
+	if credentialsChanged {
+		err = s.Repo.RevokeAllUserTokens(ctx, memberID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to revoke member tokens: %w", err)
+		}
+	}
+
+	// Record changes in the security history
+	if emailChanged {
+		s.recordSecurityEvent(ctx, member.ID, models.SecurityEventEmailChanged, req.IPAddress, req.UserAgent, map[string]string{
+			"previousEmail": previousEmail,
+			"newEmail":      member.Email,
+		})
+
+		// The new address has to be verified again
+		if err := s.sendEmailVerification(ctx, member); err != nil {
+			utils.Logger().WithError(err).Warn("Failed to send email verification")
+		}
+	}
+	if passwordChanged {
+		s.recordSecurityEvent(ctx, member.ID, models.SecurityEventPasswordChanged, req.IPAddress, req.UserAgent, nil)
+	}
+
 	return member, nil
 }
+
+// recordSecurityEvent adds an entry to the member's security history.
+// Failures are logged, the change itself has already been applied.
+func (s *Service) recordSecurityEvent(ctx context.Context, memberID uuid.UUID, eventType, ipAddress, userAgent string, metadata map[string]string) {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		utils.Logger().WithError(err).Error("Failed to encode security event metadata")
+		return
+	}
+
+	event := &models.SecurityEvent{
+		ID:        uuid.New(),
+		IDMember:  memberID,
+		EventType: eventType,
+		Metadata:  encoded,
+		CreatedAt: time.Now(),
+	}
+	if ipAddress != "" {
+		event.IPAddress = &ipAddress
+	}
+	if userAgent != "" {
+		event.UserAgent = &userAgent
+	}
+
+	err = s.Repo.CreateSecurityEvent(ctx, event)
+	if err != nil {
+		utils.Logger().WithError(err).WithField("member", memberID).WithField("event", eventType).
+			Error("Failed to record security event")
+	}
+}
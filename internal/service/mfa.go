@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrMFAAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnabled       = errors.New("two-factor authentication is not enabled")
+	ErrMFANotEnrolled      = errors.New("no pending two-factor enrolment")
+	ErrInvalidMFACode      = errors.New("invalid two-factor code")
+	ErrInvalidMFAChallenge = errors.New("invalid or expired two-factor challenge")
+)
+
+const (
+	recoveryCodeCount       = 10
+	recoveryCodeBytes       = 5
+	mfaChallengeTokenBytes  = 32
+	maxMFAChallengeAttempts = 5
+	totpSkewSteps           = 1 // Accept the previous and next code for clock drift
+)
+
+// MFAStatus describes the second factor setup of a member
+type MFAStatus struct {
+	Enabled                bool
+	ConfirmedAt            *time.Time
+	RecoveryCodesRemaining int
+}
+
+// TOTPEnrolment holds the secret of a pending enrolment, shown once to be added to an authenticator app
+type TOTPEnrolment struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+// DisableMFARequest represents the data needed to turn off two-factor authentication
+type DisableMFARequest struct {
+	MemberID        uuid.UUID
+	CurrentPassword string
+	Code            string // TOTP or recovery code
+	IPAddress       string
+	UserAgent       string
+}
+
+// VerifyMFARequest represents the second step of a login
+type VerifyMFARequest struct {
+	Token     string
+	Code      string // TOTP or recovery code
+	IPAddress string
+	UserAgent string
+}
+
+// GetMFAStatus retrieves the second factor setup of a member
+func (s *Service) GetMFAStatus(ctx context.Context, memberID uuid.UUID) (*MFAStatus, error) {
+	totp, err := s.Repo.GetMemberTOTP(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member totp: %w", err)
+	}
+	if !totp.Enabled() {
+		return &MFAStatus{}, nil
+	}
+
+	remaining, err := s.Repo.CountUnusedRecoveryCodes(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+
+	return &MFAStatus{
+		Enabled:                true,
+		ConfirmedAt:            totp.ConfirmedAt,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+// StartTOTPEnrolment generates a new TOTP secret for the member. The factor is not required at login
+// until it is confirmed with a valid code, and starting again replaces a pending secret.
+func (s *Service) StartTOTPEnrolment(ctx context.Context, memberID uuid.UUID) (*TOTPEnrolment, error) {
+	member, err := s.GetMemberByID(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate secret
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+
+	err = s.Repo.SavePendingTOTP(ctx, &models.MemberTOTP{
+		IDMember:  member.ID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMFAAlreadyEnabled
+		}
+		return nil, fmt.Errorf("failed to save totp secret: %w", err)
+	}
+
+	return &TOTPEnrolment{
+		Secret:     secret,
+		OTPAuthURI: utils.TOTPURI(secret, s.AuthConfig.MFAIssuer, member.Email),
+	}, nil
+}
+
+// ConfirmTOTPEnrolment enables the pending factor with a code from the authenticator app
+// and returns the plaintext recovery codes. Only their hashes are stored.
+func (s *Service) ConfirmTOTPEnrolment(ctx context.Context, memberID uuid.UUID, code, ipAddress, userAgent string) ([]string, error) {
+	totp, err := s.Repo.GetMemberTOTP(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member totp: %w", err)
+	}
+	if totp == nil {
+		return nil, ErrMFANotEnrolled
+	}
+	if totp.Enabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	// Validate code
+	now := time.Now()
+	step, ok := utils.ValidateTOTP(totp.Secret, code, now, totpSkewSteps)
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	// Generate recovery codes
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.ConfirmMemberTOTP(ctx, memberID, step, now, hashes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMFAAlreadyEnabled
+		}
+		return nil, fmt.Errorf("failed to confirm totp: %w", err)
+	}
+
+	s.recordSecurityEvent(ctx, memberID, models.SecurityEventMFAEnabled, ipAddress, userAgent, nil)
+
+	return codes, nil
+}
+
+// DisableMFA removes the member's second factor after checking the password and a current code
+func (s *Service) DisableMFA(ctx context.Context, req DisableMFARequest) error {
+	member, err := s.GetMemberByID(ctx, req.MemberID)
+	if err != nil {
+		return err
+	}
+
+	totp, err := s.Repo.GetMemberTOTP(ctx, member.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get member totp: %w", err)
+	}
+	if !totp.Enabled() {
+		return ErrMFANotEnabled
+	}
+
+	// Re-authenticate with both factors
+	if req.CurrentPassword == "" {
+		return ErrCurrentPasswordRequired
+	}
+	err = bcrypt.CompareHashAndPassword([]byte(member.PasswordHash), []byte(req.CurrentPassword))
+	if err != nil {
+		return ErrInvalidCurrentPassword
+	}
+	if _, err := s.verifyMFACode(ctx, totp, req.Code); err != nil {
+		return err
+	}
+
+	err = s.Repo.DeleteMemberTOTP(ctx, member.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete totp: %w", err)
+	}
+
+	s.recordSecurityEvent(ctx, member.ID, models.SecurityEventMFADisabled, req.IPAddress, req.UserAgent, nil)
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes of the member after checking a TOTP code
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, memberID uuid.UUID, code, ipAddress, userAgent string) ([]string, error) {
+	totp, err := s.Repo.GetMemberTOTP(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member totp: %w", err)
+	}
+	if !totp.Enabled() {
+		return nil, ErrMFANotEnabled
+	}
+
+	// A recovery code can't be used to mint new ones
+	step, ok := utils.ValidateTOTP(totp.Secret, code, time.Now(), totpSkewSteps)
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+	if err := s.useTOTPStep(ctx, memberID, step); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.ReplaceRecoveryCodes(ctx, memberID, hashes, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace recovery codes: %w", err)
+	}
+
+	s.recordSecurityEvent(ctx, memberID, models.SecurityEventRecoveryCodes, ipAddress, userAgent, nil)
+
+	return codes, nil
+}
+
+// VerifyMFALogin completes a login with the challenge token from Login and a TOTP or recovery code.
+// A challenge is invalidated after too many wrong codes, so the password has to be entered again.
+// Wrong codes also count against the member across challenges and lock out like wrong passwords.
+func (s *Service) VerifyMFALogin(ctx context.Context, req VerifyMFARequest) (*AuthResponse, error) {
+	challenge, err := s.Repo.GetMFAChallengeByHash(ctx, utils.HashToken(req.Token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mfa challenge: %w", err)
+	}
+	if challenge == nil {
+		return nil, ErrInvalidMFAChallenge
+	}
+
+	totp, err := s.Repo.GetMemberTOTP(ctx, challenge.IDMember)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member totp: %w", err)
+	}
+	if !totp.Enabled() {
+		return nil, ErrInvalidMFAChallenge
+	}
+
+	// Count the attempt as failed up front, rejected while the member or IP address is locked out
+	attemptKeys := s.mfaAttemptKeys(challenge.IDMember, req.IPAddress)
+	if err := s.countAttempt(ctx, attemptKeys); err != nil {
+		return nil, err
+	}
+
+	// Check code, counting failures against the challenge
+	usedRecoveryCode, err := s.verifyMFACode(ctx, totp, req.Code)
+	if err != nil {
+		if !errors.Is(err, ErrInvalidMFACode) {
+			s.releaseAttempts(ctx, attemptKeys)
+			return nil, err
+		}
+		attempts, incErr := s.Repo.IncrementMFAChallengeAttempts(ctx, challenge.ID)
+		if incErr != nil {
+			return nil, fmt.Errorf("failed to record mfa attempt: %w", incErr)
+		}
+		if attempts >= maxMFAChallengeAttempts {
+			if consumeErr := s.Repo.ConsumeMFAChallenge(ctx, challenge.ID, time.Now()); consumeErr != nil && !errors.Is(consumeErr, sql.ErrNoRows) {
+				return nil, fmt.Errorf("failed to invalidate mfa challenge: %w", consumeErr)
+			}
+		}
+		return nil, err
+	}
+	s.attemptSucceeded(ctx, attemptKeys)
+
+	// Consume challenge so the token can't be used twice
+	err = s.Repo.ConsumeMFAChallenge(ctx, challenge.ID, time.Now())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidMFAChallenge
+		}
+		return nil, fmt.Errorf("failed to consume mfa challenge: %w", err)
+	}
+
+	if usedRecoveryCode {
+		s.recordSecurityEvent(ctx, challenge.IDMember, models.SecurityEventRecoveryUsed, req.IPAddress, req.UserAgent, nil)
+	}
+
+	member, err := s.GetMemberByID(ctx, challenge.IDMember)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueAuthTokens(ctx, member)
+}
+
+// createMFAChallenge starts the second step of a login
+func (s *Service) createMFAChallenge(ctx context.Context, memberID uuid.UUID) (*AuthResponse, error) {
+	token, err := utils.GenerateSecret(mfaChallengeTokenBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate mfa token: %w", err)
+	}
+
+	now := time.Now()
+	err = s.Repo.CreateMFAChallenge(ctx, &models.MFAChallenge{
+		ID:        uuid.New(),
+		IDMember:  memberID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(time.Duration(s.AuthConfig.MFAChallengeTTL) * time.Second),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+
+	return &AuthResponse{
+		MFAToken:     token,
+		MFAExpiresIn: s.AuthConfig.MFAChallengeTTL,
+	}, nil
+}
+
+// verifyMFACode accepts a TOTP code or an unused recovery code and reports whether a recovery code was used
+func (s *Service) verifyMFACode(ctx context.Context, totp *models.MemberTOTP, code string) (bool, error) {
+	if step, ok := utils.ValidateTOTP(totp.Secret, code, time.Now(), totpSkewSteps); ok {
+		return false, s.useTOTPStep(ctx, totp.IDMember, step)
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if normalized == "" {
+		return false, ErrInvalidMFACode
+	}
+	used, err := s.Repo.UseRecoveryCode(ctx, totp.IDMember, utils.HashToken(normalized), time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	if !used {
+		return false, ErrInvalidMFACode
+	}
+	return true, nil
+}
+
+// useTOTPStep rejects a code whose time step was already accepted
+func (s *Service) useTOTPStep(ctx context.Context, memberID uuid.UUID, step int64) error {
+	err := s.Repo.UseTOTPStep(ctx, memberID, step)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidMFACode
+		}
+		return fmt.Errorf("failed to record totp step: %w", err)
+	}
+	return nil
+}
+
+// generateRecoveryCodes returns plaintext codes formatted as "xxxxx-xxxxx" and their hashes
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		secret, err := utils.GenerateSecret(recoveryCodeBytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		codes = append(codes, secret[:5]+"-"+secret[5:])
+		hashes = append(hashes, utils.HashToken(secret))
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode strips separators and case so codes can be typed loosely
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
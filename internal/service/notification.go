@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+const (
+	notificationCleanupBatchSize = 1000
+	maxMentionsPerCard           = 20 // more mentions in one change are ignored
+)
+
+// mentionPattern matches @username not preceded by a word character, so email addresses are no mentions
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([A-Za-z0-9_.-]+)`)
+
+// notificationEvent describes a change, notify turns it into a notification for every recipient
+type notificationEvent struct {
+	Type    string
+	IDBoard uuid.UUID
+	IDCard  *uuid.UUID
+	IDActor uuid.UUID
+	Data    map[string]interface{}
+}
+
+// GetMemberNotifications retrieves the notifications of a member, newest first, with the total and unread counts
+func (s *Service) GetMemberNotifications(ctx context.Context, memberID uuid.UUID, unreadOnly bool, limit, offset int) ([]*models.Notification, int, int, error) {
+	// Validate pagination parameters
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	notifications, total, unread, err := s.Repo.GetMemberNotifications(ctx, memberID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	return notifications, total, unread, nil
+}
+
+// MarkNotificationRead marks a notification of a member as read
+func (s *Service) MarkNotificationRead(ctx context.Context, notificationID, memberID uuid.UUID) error {
+	err := s.Repo.MarkNotificationRead(ctx, notificationID, memberID, time.Now())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotificationNotFound
+		}
+		return fmt.Errorf("failed to mark notification read: %w", err)
+	}
+	return nil
+}
+
+// MarkAllNotificationsRead marks every notification of a member as read and returns how many were unread
+func (s *Service) MarkAllNotificationsRead(ctx context.Context, memberID uuid.UUID) (int64, error) {
+	marked, err := s.Repo.MarkAllNotificationsRead(ctx, memberID, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return marked, nil
+}
+
+// RunNotificationCleanup deletes notifications past their retention until ctx is cancelled
+func (s *Service) RunNotificationCleanup(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.NotificationsConfig.CleanupInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.DeleteOldNotifications(ctx)
+		if err != nil {
+			utils.Logger().WithError(err).Error("Failed to delete old notifications")
+		} else if deleted > 0 {
+			utils.Logger().WithField("deleted", deleted).Info("Old notifications deleted")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteOldNotifications deletes read and unread notifications older than their retention and returns how many
+func (s *Service) DeleteOldNotifications(ctx context.Context) (int64, error) {
+	now := time.Now()
+	readBefore := now.Add(-time.Duration(s.NotificationsConfig.ReadRetention) * time.Second)
+	unreadBefore := now.Add(-time.Duration(s.NotificationsConfig.UnreadRetention) * time.Second)
+
+	// Delete in batches to keep transactions short
+	var total int64
+	for {
+		deleted, err := s.Repo.DeleteOldNotifications(ctx, readBefore, unreadBefore, notificationCleanupBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < notificationCleanupBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// notifyBoardMemberJoined notifies the other board members about a new member
+func (s *Service) notifyBoardMemberJoined(ctx context.Context, board *models.Board, member *models.Member) {
+	members, err := s.Repo.GetBoardMembers(ctx, board.ID)
+	if err != nil {
+		utils.Logger().WithError(err).WithField("board", board.ID).Error("Failed to get board members for notification")
+		return
+	}
+
+	s.notify(ctx, notificationEvent{
+		Type:    models.NotificationBoardMemberJoined,
+		IDBoard: board.ID,
+		IDActor: member.ID,
+		Data:    map[string]interface{}{"boardName": board.Name, "username": member.Username},
+	}, memberIDs(members))
+}
+
+// notifyBoardMemberRemoved notifies a member removed by someone else, or the remaining members when a member left
+func (s *Service) notifyBoardMemberRemoved(ctx context.Context, boardID, targetMemberID, requestingMemberID uuid.UUID) {
+	log := utils.Logger().WithField("board", boardID)
+
+	board, err := s.Repo.GetBoardByID(ctx, boardID)
+	if err != nil || board == nil {
+		log.WithError(err).Error("Failed to get board for notification")
+		return
+	}
+
+	if targetMemberID != requestingMemberID {
+		s.notify(ctx, notificationEvent{
+			Type:    models.NotificationBoardMemberRemoved,
+			IDBoard: boardID,
+			IDActor: requestingMemberID,
+			Data:    map[string]interface{}{"boardName": board.Name},
+		}, []uuid.UUID{targetMemberID})
+		return
+	}
+
+	member, err := s.Repo.GetMemberByID(ctx, targetMemberID)
+	if err != nil || member == nil {
+		log.WithError(err).Error("Failed to get member for notification")
+		return
+	}
+	members, err := s.Repo.GetBoardMembers(ctx, boardID)
+	if err != nil {
+		log.WithError(err).Error("Failed to get board members for notification")
+		return
+	}
+
+	s.notify(ctx, notificationEvent{
+		Type:    models.NotificationBoardMemberLeft,
+		IDBoard: boardID,
+		IDActor: targetMemberID,
+		Data:    map[string]interface{}{"boardName": board.Name, "username": member.Username},
+	}, memberIDs(members))
+}
+
+// notifyCardChange notifies the members following a card, see cardWatcherIDs, about a change made by actorID
+func (s *Service) notifyCardChange(ctx context.Context, boardID uuid.UUID, card *models.Card, notificationType string, actorID uuid.UUID, data map[string]interface{}, recipients []uuid.UUID) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	data["cardTitle"] = card.Title
+	data["idList"] = card.IDList
+
+	event := notificationEvent{
+		Type:    notificationType,
+		IDBoard: boardID,
+		IDActor: actorID,
+		Data:    data,
+	}
+	// A deleted card can't be referenced anymore
+	if notificationType != models.NotificationCardDeleted {
+		event.IDCard = &card.ID
+	}
+
+	s.notify(ctx, event, recipients)
+}
+
+// notifyCardMentions notifies board members newly mentioned with @username in the card title or description.
+// previousText is the title and description before the change, mentions already in it are not notified again.
+func (s *Service) notifyCardMentions(ctx context.Context, boardID uuid.UUID, card *models.Card, previousText string, actorID uuid.UUID) {
+	text := cardText(card)
+
+	previous := make(map[string]bool)
+	for _, username := range mentionedUsernames(previousText) {
+		previous[username] = true
+	}
+
+	var recipients []uuid.UUID
+	for _, username := range mentionedUsernames(text) {
+		if previous[username] {
+			continue
+		}
+
+		member, err := s.Repo.GetMemberByUsername(ctx, username)
+		if err != nil {
+			utils.Logger().WithError(err).WithField("username", username).Error("Failed to get mentioned member")
+			continue
+		}
+		if member == nil || member.DeletedAt != nil {
+			continue
+		}
+
+		// Only board members can see the card
+		boardMember, err := s.Repo.GetBoardMember(ctx, boardID, member.ID)
+		if err != nil {
+			utils.Logger().WithError(err).WithField("username", username).Error("Failed to check mentioned member")
+			continue
+		}
+		if boardMember != nil {
+			recipients = append(recipients, member.ID)
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	s.notify(ctx, notificationEvent{
+		Type:    models.NotificationCardMentioned,
+		IDBoard: boardID,
+		IDCard:  &card.ID,
+		IDActor: actorID,
+		Data:    map[string]interface{}{"cardTitle": card.Title, "idList": card.IDList},
+	}, recipients)
+}
+
+// notify stores a notification of the event for every recipient except the actor who enabled its kind.
+// Failures are logged and never fail the operation that triggered the event.
+func (s *Service) notify(ctx context.Context, event notificationEvent, recipients []uuid.UUID) {
+	log := utils.Logger().WithField("notification", event.Type).WithField("board", event.IDBoard)
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		log.WithError(err).Error("Failed to encode notification data")
+		return
+	}
+
+	now := time.Now()
+	seen := make(map[uuid.UUID]bool, len(recipients))
+	var notifications []*models.Notification
+	for _, recipient := range recipients {
+		if recipient == event.IDActor || seen[recipient] {
+			continue
+		}
+		seen[recipient] = true
+
+		preferences, err := s.GetMemberPreferences(ctx, recipient)
+		if err != nil {
+			log.WithError(err).WithField("member", recipient).Error("Failed to get notification preferences")
+			continue
+		}
+		if !notificationEnabled(preferences, event.Type) {
+			continue
+		}
+
+		boardID := event.IDBoard
+		actorID := event.IDActor
+		notifications = append(notifications, &models.Notification{
+			ID:        uuid.New(),
+			IDMember:  recipient,
+			Type:      event.Type,
+			IDBoard:   &boardID,
+			IDCard:    event.IDCard,
+			IDActor:   &actorID,
+			Data:      data,
+			CreatedAt: now,
+		})
+	}
+	if len(notifications) == 0 {
+		return
+	}
+
+	if err := s.Repo.CreateNotifications(ctx, notifications); err != nil {
+		log.WithError(err).Error("Failed to create notifications")
+	}
+}
+
+// notificationEnabled reports whether the member's preferences allow notifications of the type
+func notificationEnabled(preferences *models.MemberPreferences, notificationType string) bool {
+	switch {
+	case notificationType == models.NotificationCardMentioned:
+		return preferences.Notifications.Mentions
+	case strings.HasPrefix(notificationType, "board."):
+		return preferences.Notifications.BoardMembers
+	default:
+		return preferences.Notifications.CardChanges
+	}
+}
+
+// mentionedUsernames returns the distinct usernames mentioned with @username in text
+func mentionedUsernames(text string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		// A trailing dot ends the sentence, it isn't part of the username
+		username := strings.TrimRight(match[1], ".")
+		if username == "" || seen[username] {
+			continue
+		}
+		seen[username] = true
+		usernames = append(usernames, username)
+		if len(usernames) == maxMentionsPerCard {
+			break
+		}
+	}
+	return usernames
+}
+
+// cardText returns the card text mentions are searched in
+func cardText(card *models.Card) string {
+	if card.Description == nil {
+		return card.Title
+	}
+	return card.Title + "\n" + *card.Description
+}
+
+func memberIDs(members []*models.Member) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.ID)
+	}
+	return ids
+}
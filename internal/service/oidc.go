@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/internal/oidc"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrUnknownOIDCProvider  = errors.New("unknown sign-in provider")
+	ErrInvalidOIDCState     = errors.New("invalid or expired sign-in state")
+	ErrOIDCLoginFailed      = errors.New("sign-in with the provider failed")
+	ErrOIDCEmailNotVerified = errors.New("the provider didn't confirm the email address")
+	ErrOIDCSignupDisabled   = errors.New("no account is linked to this sign-in and sign-up is disabled")
+)
+
+const (
+	defaultOIDCStateTTL    = 600
+	oidcStateBytes         = 32
+	oidcNonceBytes         = 16
+	minUsernameLength      = 3
+	maxOIDCUsernameLength  = 40
+	usernameSuffixBytes    = 3
+	maxUsernameSuffixTries = 5
+)
+
+// OIDCCallbackRequest represents the provider redirect back after a login
+type OIDCCallbackRequest struct {
+	Provider    string
+	Code        string
+	State       string
+	StateCookie string // state bound to the browser that started the login
+	IPAddress   string
+	UserAgent   string
+}
+
+// StartOIDCLogin stores a pending login and returns the provider URL to redirect the user to.
+// The returned state must be kept in the browser and sent back with the callback.
+func (s *Service) StartOIDCLogin(ctx context.Context, providerName string) (string, string, error) {
+	provider, err := s.OIDC.Provider(providerName)
+	if err != nil {
+		return "", "", ErrUnknownOIDCProvider
+	}
+
+	// Generate state, nonce and PKCE verifier
+	state, err := utils.GenerateSecret(oidcStateBytes)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate state: %w", err)
+	}
+	nonce, err := utils.GenerateSecret(oidcNonceBytes)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	codeVerifier, err := oidc.GenerateCodeVerifier()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate code verifier: %w", err)
+	}
+
+	// Build the URL first, discovery may fail and there is no point storing the state then
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		utils.Logger().WithError(err).WithField("provider", providerName).Warn("Failed to start OIDC login")
+		return "", "", ErrOIDCLoginFailed
+	}
+
+	stateTTL := s.OIDCConfig.StateTTL
+	if stateTTL == 0 {
+		stateTTL = defaultOIDCStateTTL
+	}
+	now := time.Now()
+	err = s.Repo.CreateOIDCLoginState(ctx, &models.OIDCLoginState{
+		ID:           uuid.New(),
+		Provider:     provider.Name(),
+		StateHash:    utils.HashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(time.Duration(stateTTL) * time.Second),
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return authURL, state, nil
+}
+
+// CompleteOIDCLogin redeems the authorization code, finds or creates the member and logs them in.
+// Members with a second factor get a challenge like a password login.
+func (s *Service) CompleteOIDCLogin(ctx context.Context, req OIDCCallbackRequest) (*AuthResponse, error) {
+	provider, err := s.OIDC.Provider(req.Provider)
+	if err != nil {
+		return nil, ErrUnknownOIDCProvider
+	}
+
+	// The state must come from the browser that started the login, otherwise an attacker could
+	// complete their own login in the victim's browser
+	if req.StateCookie == "" || subtle.ConstantTimeCompare([]byte(req.StateCookie), []byte(req.State)) != 1 {
+		return nil, ErrInvalidOIDCState
+	}
+
+	// Consume the state, a callback can't be replayed
+	loginState, err := s.Repo.ConsumeOIDCLoginState(ctx, provider.Name(), utils.HashToken(req.State))
+	if err != nil {
+		return nil, err
+	}
+	if loginState == nil {
+		return nil, ErrInvalidOIDCState
+	}
+
+	// Exchange the code and verify the ID token
+	rawIDToken, err := provider.Exchange(ctx, req.Code, loginState.CodeVerifier)
+	if err != nil {
+		utils.Logger().WithError(err).WithField("provider", provider.Name()).Warn("Failed to exchange OIDC code")
+		return nil, ErrOIDCLoginFailed
+	}
+	claims, err := provider.VerifyIDToken(ctx, rawIDToken, loginState.Nonce)
+	if err != nil {
+		utils.Logger().WithError(err).WithField("provider", provider.Name()).Warn("Failed to verify OIDC ID token")
+		return nil, ErrOIDCLoginFailed
+	}
+
+	member, err := s.resolveOIDCMember(ctx, provider, claims, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The local second factor still applies
+	totp, err := s.Repo.GetMemberTOTP(ctx, member.ID)
+	if err != nil {
+		return nil, err
+	}
+	if totp.Enabled() {
+		return s.createMFAChallenge(ctx, member.ID)
+	}
+
+	return s.issueAuthTokens(ctx, member)
+}
+
+// resolveOIDCMember returns the member linked to the provider account.
+// Unlinked accounts are linked to the member with the same verified email, or signed up when allowed.
+func (s *Service) resolveOIDCMember(ctx context.Context, provider *oidc.Provider, claims *oidc.IDTokenClaims, req OIDCCallbackRequest) (*models.Member, error) {
+	// Check for an existing link
+	identity, err := s.Repo.GetMemberIdentity(ctx, provider.Name(), claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if identity != nil {
+		member, err := s.Repo.GetMemberByID(ctx, identity.IDMember)
+		if err != nil {
+			return nil, err
+		}
+		if member == nil {
+			return nil, ErrUserNotFound
+		}
+		return member, nil
+	}
+
+	// Linking by email is only safe when the provider vouches for the address
+	email := strings.ToLower(strings.TrimSpace(claims.Email))
+	if email == "" || !claims.EmailVerified {
+		return nil, ErrOIDCEmailNotVerified
+	}
+
+	now := time.Now()
+	identity = &models.MemberIdentity{
+		ID:        uuid.New(),
+		Provider:  provider.Name(),
+		Subject:   claims.Subject,
+		Email:     &email,
+		CreatedAt: now,
+	}
+
+	member, err := s.Repo.GetMemberByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil {
+		identity.IDMember = member.ID
+		if err := s.Repo.CreateMemberIdentity(ctx, identity); err != nil {
+			return nil, err
+		}
+
+		// The provider confirmed the address, no need for our own link
+		if member.EmailVerifiedAt == nil {
+			member.EmailVerifiedAt = &now
+			member.UpdatedAt = now
+			if err := s.Repo.UpdateMember(ctx, member); err != nil {
+				return nil, err
+			}
+		}
+
+		s.recordSecurityEvent(ctx, member.ID, models.SecurityEventIdentityLinked, req.IPAddress, req.UserAgent, map[string]string{
+			"provider": provider.Name(),
+		})
+		return member, nil
+	}
+
+	if !provider.AllowSignup() {
+		return nil, ErrOIDCSignupDisabled
+	}
+
+	// Sign up with a random password, the member can set one through the password reset
+	username, err := s.availableUsername(ctx, oidcUsername(claims, email))
+	if err != nil {
+		return nil, err
+	}
+	password, err := utils.GenerateSecret(oidcStateBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate password: %w", err)
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	member = &models.Member{
+		ID:              uuid.New(),
+		Email:           email,
+		Username:        username,
+		PasswordHash:    string(hashedPassword),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		EmailVerifiedAt: &now,
+	}
+	if name := strings.TrimSpace(claims.Name); name != "" {
+		member.FullName = &name
+	}
+	identity.IDMember = member.ID
+
+	if err := s.Repo.CreateMemberWithIdentity(ctx, member, identity); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// availableUsername returns the base username, or the base with a random suffix when taken
+func (s *Service) availableUsername(ctx context.Context, base string) (string, error) {
+	username := base
+	for range maxUsernameSuffixTries {
+		existing, err := s.Repo.GetMemberByUsername(ctx, username)
+		if err != nil {
+			return "", err
+		}
+		if existing == nil {
+			return username, nil
+		}
+
+		suffix, err := utils.GenerateSecret(usernameSuffixBytes)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate username: %w", err)
+		}
+		username = base + "-" + suffix
+	}
+	return "", ErrUserAlreadyExists
+}
+
+// oidcUsername derives a username from the preferred username claim or the email local part
+func oidcUsername(claims *oidc.IDTokenClaims, email string) string {
+	candidate := claims.PreferredUsername
+	if candidate == "" || strings.Contains(candidate, "@") {
+		candidate, _, _ = strings.Cut(email, "@")
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(candidate) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '.' || r == '_' || r == '-' {
+			b.WriteRune(r)
+		}
+	}
+
+	username := b.String()
+	if len(username) > maxOIDCUsernameLength {
+		username = username[:maxOIDCUsernameLength]
+	}
+	if len(username) < minUsernameLength {
+		username = "user"
+	}
+	return username
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/mailer"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+	ErrPasswordTooShort  = errors.New("password must be at least 8 characters long")
+)
+
+const passwordResetTokenBytes = 32
+
+// ResetPasswordRequest represents the data needed to reset a forgotten password
+type ResetPasswordRequest struct {
+	Token     string
+	Password  string
+	IPAddress string
+	UserAgent string
+}
+
+// RequestPasswordReset emails a one-time reset link when the email belongs to a member.
+// Unknown emails are ignored so the response doesn't reveal which accounts exist.
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) error {
+	member, err := s.Repo.GetMemberByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to get member: %w", err)
+	}
+	if member == nil {
+		return nil
+	}
+
+	// Generate token, only its hash is stored
+	token, err := utils.GenerateSecret(passwordResetTokenBytes)
+	if err != nil {
+		return fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	now := time.Now()
+	ttl := time.Duration(s.AuthConfig.PasswordResetTTL) * time.Second
+	err = s.Repo.CreatePasswordResetToken(ctx, &models.PasswordResetToken{
+		ID:        uuid.New(),
+		IDMember:  member.ID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create reset token: %w", err)
+	}
+
+	// Send reset link
+	link := strings.ReplaceAll(s.AuthConfig.PasswordResetURL, "{token}", token)
+	err = s.Mailer.Send(ctx, mailer.Message{
+		To:      member.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\n"+
+			"Someone asked to reset the password of your account. Open the link below to choose a new one:\n\n"+
+			"%s\n\n"+
+			"The link expires in %d minutes and works only once. If you didn't ask for it, ignore this email.\n",
+			member.Username, link, int(ttl.Minutes())),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send reset email: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password with a reset token and ends every session of the member
+func (s *Service) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	if len(req.Password) < 8 {
+		return ErrPasswordTooShort
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// Consume token, update password and revoke sessions at once
+	memberID, err := s.Repo.ResetMemberPassword(ctx, utils.HashToken(req.Token), string(hashedPassword), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+	if memberID == uuid.Nil {
+		return ErrInvalidResetToken
+	}
+
+	s.recordSecurityEvent(ctx, memberID, models.SecurityEventPasswordReset, req.IPAddress, req.UserAgent, nil)
+
+	return nil
+}
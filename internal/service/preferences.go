@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	_ "time/tzdata" // time zones validate without zoneinfo files on the host
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"golang.org/x/text/language"
+)
+
+var ErrInvalidPreference = errors.New("invalid preference")
+
+// InvalidPreferenceError names the rejected preference. It matches ErrInvalidPreference.
+type InvalidPreferenceError struct {
+	Field  string
+	Reason string
+}
+
+func (e *InvalidPreferenceError) Error() string {
+	return fmt.Sprintf("invalid preference %s: %s", e.Field, e.Reason)
+}
+
+func (e *InvalidPreferenceError) Is(target error) bool {
+	return target == ErrInvalidPreference
+}
+
+// UpdatePreferencesRequest represents the preferences to change, nil fields are left as they are
+type UpdatePreferencesRequest struct {
+	Locale           *string
+	Timezone         *string
+	DefaultBoardSort *string
+	EmailDigest      *string
+	Notifications    *UpdateNotificationPreferencesRequest
+}
+
+// UpdateNotificationPreferencesRequest represents the notification settings to change
+type UpdateNotificationPreferencesRequest struct {
+	BoardMembers *bool
+	CardChanges  *bool
+	Mentions     *bool
+}
+
+// GetMemberPreferences retrieves the preferences of a member with defaults for everything the member didn't set.
+// Other services read member settings only through it.
+func (s *Service) GetMemberPreferences(ctx context.Context, memberID uuid.UUID) (*models.MemberPreferences, error) {
+	stored, err := s.Repo.GetMemberPreferences(ctx, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member preferences: %w", err)
+	}
+
+	preferences := models.DefaultMemberPreferences()
+	if stored != nil {
+		if err := json.Unmarshal(stored, preferences); err != nil {
+			return nil, fmt.Errorf("failed to decode member preferences: %w", err)
+		}
+	}
+	return preferences, nil
+}
+
+// UpdateMemberPreferences validates and stores the changed preferences of a member.
+// Only preferences the member set are stored, so the others follow changes of the defaults.
+// Changes are merged into the stored preferences by the database, concurrent updates don't get lost.
+func (s *Service) UpdateMemberPreferences(ctx context.Context, memberID uuid.UUID, req UpdatePreferencesRequest) (*models.MemberPreferences, error) {
+	document := map[string]interface{}{}
+
+	// Validate and apply changes
+	if req.Locale != nil {
+		tag, err := language.Parse(*req.Locale)
+		if err != nil {
+			return nil, &InvalidPreferenceError{Field: "locale", Reason: "must be a BCP 47 language tag such as en or de-AT"}
+		}
+		document["locale"] = tag.String()
+	}
+	if req.Timezone != nil {
+		if *req.Timezone == "" || *req.Timezone == "Local" {
+			return nil, &InvalidPreferenceError{Field: "timezone", Reason: "must be an IANA time zone such as Europe/Berlin"}
+		}
+		if _, err := time.LoadLocation(*req.Timezone); err != nil {
+			return nil, &InvalidPreferenceError{Field: "timezone", Reason: "must be an IANA time zone such as Europe/Berlin"}
+		}
+		document["timezone"] = *req.Timezone
+	}
+	if req.DefaultBoardSort != nil {
+		switch *req.DefaultBoardSort {
+		case models.BoardSortUpdated, models.BoardSortName, models.BoardSortCreated, models.BoardSortJoined:
+		default:
+			return nil, &InvalidPreferenceError{Field: "defaultBoardSort", Reason: "must be updated, name, created or joined"}
+		}
+		document["defaultBoardSort"] = *req.DefaultBoardSort
+	}
+	if req.EmailDigest != nil {
+		switch *req.EmailDigest {
+		case models.EmailDigestOff, models.EmailDigestDaily, models.EmailDigestWeekly:
+		default:
+			return nil, &InvalidPreferenceError{Field: "emailDigest", Reason: "must be off, daily or weekly"}
+		}
+		document["emailDigest"] = *req.EmailDigest
+	}
+	if req.Notifications != nil {
+		notifications := map[string]interface{}{}
+		if req.Notifications.BoardMembers != nil {
+			notifications["boardMembers"] = *req.Notifications.BoardMembers
+		}
+		if req.Notifications.CardChanges != nil {
+			notifications["cardChanges"] = *req.Notifications.CardChanges
+		}
+		if req.Notifications.Mentions != nil {
+			notifications["mentions"] = *req.Notifications.Mentions
+		}
+		document["notifications"] = notifications
+	}
+
+	changes, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode member preferences: %w", err)
+	}
+
+	stored, err := s.Repo.MergeMemberPreferences(ctx, memberID, changes, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to save member preferences: %w", err)
+	}
+
+	preferences := models.DefaultMemberPreferences()
+	if err := json.Unmarshal(stored, preferences); err != nil {
+		return nil, fmt.Errorf("failed to decode member preferences: %w", err)
+	}
+
+	return preferences, nil
+}
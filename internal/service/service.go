@@ -2,9 +2,13 @@ package service
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/tasks-control/core-back-end/internal/config"
+	"github.com/tasks-control/core-back-end/internal/mailer"
+	"github.com/tasks-control/core-back-end/internal/oidc"
 	"github.com/tasks-control/core-back-end/internal/repository"
+	"github.com/tasks-control/core-back-end/internal/storage"
 	"github.com/tasks-control/core-back-end/pkg/utils"
 )
 
@@ -16,20 +20,59 @@ var (
 )
 
 type Service struct {
-	Repo       repository.Repository
-	JWTManager *utils.JWTManager
-	JWTConfig  config.JWTConfig
+	Repo                repository.Repository
+	JWTManager          *utils.JWTManager
+	JWTConfig           config.JWTConfig
+	AuthConfig          config.AuthConfig
+	Mailer              mailer.Mailer
+	OIDC                *oidc.Client
+	OIDCConfig          oidc.Config
+	DataExportConfig    config.DataExportConfig
+	Storage             storage.Store
+	NotificationsConfig config.NotificationsConfig
+	WebhooksConfig      config.WebhooksConfig
+	WebhookClient       *http.Client
 }
 
 func New(repo repository.Repository, cfg *config.Config) (*Service, error) {
-	jwtManager, err := utils.NewJWTManager(cfg.JWT.SecretEnv)
+	var jwtManager *utils.JWTManager
+	var err error
+	if cfg.JWT.KeySetFile != "" {
+		jwtManager, err = utils.NewJWTManagerWithKeySet(cfg.JWT.KeySetFile, cfg.JWT.SecretEnv)
+	} else {
+		jwtManager, err = utils.NewJWTManager(cfg.JWT.SecretEnv)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := mailer.New(cfg.Mail)
+	if err != nil {
+		return nil, err
+	}
+
+	oidcClient, err := oidc.New(cfg.OIDC)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := storage.New(cfg.Storage)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Service{
-		Repo:       repo,
-		JWTManager: jwtManager,
-		JWTConfig:  cfg.JWT,
+		Repo:                repo,
+		JWTManager:          jwtManager,
+		JWTConfig:           cfg.JWT,
+		AuthConfig:          cfg.Auth,
+		Mailer:              mail,
+		OIDC:                oidcClient,
+		OIDCConfig:          cfg.OIDC,
+		DataExportConfig:    cfg.DataExport,
+		Storage:             store,
+		NotificationsConfig: cfg.Notifications,
+		WebhooksConfig:      cfg.Webhooks,
+		WebhookClient:       newWebhookHTTPClient(cfg.Webhooks),
 	}, nil
 }
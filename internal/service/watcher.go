@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+// SetCardWatching starts or stops a member watching a card
+func (s *Service) SetCardWatching(ctx context.Context, cardID, memberID uuid.UUID, watching bool) error {
+	// Get board ID from card
+	boardID, err := s.Repo.GetBoardIDByCardID(ctx, cardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board ID: %w", err)
+	}
+	if boardID == uuid.Nil {
+		return ErrCardNotFound
+	}
+
+	return s.setWatching(ctx, boardID, memberID, models.WatchTargetCard, cardID, watching)
+}
+
+// SetListWatching starts or stops a member watching all cards of a list
+func (s *Service) SetListWatching(ctx context.Context, listID, memberID uuid.UUID, watching bool) error {
+	// Get list
+	list, err := s.Repo.GetListByID(ctx, listID)
+	if err != nil {
+		return fmt.Errorf("failed to get list: %w", err)
+	}
+	if list == nil {
+		return ErrListNotFound
+	}
+
+	return s.setWatching(ctx, list.IDBoard, memberID, models.WatchTargetList, listID, watching)
+}
+
+// SetBoardWatching starts or stops a member watching all cards of a board
+func (s *Service) SetBoardWatching(ctx context.Context, boardID, memberID uuid.UUID, watching bool) error {
+	// Check if board exists
+	board, err := s.Repo.GetBoardByID(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("failed to get board: %w", err)
+	}
+	if board == nil {
+		return ErrBoardNotFound
+	}
+
+	return s.setWatching(ctx, boardID, memberID, models.WatchTargetBoard, boardID, watching)
+}
+
+// setWatching adds or removes a watcher after checking the member belongs to the board of the target
+func (s *Service) setWatching(ctx context.Context, boardID, memberID uuid.UUID, targetType string, targetID uuid.UUID, watching bool) error {
+	// Check if user is a member of the board
+	boardMember, err := s.Repo.GetBoardMember(ctx, boardID, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return ErrNotBoardMember
+	}
+
+	if watching {
+		err = s.Repo.AddWatcher(ctx, memberID, targetType, targetID)
+	} else {
+		err = s.Repo.RemoveWatcher(ctx, memberID, targetType, targetID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update watcher: %w", err)
+	}
+
+	return nil
+}
+
+// cardWatcherIDs returns the members following a card: its creator and everyone watching the card, its list or its board.
+// Members who left the board or deleted their account are excluded. A failed lookup is logged and notifies nobody.
+func (s *Service) cardWatcherIDs(ctx context.Context, boardID uuid.UUID, card *models.Card) []uuid.UUID {
+	watchers, err := s.Repo.GetCardWatcherIDs(ctx, boardID, card.IDList, card.ID, card.CreatedBy)
+	if err != nil {
+		utils.Logger().WithError(err).WithField("card", card.ID).Error("Failed to get card watchers for notification")
+		return nil
+	}
+	return watchers
+}
+
+// cardsWatcherIDs returns the members following each of the given cards with one lookup, see cardWatcherIDs.
+// A failed lookup is logged and notifies nobody.
+func (s *Service) cardsWatcherIDs(ctx context.Context, cardIDs []uuid.UUID) map[uuid.UUID][]uuid.UUID {
+	if len(cardIDs) == 0 {
+		return nil
+	}
+	watchers, err := s.Repo.GetCardsWatcherIDs(ctx, cardIDs)
+	if err != nil {
+		utils.Logger().WithError(err).WithField("cards", len(cardIDs)).Error("Failed to get card watchers for notification")
+		return nil
+	}
+	return watchers
+}
+
+// changedCardIDs returns the IDs of changed cards
+func changedCardIDs(changes []*models.CardChange) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(changes))
+	for _, change := range changes {
+		ids = append(ids, change.ID)
+	}
+	return ids
+}
@@ -0,0 +1,559 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tasks-control/core-back-end/internal/config"
+	"github.com/tasks-control/core-back-end/internal/models"
+	"github.com/tasks-control/core-back-end/pkg/utils"
+)
+
+var (
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrInvalidWebhookURL   = errors.New("webhook URL must be an absolute http or https URL")
+	ErrInvalidWebhookEvent = errors.New("unknown webhook event")
+	errPrivateTarget       = errors.New("webhook target resolves to a private network address")
+)
+
+const (
+	webhookSecretBytes   = 32
+	webhookResponseLimit = 64 << 10
+	webhookLeaseDuration = 5 * time.Minute
+)
+
+// CreateWebhookRequest represents the data needed to create a webhook
+type CreateWebhookRequest struct {
+	URL      string
+	Secret   *string // Generated when not provided
+	Events   []string
+	BoardID  uuid.UUID
+	MemberID uuid.UUID
+}
+
+// UpdateWebhookRequest represents the data needed to update a webhook
+type UpdateWebhookRequest struct {
+	URL    *string
+	Secret *string
+	Events *[]string
+	Active *bool
+}
+
+// webhookPayload is the JSON body sent to webhook receivers
+type webhookPayload struct {
+	ID         uuid.UUID   `json:"id"`
+	Event      string      `json:"event"`
+	IDBoard    uuid.UUID   `json:"idBoard"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	Data       interface{} `json:"data"`
+}
+
+// CreateWebhook creates a webhook subscription on a board (owner only)
+func (s *Service) CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*models.Webhook, error) {
+	// Only owners can manage webhooks
+	if err := s.checkBoardOwner(ctx, req.BoardID, req.MemberID); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEvents(req.Events); err != nil {
+		return nil, err
+	}
+
+	// Generate secret if not provided
+	secret := ""
+	if req.Secret != nil && *req.Secret != "" {
+		secret = *req.Secret
+	} else {
+		generated, err := utils.GenerateSecret(webhookSecretBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		secret = generated
+	}
+
+	events := req.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	// Create webhook
+	now := time.Now()
+	webhook := &models.Webhook{
+		ID:        uuid.New(),
+		IDBoard:   req.BoardID,
+		URL:       req.URL,
+		Secret:    secret,
+		Events:    events,
+		Active:    true,
+		CreatedBy: req.MemberID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := s.Repo.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// GetBoardWebhooks retrieves all webhooks of a board (owner only)
+func (s *Service) GetBoardWebhooks(ctx context.Context, boardID, memberID uuid.UUID) ([]*models.Webhook, error) {
+	// Only owners can manage webhooks
+	if err := s.checkBoardOwner(ctx, boardID, memberID); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.Repo.GetBoardWebhooks(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// UpdateWebhook updates a webhook subscription (owner only)
+func (s *Service) UpdateWebhook(ctx context.Context, boardID, webhookID, memberID uuid.UUID, req UpdateWebhookRequest) (*models.Webhook, error) {
+	webhook, err := s.getOwnedWebhook(ctx, boardID, webhookID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+		webhook.URL = *req.URL
+	}
+
+	if req.Secret != nil && *req.Secret != "" {
+		webhook.Secret = *req.Secret
+	}
+
+	if req.Events != nil {
+		if err := validateWebhookEvents(*req.Events); err != nil {
+			return nil, err
+		}
+		webhook.Events = *req.Events
+	}
+
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+
+	webhook.UpdatedAt = time.Now()
+
+	err = s.Repo.UpdateWebhook(ctx, webhook)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook deletes a webhook subscription with its queued deliveries (owner only)
+func (s *Service) DeleteWebhook(ctx context.Context, boardID, webhookID, memberID uuid.UUID) error {
+	if _, err := s.getOwnedWebhook(ctx, boardID, webhookID, memberID); err != nil {
+		return err
+	}
+
+	err := s.Repo.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrWebhookNotFound
+		}
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveryLogs retrieves delivery attempts of a webhook (owner only)
+func (s *Service) GetWebhookDeliveryLogs(ctx context.Context, boardID, webhookID, memberID uuid.UUID, limit, offset int) ([]*models.WebhookDeliveryLog, int, error) {
+	if _, err := s.getOwnedWebhook(ctx, boardID, webhookID, memberID); err != nil {
+		return nil, 0, err
+	}
+
+	// Validate pagination parameters
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	logs, total, err := s.Repo.GetWebhookDeliveryLogs(ctx, webhookID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get webhook delivery logs: %w", err)
+	}
+
+	return logs, total, nil
+}
+
+// TestWebhook sends a webhook.test event right away and returns the attempt log.
+// A failed test delivery stays in the queue and is retried like any other delivery.
+func (s *Service) TestWebhook(ctx context.Context, boardID, webhookID, memberID uuid.UUID) (*models.WebhookDeliveryLog, error) {
+	webhook, err := s.getOwnedWebhook(ctx, boardID, webhookID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(webhookPayload{
+		ID:         uuid.New(),
+		Event:      models.WebhookEventTest,
+		IDBoard:    boardID,
+		OccurredAt: now,
+		Data:       map[string]interface{}{"idWebhook": webhook.ID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	// Lease the delivery so the background dispatcher doesn't pick it up concurrently
+	delivery := &models.WebhookDelivery{
+		ID:            uuid.New(),
+		IDWebhook:     webhook.ID,
+		Event:         models.WebhookEventTest,
+		Payload:       payload,
+		Status:        models.WebhookDeliveryPending,
+		NextAttemptAt: now.Add(webhookLeaseDuration),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err = s.Repo.CreateWebhookDeliveries(ctx, []*models.WebhookDelivery{delivery})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+
+	log, err := s.attemptWebhookDelivery(ctx, webhook, delivery)
+	if err != nil {
+		return nil, err
+	}
+	log.Event = delivery.Event
+	log.DeliveryStatus = delivery.Status
+
+	return log, nil
+}
+
+// RunWebhookDispatcher delivers queued webhook events until ctx is cancelled
+func (s *Service) RunWebhookDispatcher(ctx context.Context) {
+	log := utils.Logger()
+	ticker := time.NewTicker(time.Duration(s.WebhooksConfig.PollInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		// Drain the queue before waiting for the next tick
+		for {
+			processed, err := s.ProcessWebhookDeliveries(ctx)
+			if err != nil {
+				log.WithError(err).Error("Failed to process webhook deliveries")
+				break
+			}
+			if processed < s.WebhooksConfig.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessWebhookDeliveries attempts one batch of due deliveries and returns how many were claimed
+func (s *Service) ProcessWebhookDeliveries(ctx context.Context) (int, error) {
+	deliveries, err := s.Repo.ClaimWebhookDeliveries(ctx, s.WebhooksConfig.BatchSize, time.Now().Add(webhookLeaseDuration))
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	webhooks := make(map[uuid.UUID]*models.Webhook)
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.IDWebhook]
+		if !ok {
+			webhook, err = s.Repo.GetWebhookByID(ctx, delivery.IDWebhook)
+			if err != nil {
+				return 0, fmt.Errorf("failed to get webhook: %w", err)
+			}
+			webhooks[delivery.IDWebhook] = webhook
+		}
+
+		// Deleted webhooks take their deliveries with them
+		if webhook == nil {
+			continue
+		}
+
+		if _, err := s.attemptWebhookDelivery(ctx, webhook, delivery); err != nil {
+			utils.Logger().WithError(err).WithField("delivery", delivery.ID).Error("Failed to record webhook attempt")
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// publishWebhookEvent enqueues an event for every active webhook of the board subscribed to it.
+// Failures are logged and never fail the operation that triggered the event.
+func (s *Service) publishWebhookEvent(ctx context.Context, boardID uuid.UUID, event string, data interface{}) {
+	log := utils.Logger().WithField("event", event).WithField("board", boardID)
+
+	webhooks, err := s.Repo.GetActiveWebhooksForEvent(ctx, boardID, event)
+	if err != nil {
+		log.WithError(err).Error("Failed to get webhooks for event")
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(webhookPayload{
+		ID:         uuid.New(),
+		Event:      event,
+		IDBoard:    boardID,
+		OccurredAt: now,
+		Data:       data,
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to encode webhook payload")
+		return
+	}
+
+	deliveries := make([]*models.WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		deliveries = append(deliveries, &models.WebhookDelivery{
+			ID:            uuid.New(),
+			IDWebhook:     webhook.ID,
+			Event:         event,
+			Payload:       payload,
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+
+	if err := s.Repo.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		log.WithError(err).Error("Failed to enqueue webhook deliveries")
+	}
+}
+
+// attemptWebhookDelivery sends a delivery once and stores the outcome: success, retry with backoff or dead letter
+func (s *Service) attemptWebhookDelivery(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (*models.WebhookDeliveryLog, error) {
+	delivery.Attempts++
+	started := time.Now()
+
+	var statusCode *int
+	var sendErr error
+	if webhook.Active {
+		code, err := s.sendWebhook(ctx, webhook, delivery)
+		if code != 0 {
+			statusCode = &code
+		}
+		sendErr = err
+	} else {
+		sendErr = errors.New("webhook is disabled")
+	}
+
+	finished := time.Now()
+	log := &models.WebhookDeliveryLog{
+		ID:         uuid.New(),
+		IDDelivery: delivery.ID,
+		IDWebhook:  webhook.ID,
+		Attempt:    delivery.Attempts,
+		StatusCode: statusCode,
+		DurationMs: int(finished.Sub(started).Milliseconds()),
+		CreatedAt:  finished,
+	}
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &finished
+		delivery.LastError = nil
+	case !webhook.Active || delivery.Attempts >= s.WebhooksConfig.MaxAttempts:
+		// Dead letter: kept for inspection, never retried
+		message := sendErr.Error()
+		delivery.Status = models.WebhookDeliveryDead
+		delivery.LastError = &message
+		log.Error = &message
+	default:
+		message := sendErr.Error()
+		delivery.Status = models.WebhookDeliveryPending
+		delivery.NextAttemptAt = finished.Add(s.webhookBackoff(delivery.Attempts))
+		delivery.LastError = &message
+		log.Error = &message
+	}
+
+	err := s.Repo.RecordWebhookAttempt(ctx, delivery, log)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to record webhook attempt: %w", err)
+	}
+
+	return log, nil
+}
+
+// sendWebhook POSTs the signed payload and returns the response status code
+func (s *Service) sendWebhook(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	timestamp := time.Now().Unix()
+	signature := utils.SignWebhookPayload(webhook.Secret, timestamp, delivery.Payload)
+
+	reqCtx, cancel := context.WithTimeout(ctx, time.Duration(s.WebhooksConfig.RequestTimeout)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "core-back-end-webhooks/1.0")
+	req.Header.Set("X-Webhook-Event", delivery.Event)
+	req.Header.Set("X-Webhook-Delivery", delivery.ID.String())
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Webhook-Signature", "sha256="+signature)
+
+	resp, err := s.WebhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain a bounded part of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookResponseLimit))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// webhookBackoff returns the delay before the next attempt: initial backoff doubled per failed attempt, capped
+func (s *Service) webhookBackoff(attempts int) time.Duration {
+	backoff := time.Duration(s.WebhooksConfig.InitialBackoff) * time.Second
+	maxBackoff := time.Duration(s.WebhooksConfig.MaxBackoff) * time.Second
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// checkBoardOwner returns an error unless the member owns the board
+func (s *Service) checkBoardOwner(ctx context.Context, boardID, memberID uuid.UUID) error {
+	boardMember, err := s.Repo.GetBoardMember(ctx, boardID, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to check board membership: %w", err)
+	}
+	if boardMember == nil {
+		return ErrNotBoardMember
+	}
+	if boardMember.Role != models.BoardRoleOwner {
+		return ErrNotBoardOwner
+	}
+	return nil
+}
+
+// getOwnedWebhook retrieves a webhook of a board owned by the member
+func (s *Service) getOwnedWebhook(ctx context.Context, boardID, webhookID, memberID uuid.UUID) (*models.Webhook, error) {
+	// Only owners can manage webhooks
+	if err := s.checkBoardOwner(ctx, boardID, memberID); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.Repo.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	if webhook == nil || webhook.IDBoard != boardID {
+		return nil, ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
+
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+func validateWebhookEvents(events []string) error {
+	for _, event := range events {
+		switch event {
+		case models.WebhookEventCardCreated,
+			models.WebhookEventCardUpdated,
+			models.WebhookEventCardMoved,
+			models.WebhookEventCardArchived,
+			models.WebhookEventCardUnarchived,
+			models.WebhookEventCardDeleted,
+			models.WebhookEventCardsBulk,
+			models.WebhookEventListCardsMoved,
+			models.WebhookEventListCardsArchived:
+		default:
+			return ErrInvalidWebhookEvent
+		}
+	}
+	return nil
+}
+
+// newWebhookHTTPClient builds the client used for deliveries. Redirects are not followed and,
+// unless allowed in config, connections to loopback and private addresses are refused after DNS resolution.
+func newWebhookHTTPClient(cfg config.WebhooksConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if !cfg.AllowPrivateTargets {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+				return errPrivateTarget
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Supported storage drivers
+const (
+	DriverLocal = "local"
+)
+
+var ErrNotFound = errors.New("file not found")
+
+type Config struct {
+	Driver    string `validate:"required,oneof=local" yaml:"driver"`
+	Dir       string `validate:"required_if=Driver local" yaml:"dir"` // root directory of the local driver
+	PublicURL string `validate:"required" yaml:"publicURL"`           // base URL stored files are served from, without trailing slash
+}
+
+// Store keeps uploaded files under slash separated keys. Implementations must be safe for concurrent use.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Open returns ErrNotFound for missing keys
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, time.Time, error)
+	// Delete ignores missing keys
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+// New creates the store selected by the config driver
+func New(cfg Config) (Store, error) {
+	switch cfg.Driver {
+	case DriverLocal:
+		return NewLocalStore(cfg.Dir, cfg.PublicURL)
+	}
+	return nil, fmt.Errorf("unknown storage driver: %s", cfg.Driver)
+}
+
+// LocalStore keeps files in a directory on the local disk, served by the API itself
+type LocalStore struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalStore creates a new local store, creating the root directory if needed
+func NewLocalStore(dir, publicURL string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("can't create storage directory: %w", err)
+	}
+	return &LocalStore{dir: dir, publicURL: strings.TrimSuffix(publicURL, "/")}, nil
+}
+
+// Put writes the file through a temporary file, so readers never see a partial file
+func (s *LocalStore) Put(_ context.Context, key string, data []byte) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+		return fmt.Errorf("can't create storage directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("can't create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("can't write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("can't write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("can't store file: %w", err)
+	}
+	return nil
+}
+
+// Open opens a stored file for reading
+func (s *LocalStore) Open(_ context.Context, key string) (io.ReadSeekCloser, time.Time, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, time.Time{}, ErrNotFound
+	}
+
+	file, err := os.Open(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, time.Time{}, ErrNotFound
+	}
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("can't open file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, time.Time{}, fmt.Errorf("can't stat file: %w", err)
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, time.Time{}, ErrNotFound
+	}
+
+	return file, info.ModTime(), nil
+}
+
+// Delete removes a stored file
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("can't delete file: %w", err)
+	}
+	return nil
+}
+
+// URL returns where the file is served
+func (s *LocalStore) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+// path maps a key to a file below the root directory, rejecting keys that would leave it
+func (s *LocalStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return "", fmt.Errorf("invalid storage key: %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
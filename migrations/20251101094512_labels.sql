@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: labels (Board Labels)
+-- =====================================================
+CREATE TABLE labels (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_board UUID NOT NULL,
+    name VARCHAR(100),
+    color VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_labels_board
+        FOREIGN KEY (id_board)
+        REFERENCES boards(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_labels_board ON labels(id_board);
+
+-- =====================================================
+-- Table: card_labels (Labels assigned to cards)
+-- =====================================================
+CREATE TABLE card_labels (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_card UUID NOT NULL,
+    id_label UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_card_labels_card
+        FOREIGN KEY (id_card)
+        REFERENCES cards(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_card_labels_label
+        FOREIGN KEY (id_label)
+        REFERENCES labels(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT uq_card_label
+        UNIQUE (id_card, id_label)
+);
+
+CREATE INDEX idx_card_labels_card ON card_labels(id_card);
+CREATE INDEX idx_card_labels_label ON card_labels(id_label);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS card_labels;
+DROP TABLE IF EXISTS labels;
+
+-- +goose StatementEnd
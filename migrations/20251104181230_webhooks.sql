@@ -0,0 +1,105 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: webhooks (Board Webhook Subscriptions)
+-- =====================================================
+CREATE TABLE webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_board UUID NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    events TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_webhooks_board
+        FOREIGN KEY (id_board)
+        REFERENCES boards(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_webhooks_creator
+        FOREIGN KEY (created_by)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhooks_board ON webhooks(id_board) WHERE active = TRUE;
+
+-- =====================================================
+-- Table: webhook_deliveries (Durable Delivery Queue)
+-- =====================================================
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_webhook UUID NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_webhook_deliveries_webhook
+        FOREIGN KEY (id_webhook)
+        REFERENCES webhooks(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_webhook_deliveries_status
+        CHECK (status IN ('pending', 'succeeded', 'dead'))
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_webhook ON webhook_deliveries(id_webhook);
+
+-- =====================================================
+-- Table: webhook_delivery_logs (Delivery Attempts)
+-- =====================================================
+CREATE TABLE webhook_delivery_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_delivery UUID NOT NULL,
+    id_webhook UUID NOT NULL,
+    attempt INTEGER NOT NULL,
+    status_code INTEGER,
+    error TEXT,
+    duration_ms INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_webhook_delivery_logs_delivery
+        FOREIGN KEY (id_delivery)
+        REFERENCES webhook_deliveries(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_webhook_delivery_logs_webhook
+        FOREIGN KEY (id_webhook)
+        REFERENCES webhooks(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_delivery_logs_webhook ON webhook_delivery_logs(id_webhook, created_at DESC);
+
+CREATE TRIGGER update_webhooks_updated_at
+    BEFORE UPDATE ON webhooks
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_webhook_deliveries_updated_at
+    BEFORE UPDATE ON webhook_deliveries
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER IF EXISTS update_webhook_deliveries_updated_at ON webhook_deliveries;
+DROP TRIGGER IF EXISTS update_webhooks_updated_at ON webhooks;
+
+DROP TABLE IF EXISTS webhook_delivery_logs;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
+
+-- +goose StatementEnd
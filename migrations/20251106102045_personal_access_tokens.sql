@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: personal_access_tokens (Long-lived API Tokens)
+-- =====================================================
+CREATE TABLE personal_access_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    token_prefix VARCHAR(20) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_personal_access_tokens_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_personal_access_tokens_member ON personal_access_tokens(id_member);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS personal_access_tokens;
+
+-- +goose StatementEnd
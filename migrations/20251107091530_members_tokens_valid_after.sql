@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Access tokens issued before this time are rejected, set when credentials change
+ALTER TABLE members ADD COLUMN tokens_valid_after TIMESTAMP WITH TIME ZONE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE members DROP COLUMN IF EXISTS tokens_valid_after;
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: member_security_events (Account Security History)
+-- =====================================================
+CREATE TABLE member_security_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    ip_address VARCHAR(45),
+    user_agent TEXT,
+    metadata JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_member_security_events_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_member_security_events_member ON member_security_events(id_member, created_at DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS member_security_events;
+
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: password_reset_tokens (One-time Password Reset Tokens)
+-- =====================================================
+CREATE TABLE password_reset_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_password_reset_tokens_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_password_reset_tokens_member ON password_reset_tokens(id_member) WHERE used_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS password_reset_tokens;
+
+-- +goose StatementEnd
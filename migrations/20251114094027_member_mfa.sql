@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: member_totp (TOTP Second Factor)
+-- =====================================================
+CREATE TABLE member_totp (
+    id_member UUID PRIMARY KEY,
+    secret VARCHAR(64) NOT NULL,
+    confirmed_at TIMESTAMP WITH TIME ZONE,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_member_totp_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+-- =====================================================
+-- Table: member_recovery_codes (Single-use 2FA Recovery Codes)
+-- =====================================================
+CREATE TABLE member_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    code_hash VARCHAR(255) NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_member_recovery_codes_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_member_recovery_codes_member ON member_recovery_codes(id_member, code_hash);
+
+-- =====================================================
+-- Table: mfa_challenges (Pending Second Factor Logins)
+-- =====================================================
+CREATE TABLE mfa_challenges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_mfa_challenges_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX idx_mfa_challenges_expires_at ON mfa_challenges(expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS mfa_challenges;
+DROP TABLE IF EXISTS member_recovery_codes;
+DROP TABLE IF EXISTS member_totp;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: auth_failures (Failed Password Attempts and Lockouts)
+-- =====================================================
+CREATE TABLE auth_failures (
+    scope VARCHAR(32) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    locked_until TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX idx_auth_failures_last_failure_at ON auth_failures(last_failure_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS auth_failures;
+
+-- +goose StatementEnd
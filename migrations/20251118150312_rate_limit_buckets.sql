@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: rate_limit_buckets (Shared Rate Limiter State)
+-- =====================================================
+CREATE TABLE rate_limit_buckets (
+    key VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_rate_limit_buckets_updated_at ON rate_limit_buckets(updated_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS rate_limit_buckets;
+
+-- +goose StatementEnd
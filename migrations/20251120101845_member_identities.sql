@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: member_identities (External OIDC Accounts)
+-- =====================================================
+CREATE TABLE member_identities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    provider VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_member_identities_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT uk_member_identities_provider_subject
+        UNIQUE (provider, subject)
+);
+
+CREATE INDEX idx_member_identities_member ON member_identities(id_member);
+
+-- =====================================================
+-- Table: oidc_login_states (Pending OIDC Logins)
+-- =====================================================
+CREATE TABLE oidc_login_states (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    provider VARCHAR(64) NOT NULL,
+    state_hash VARCHAR(255) NOT NULL UNIQUE,
+    nonce VARCHAR(255) NOT NULL,
+    code_verifier VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_oidc_login_states_expires_at ON oidc_login_states(expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS oidc_login_states;
+DROP TABLE IF EXISTS member_identities;
+
+-- +goose StatementEnd
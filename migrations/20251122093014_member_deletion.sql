@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Deleted members are kept as anonymised tombstones so shared boards keep their content
+ALTER TABLE members ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Deleting a member row must not silently wipe cards and boards other members work on
+ALTER TABLE cards DROP CONSTRAINT fk_cards_creator;
+ALTER TABLE cards ADD CONSTRAINT fk_cards_creator
+    FOREIGN KEY (created_by)
+    REFERENCES members(id)
+    ON DELETE RESTRICT;
+
+ALTER TABLE boards DROP CONSTRAINT fk_boards_creator;
+ALTER TABLE boards ADD CONSTRAINT fk_boards_creator
+    FOREIGN KEY (id_member_creator)
+    REFERENCES members(id)
+    ON DELETE RESTRICT;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE boards DROP CONSTRAINT fk_boards_creator;
+ALTER TABLE boards ADD CONSTRAINT fk_boards_creator
+    FOREIGN KEY (id_member_creator)
+    REFERENCES members(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE cards DROP CONSTRAINT fk_cards_creator;
+ALTER TABLE cards ADD CONSTRAINT fk_cards_creator
+    FOREIGN KEY (created_by)
+    REFERENCES members(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE members DROP COLUMN IF EXISTS deleted_at;
+
+-- +goose StatementEnd
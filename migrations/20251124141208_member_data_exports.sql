@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: member_data_exports (Personal Data Export Jobs)
+-- =====================================================
+CREATE TABLE member_data_exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    file_path VARCHAR(1024),
+    size_bytes BIGINT,
+    error TEXT,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_member_data_exports_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_member_data_exports_format
+        CHECK (format IN ('json', 'zip')),
+
+    CONSTRAINT chk_member_data_exports_status
+        CHECK (status IN ('pending', 'processing', 'ready', 'failed'))
+);
+
+CREATE INDEX idx_member_data_exports_member ON member_data_exports(id_member, created_at DESC);
+CREATE INDEX idx_member_data_exports_pending ON member_data_exports(created_at) WHERE status IN ('pending', 'processing');
+CREATE INDEX idx_member_data_exports_expires_at ON member_data_exports(expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS member_data_exports;
+
+-- +goose StatementEnd
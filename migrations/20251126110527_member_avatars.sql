@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Every upload gets a new ID, so avatar URLs never change content and can be cached for good
+ALTER TABLE members ADD COLUMN avatar_id UUID;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE members DROP COLUMN IF EXISTS avatar_id;
+
+-- +goose StatementEnd
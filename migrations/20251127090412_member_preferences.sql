@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: member_preferences (Per-Member Settings)
+-- =====================================================
+-- Only settings the member changed are stored, defaults are applied when reading
+CREATE TABLE member_preferences (
+    id_member UUID PRIMARY KEY,
+    preferences JSONB NOT NULL DEFAULT '{}'::jsonb,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_member_preferences_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_member_preferences_object
+        CHECK (jsonb_typeof(preferences) = 'object')
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS member_preferences;
+
+-- +goose StatementEnd
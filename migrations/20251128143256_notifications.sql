@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: notifications (In-App Notification Inbox)
+-- =====================================================
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    id_board UUID,
+    id_card UUID,
+    id_actor UUID,
+    data JSONB NOT NULL DEFAULT '{}',
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_notifications_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_notifications_board
+        FOREIGN KEY (id_board)
+        REFERENCES boards(id)
+        ON DELETE CASCADE,
+
+    -- Notifications about deleted cards stay readable, the card title is kept in data
+    CONSTRAINT fk_notifications_card
+        FOREIGN KEY (id_card)
+        REFERENCES cards(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT fk_notifications_actor
+        FOREIGN KEY (id_actor)
+        REFERENCES members(id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX idx_notifications_member ON notifications(id_member, created_at DESC);
+CREATE INDEX idx_notifications_unread ON notifications(id_member, created_at DESC) WHERE read_at IS NULL;
+CREATE INDEX idx_notifications_created_at ON notifications(created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS notifications;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: member_email_digests (Email Digest Progress)
+-- =====================================================
+-- Unread notifications created up to last_digest_at were emailed, or skipped while digests were off
+CREATE TABLE member_email_digests (
+    id_member UUID PRIMARY KEY,
+    last_digest_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT fk_member_email_digests_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS member_email_digests;
+
+-- +goose StatementEnd
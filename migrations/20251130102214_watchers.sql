@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: watchers (Card, List and Board Subscriptions)
+-- =====================================================
+-- Exactly one of id_board, id_list and id_card is set
+CREATE TABLE watchers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_member UUID NOT NULL,
+    id_board UUID,
+    id_list UUID,
+    id_card UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_watchers_member
+        FOREIGN KEY (id_member)
+        REFERENCES members(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_watchers_board
+        FOREIGN KEY (id_board)
+        REFERENCES boards(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_watchers_list
+        FOREIGN KEY (id_list)
+        REFERENCES lists(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_watchers_card
+        FOREIGN KEY (id_card)
+        REFERENCES cards(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_watchers_one_target
+        CHECK (num_nonnulls(id_board, id_list, id_card) = 1)
+);
+
+-- Indexes for watchers
+CREATE UNIQUE INDEX idx_watchers_board ON watchers(id_board, id_member) WHERE id_board IS NOT NULL;
+CREATE UNIQUE INDEX idx_watchers_list ON watchers(id_list, id_member) WHERE id_list IS NOT NULL;
+CREATE UNIQUE INDEX idx_watchers_card ON watchers(id_card, id_member) WHERE id_card IS NOT NULL;
+CREATE INDEX idx_watchers_member ON watchers(id_member);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS watchers;
+
+-- +goose StatementEnd
@@ -0,0 +1,94 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =====================================================
+-- Table: custom_fields (Board Defined Card Fields)
+-- =====================================================
+CREATE TABLE custom_fields (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_board UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    position DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_custom_fields_board
+        FOREIGN KEY (id_board)
+        REFERENCES boards(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_custom_fields_type
+        CHECK (type IN ('text', 'number', 'date', 'checkbox', 'dropdown'))
+);
+
+-- Indexes for custom_fields
+CREATE UNIQUE INDEX idx_custom_fields_board_name ON custom_fields(id_board, LOWER(name));
+
+-- =====================================================
+-- Table: custom_field_options (Dropdown Choices)
+-- =====================================================
+CREATE TABLE custom_field_options (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    id_custom_field UUID NOT NULL,
+    value VARCHAR(100) NOT NULL,
+    position DOUBLE PRECISION NOT NULL,
+
+    CONSTRAINT fk_custom_field_options_field
+        FOREIGN KEY (id_custom_field)
+        REFERENCES custom_fields(id)
+        ON DELETE CASCADE
+);
+
+-- Indexes for custom_field_options
+CREATE INDEX idx_custom_field_options_field ON custom_field_options(id_custom_field);
+
+-- =====================================================
+-- Table: card_custom_field_values (Per-Card Values)
+-- =====================================================
+-- Exactly one value column is set, matching the type of the field
+CREATE TABLE card_custom_field_values (
+    id_card UUID NOT NULL,
+    id_custom_field UUID NOT NULL,
+    value_text TEXT,
+    value_number DOUBLE PRECISION,
+    value_date TIMESTAMP WITH TIME ZONE,
+    value_checked BOOLEAN,
+    id_option UUID,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (id_card, id_custom_field),
+
+    CONSTRAINT fk_card_custom_field_values_card
+        FOREIGN KEY (id_card)
+        REFERENCES cards(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_card_custom_field_values_field
+        FOREIGN KEY (id_custom_field)
+        REFERENCES custom_fields(id)
+        ON DELETE CASCADE,
+
+    -- Removing a dropdown option clears the cards that selected it
+    CONSTRAINT fk_card_custom_field_values_option
+        FOREIGN KEY (id_option)
+        REFERENCES custom_field_options(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_card_custom_field_values_one_value
+        CHECK (num_nonnulls(value_text, value_number, value_date, value_checked, id_option) = 1)
+);
+
+-- Indexes for card_custom_field_values
+CREATE INDEX idx_card_custom_field_values_field ON card_custom_field_values(id_custom_field);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS card_custom_field_values;
+DROP TABLE IF EXISTS custom_field_options;
+DROP TABLE IF EXISTS custom_fields;
+
+-- +goose StatementEnd
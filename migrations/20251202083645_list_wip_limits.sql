@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Maximum number of active cards in a list, no limit when NULL
+ALTER TABLE lists ADD COLUMN wip_limit INTEGER;
+ALTER TABLE lists ADD CONSTRAINT chk_lists_wip_limit CHECK (wip_limit > 0);
+
+-- Hard limits always reject cards over the limit, soft ones can be overridden per request
+ALTER TABLE boards ADD COLUMN wip_limit_mode VARCHAR(10) NOT NULL DEFAULT 'soft';
+ALTER TABLE boards ADD CONSTRAINT chk_boards_wip_limit_mode CHECK (wip_limit_mode IN ('soft', 'hard'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE boards DROP COLUMN IF EXISTS wip_limit_mode;
+ALTER TABLE lists DROP COLUMN IF EXISTS wip_limit;
+
+-- +goose StatementEnd
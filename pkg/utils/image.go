@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"image"
+	"image/draw"
+)
+
+// SquareThumbnail crops the centre square of src and scales it to size x size pixels.
+// Every output pixel is the area weighted average of the source pixels it covers,
+// which keeps downscaled photos smooth without an external imaging library.
+func SquareThumbnail(src image.Image, size int) *image.RGBA {
+	bounds := src.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(
+		bounds.Min.X+(bounds.Dx()-side)/2,
+		bounds.Min.Y+(bounds.Dy()-side)/2,
+	))
+
+	// Premultiplied alpha, so transparent pixels don't bleed their colour into the average
+	square := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(square, square.Bounds(), src, crop.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	scale := float64(side) / float64(size)
+	var sum [4]float64
+	for y := range size {
+		y0, y1 := float64(y)*scale, float64(y+1)*scale
+		for x := range size {
+			x0, x1 := float64(x)*scale, float64(x+1)*scale
+
+			sum = [4]float64{}
+			var total float64
+			for sy := int(y0); float64(sy) < y1 && sy < side; sy++ {
+				wy := min(y1, float64(sy+1)) - max(y0, float64(sy))
+				for sx := int(x0); float64(sx) < x1 && sx < side; sx++ {
+					weight := wy * (min(x1, float64(sx+1)) - max(x0, float64(sx)))
+					offset := square.PixOffset(sx, sy)
+					for c := range 4 {
+						sum[c] += weight * float64(square.Pix[offset+c])
+					}
+					total += weight
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			for c := range 4 {
+				dst.Pix[offset+c] = uint8(sum[c]/total + 0.5)
+			}
+		}
+	}
+
+	return dst
+}
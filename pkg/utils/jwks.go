@@ -0,0 +1,307 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Supported asymmetric signing algorithms
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// JWTKey is a key of the JWT key set. Keys without a private key can only verify tokens.
+type JWTKey struct {
+	ID         string
+	Algorithm  string
+	ActiveFrom time.Time  // Signing starts at this time, the key is published and verifies tokens before that
+	RetireAt   *time.Time // Tokens signed with the key are rejected and it is unpublished after this time
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// JWK is the JSON Web Key representation of a public key (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// keySetManifest is the on-disk description of the key set.
+// Key file paths are relative to the manifest directory unless absolute.
+type keySetManifest struct {
+	Keys []struct {
+		KID            string     `yaml:"kid"`
+		Algorithm      string     `yaml:"algorithm"`
+		PrivateKeyFile string     `yaml:"privateKeyFile"`
+		PublicKeyFile  string     `yaml:"publicKeyFile"`
+		ActiveFrom     time.Time  `yaml:"activeFrom"`
+		RetireAt       *time.Time `yaml:"retireAt"`
+	} `yaml:"keys"`
+}
+
+// LoadJWTKeySet reads the key set manifest and the PEM encoded keys it references
+func LoadJWTKeySet(manifestPath string) ([]*JWTKey, error) {
+	data, err := os.ReadFile(manifestPath) //nolint:gosec // Key set path is controlled by application config
+	if err != nil {
+		return nil, fmt.Errorf("can't read key set manifest: %w", err)
+	}
+
+	var manifest keySetManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("can't parse key set manifest: %w", err)
+	}
+	if len(manifest.Keys) == 0 {
+		return nil, errors.New("key set manifest has no keys")
+	}
+
+	baseDir := filepath.Dir(manifestPath)
+	seen := make(map[string]bool, len(manifest.Keys))
+	keys := make([]*JWTKey, 0, len(manifest.Keys))
+	for _, entry := range manifest.Keys {
+		if entry.KID == "" {
+			return nil, errors.New("key set entry without kid")
+		}
+		if seen[entry.KID] {
+			return nil, fmt.Errorf("duplicated kid %q in key set", entry.KID)
+		}
+		seen[entry.KID] = true
+
+		key := &JWTKey{
+			ID:         entry.KID,
+			Algorithm:  entry.Algorithm,
+			ActiveFrom: entry.ActiveFrom,
+			RetireAt:   entry.RetireAt,
+		}
+
+		switch {
+		case entry.PrivateKeyFile != "":
+			signer, err := readPrivateKey(resolveKeyPath(baseDir, entry.PrivateKeyFile))
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", entry.KID, err)
+			}
+			key.PrivateKey = signer
+			key.PublicKey = signer.Public()
+		case entry.PublicKeyFile != "":
+			publicKey, err := readPublicKey(resolveKeyPath(baseDir, entry.PublicKeyFile))
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", entry.KID, err)
+			}
+			key.PublicKey = publicKey
+		default:
+			return nil, fmt.Errorf("key %q has neither privateKeyFile nor publicKeyFile", entry.KID)
+		}
+
+		if err := checkKeyAlgorithm(key); err != nil {
+			return nil, fmt.Errorf("key %q: %w", entry.KID, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	// Newest keys first, so the first active one is the signing key
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].ActiveFrom.After(keys[j].ActiveFrom)
+	})
+
+	return keys, nil
+}
+
+// canSign reports whether the key signs new tokens at the given time
+func (k *JWTKey) canSign(now time.Time) bool {
+	return k.PrivateKey != nil && !k.ActiveFrom.After(now) && !k.retired(now)
+}
+
+func (k *JWTKey) retired(now time.Time) bool {
+	return k.RetireAt != nil && !now.Before(*k.RetireAt)
+}
+
+func (k *JWTKey) signingMethod() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// jwk converts the public part of the key to its JWK representation
+func (k *JWTKey) jwk() JWK {
+	result := JWK{
+		KeyID:     k.ID,
+		Use:       "sig",
+		Algorithm: k.Algorithm,
+	}
+
+	switch publicKey := k.PublicKey.(type) {
+	case *rsa.PublicKey:
+		result.KeyType = "RSA"
+		result.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		result.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		result.KeyType = "OKP"
+		result.Curve = "Ed25519"
+		result.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	}
+
+	return result
+}
+
+// PublicKey decodes an RSA, EC or Ed25519 JWK, for verifying tokens issued by other parties
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > math.MaxInt32 {
+			return nil, errors.New("RSA exponent out of range")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported EC curve %q", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported OKP curve %q", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+}
+
+func checkKeyAlgorithm(key *JWTKey) error {
+	switch key.Algorithm {
+	case AlgorithmRS256:
+		if _, ok := key.PublicKey.(*rsa.PublicKey); !ok {
+			return errors.New("RS256 requires an RSA key")
+		}
+	case AlgorithmEdDSA:
+		if _, ok := key.PublicKey.(ed25519.PublicKey); !ok {
+			return errors.New("EdDSA requires an Ed25519 key")
+		}
+	default:
+		return fmt.Errorf("unsupported algorithm %q, expected %s or %s", key.Algorithm, AlgorithmRS256, AlgorithmEdDSA)
+	}
+	return nil
+}
+
+func resolveKeyPath(baseDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+func readPEMBlock(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Key paths come from the key set manifest
+	if err != nil {
+		return nil, fmt.Errorf("can't read key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+	return block, nil
+}
+
+// readPrivateKey reads a PKCS#8 or PKCS#1 encoded RSA or Ed25519 private key
+func readPrivateKey(path string) (crypto.Signer, error) {
+	block, err := readPEMBlock(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse private key: %w", err)
+	}
+	switch privateKey := parsed.(type) {
+	case *rsa.PrivateKey:
+		return privateKey, nil
+	case ed25519.PrivateKey:
+		return privateKey, nil
+	}
+	return nil, errors.New("private key must be RSA or Ed25519")
+}
+
+// readPublicKey reads a PKIX encoded RSA or Ed25519 public key
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEMBlock(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse public key: %w", err)
+	}
+	switch publicKey := parsed.(type) {
+	case *rsa.PublicKey:
+		return publicKey, nil
+	case ed25519.PublicKey:
+		return publicKey, nil
+	}
+	return nil, errors.New("public key must be RSA or Ed25519")
+}
@@ -15,6 +15,7 @@ import (
 var (
 	ErrInvalidToken = errors.New("invalid token")
 	ErrExpiredToken = errors.New("token has expired")
+	ErrNoSigningKey = errors.New("no active signing key in key set")
 )
 
 // Claims represents the JWT claims
@@ -25,9 +26,11 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// JWTManager handles JWT token generation and validation
+// JWTManager handles JWT token generation and validation.
+// It signs with HS256 and a shared secret, or with the active key of an asymmetric key set.
 type JWTManager struct {
-	secretKey string
+	secretKey string    // With a key set, only used to verify HS256 tokens issued before the switch
+	keys      []*JWTKey // Sorted by activation time, newest first
 }
 
 // NewJWTManager creates a new JWT manager
@@ -41,6 +44,41 @@ func NewJWTManager(secretEnv string) (*JWTManager, error) {
 	}, nil
 }
 
+// NewJWTManagerWithKeySet creates a JWT manager signing with RS256 or EdDSA keys from the key set manifest.
+// When legacySecretEnv holds a secret, HS256 tokens signed with it are still accepted until they expire.
+func NewJWTManagerWithKeySet(manifestPath, legacySecretEnv string) (*JWTManager, error) {
+	keys, err := LoadJWTKeySet(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &JWTManager{
+		keys: keys,
+	}
+	if legacySecretEnv != "" {
+		m.secretKey = os.Getenv(legacySecretEnv)
+	}
+
+	if m.signingKey(time.Now()) == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	return m, nil
+}
+
+// JWKS returns the public keys that verify tokens, including keys scheduled for activation
+// and keys kept during a rotation overlap. It is empty when signing with HS256.
+func (m *JWTManager) JWKS() JWKS {
+	now := time.Now()
+	set := JWKS{Keys: []JWK{}}
+	for _, key := range m.keys {
+		if !key.retired(now) {
+			set.Keys = append(set.Keys, key.jwk())
+		}
+	}
+	return set
+}
+
 // GenerateAccessToken generates a new access token
 func (m *JWTManager) GenerateAccessToken(userID uuid.UUID, email, username string, duration time.Duration) (string, error) {
 	claims := Claims{
@@ -55,8 +93,7 @@ func (m *JWTManager) GenerateAccessToken(userID uuid.UUID, email, username strin
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+	return m.sign(claims)
 }
 
 // GenerateRefreshToken generates a new refresh token
@@ -69,18 +106,12 @@ func (m *JWTManager) GenerateRefreshToken(userID uuid.UUID, duration time.Durati
 		ID:        uuid.New().String(), // Unique identifier for the refresh token
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+	return m.sign(claims)
 }
 
 // ValidateAccessToken validates an access token and returns the claims
 func (m *JWTManager) ValidateAccessToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(m.secretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, m.keyFunc)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -98,12 +129,7 @@ func (m *JWTManager) ValidateAccessToken(tokenString string) (*Claims, error) {
 
 // ValidateRefreshToken validates a refresh token and returns the user ID
 func (m *JWTManager) ValidateRefreshToken(tokenString string) (uuid.UUID, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(m.secretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, m.keyFunc)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -123,6 +149,60 @@ func (m *JWTManager) ValidateRefreshToken(tokenString string) (uuid.UUID, error)
 	return uuid.Nil, ErrInvalidToken
 }
 
+// sign signs the claims with the current signing key, setting the kid header for key set keys
+func (m *JWTManager) sign(claims jwt.Claims) (string, error) {
+	if m.keys == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(m.secretKey))
+	}
+
+	key := m.signingKey(time.Now())
+	if key == nil {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(key.signingMethod(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
+}
+
+// signingKey returns the most recently activated key able to sign at the given time
+func (m *JWTManager) signingKey(now time.Time) *JWTKey {
+	for _, key := range m.keys {
+		if key.canSign(now) {
+			return key
+		}
+	}
+	return nil
+}
+
+// keyFunc selects the verification key by the kid header and checks the algorithm matches the key
+func (m *JWTManager) keyFunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if m.secretKey == "" {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(m.secretKey), nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	now := time.Now()
+	for _, key := range m.keys {
+		if key.ID != kid {
+			continue
+		}
+		if key.retired(now) {
+			return nil, fmt.Errorf("signing key %q is retired", kid)
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.PublicKey, nil
+	}
+
+	return nil, fmt.Errorf("unknown signing key: %q", kid)
+}
+
 // HashToken creates a SHA256 hash of the token for storage
 func HashToken(token string) string {
 	hash := sha256.Sum256([]byte(token))